@@ -0,0 +1,154 @@
+package checkers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const notQualifiedMessageFormat = "Not qualified in the staking auction: qualified top-up: %s, threshold: %s"
+const unknownThreshold = "n/a"
+
+// ArgsAuctionChecker defines the DTO struct for the NewAuctionChecker constructor function
+type ArgsAuctionChecker struct {
+	Name       string
+	Querier    AuctionListQuerier
+	Identities *core.IdentitiesHolder
+}
+
+type auctionChecker struct {
+	name          string
+	querier       AuctionListQuerier
+	mutIdentities sync.RWMutex
+	hexBlsKeys    []string
+}
+
+// NewAuctionChecker creates a new instance of type auctionChecker
+func NewAuctionChecker(args ArgsAuctionChecker) (*auctionChecker, error) {
+	if check.IfNil(args.Querier) {
+		return nil, errNilAuctionListQuerier
+	}
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+
+	log.Debug("NewAuctionChecker", "checker name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys))
+
+	return &auctionChecker{
+		name:       args.Name,
+		querier:    args.Querier,
+		hexBlsKeys: args.Identities.BlsHexKeys,
+	}, nil
+}
+
+// SetIdentities will atomically replace the monitored BLS keys
+func (checker *auctionChecker) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	checker.mutIdentities.Lock()
+	checker.hexBlsKeys = identities.BlsHexKeys
+	checker.mutIdentities.Unlock()
+
+	log.Debug("auctionChecker.SetIdentities", "checker name", checker.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
+// Check queries the staking auction list and returns the monitored BLS keys that are not projected to be selected. The
+// threshold is the lowest qualified top-up of the owners having at least one qualified node. The BLS keys not found in
+// the auction list are not reported
+func (checker *auctionChecker) Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+	auctionList, err := checker.querier.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checker.mutIdentities.RLock()
+	monitoredKeys := make(map[string]struct{}, len(checker.hexBlsKeys)+len(extraBLSKeys))
+	for _, blsKey := range checker.hexBlsKeys {
+		monitoredKeys[blsKey] = struct{}{}
+	}
+	checker.mutIdentities.RUnlock()
+	for _, blsKey := range extraBLSKeys {
+		monitoredKeys[blsKey] = struct{}{}
+	}
+
+	threshold := computeAuctionThreshold(auctionList)
+	log.Debug("auctionChecker.Check", "checker name", checker.name, "num keys", len(monitoredKeys),
+		"num owners in auction", len(auctionList), "threshold", threshold)
+
+	results := make([]core.CheckResponse, 0)
+	for _, validator := range auctionList {
+		for _, node := range validator.Nodes {
+			if node == nil || node.Qualified {
+				continue
+			}
+			_, isMonitored := monitoredKeys[node.BlsKey]
+			if !isMonitored {
+				continue
+			}
+
+			log.Debug("found not qualified node in the staking auction", "checker", checker.name, "bls key", node.BlsKey)
+			results = append(results, core.CheckResponse{
+				HexBLSKey: node.BlsKey,
+				Status:    fmt.Sprintf(notQualifiedMessageFormat, formatTopUp(validator.QualifiedTopUp), threshold),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// computeAuctionThreshold returns the formatted lowest qualified top-up of the owners having at least one qualified node
+func computeAuctionThreshold(auctionList []*core.AuctionListValidator) string {
+	var threshold *big.Int
+	for _, validator := range auctionList {
+		if !hasQualifiedNodes(validator) {
+			continue
+		}
+
+		qualifiedTopUp, ok := big.NewInt(0).SetString(validator.QualifiedTopUp, 10)
+		if !ok {
+			continue
+		}
+		if threshold == nil || qualifiedTopUp.Cmp(threshold) < 0 {
+			threshold = qualifiedTopUp
+		}
+	}
+
+	if threshold == nil {
+		return unknownThreshold
+	}
+
+	return formatEGLD(threshold) + " EGLD"
+}
+
+func hasQualifiedNodes(validator *core.AuctionListValidator) bool {
+	for _, node := range validator.Nodes {
+		if node != nil && node.Qualified {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatTopUp(topUp string) string {
+	value, ok := big.NewInt(0).SetString(topUp, 10)
+	if !ok {
+		return unknownThreshold
+	}
+
+	return formatEGLD(value) + " EGLD"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *auctionChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
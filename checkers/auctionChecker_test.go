@@ -0,0 +1,177 @@
+package checkers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestAuctionListQuerier(auctionList ...*core.AuctionListValidator) AuctionListQuerier {
+	return &mock.AuctionListQuerierStub{
+		QueryHandler: func(ctx context.Context) ([]*core.AuctionListValidator, error) {
+			return auctionList, nil
+		},
+	}
+}
+
+func createTestAuctionList() []*core.AuctionListValidator {
+	return []*core.AuctionListValidator{
+		{
+			Owner:          "owner1",
+			QualifiedTopUp: egld(150).String(),
+			Nodes: []*core.AuctionNode{
+				{BlsKey: "key1", Qualified: true},
+				{BlsKey: "key2", Qualified: false},
+			},
+		},
+		{
+			Owner:          "owner2",
+			QualifiedTopUp: egld(120).String(),
+			Nodes: []*core.AuctionNode{
+				{BlsKey: "key3", Qualified: true},
+			},
+		},
+		{
+			Owner:          "owner3",
+			QualifiedTopUp: egld(50).String(),
+			Nodes: []*core.AuctionNode{
+				{BlsKey: "key4", Qualified: false},
+				nil,
+			},
+		},
+	}
+}
+
+func createMockArgsAuctionChecker() ArgsAuctionChecker {
+	return ArgsAuctionChecker{
+		Name:    "test",
+		Querier: &mock.AuctionListQuerierStub{},
+		Identities: &core.IdentitiesHolder{
+			BlsHexKeys: []string{"key1", "key2"},
+		},
+	}
+}
+
+func TestNewAuctionChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuctionChecker()
+		args.Querier = nil
+		checker, err := NewAuctionChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilAuctionListQuerier, err)
+	})
+	t.Run("nil identities holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuctionChecker()
+		args.Identities = nil
+		checker, err := NewAuctionChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewAuctionChecker(createMockArgsAuctionChecker())
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuctionChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *auctionChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &auctionChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestAuctionChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("querier error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsAuctionChecker()
+		args.Querier = &mock.AuctionListQuerierStub{
+			QueryHandler: func(ctx context.Context) ([]*core.AuctionListValidator, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewAuctionChecker(args)
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, results)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should report the monitored keys that are not qualified", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuctionChecker()
+		args.Querier = createTestAuctionListQuerier(createTestAuctionList()...)
+		checker, _ := NewAuctionChecker(args)
+		results, err := checker.Check(context.Background(), []string{"key3", "key4", "key5"})
+		assert.Nil(t, err)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key2",
+				Status:    "Not qualified in the staking auction: qualified top-up: 150.00 EGLD, threshold: 120.00 EGLD",
+			},
+			{
+				HexBLSKey: "key4",
+				Status:    "Not qualified in the staking auction: qualified top-up: 50.00 EGLD, threshold: 120.00 EGLD",
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("no qualified nodes should report an unknown threshold", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuctionChecker()
+		args.Querier = createTestAuctionListQuerier(&core.AuctionListValidator{
+			Owner:          "owner1",
+			QualifiedTopUp: "invalid",
+			Nodes: []*core.AuctionNode{
+				{BlsKey: "key1", Qualified: false},
+			},
+		})
+		checker, _ := NewAuctionChecker(args)
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, err)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key1",
+				Status:    "Not qualified in the staking auction: qualified top-up: n/a, threshold: n/a",
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("SetIdentities should replace the monitored keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuctionChecker()
+		args.Querier = createTestAuctionListQuerier(createTestAuctionList()...)
+		checker, _ := NewAuctionChecker(args)
+
+		err := checker.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+
+		err = checker.SetIdentities(&core.IdentitiesHolder{BlsHexKeys: []string{"key4"}})
+		assert.Nil(t, err)
+
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key4", results[0].HexBLSKey)
+	})
+}
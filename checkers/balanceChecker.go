@@ -0,0 +1,81 @@
+package checkers
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	lowBalanceMessageFormat     = "Balance below the threshold: balance: %s %s, threshold: %s %s"
+	balanceChangedMessageFormat = "Balance changed by more than %s %s: from %s %s to %s %s"
+)
+
+// ArgsBalanceChecker defines the DTO struct for the NewBalanceChecker constructor function. The amounts are expressed in
+// the smallest denomination of the token, a 0 value disabling the corresponding check
+type ArgsBalanceChecker struct {
+	MinBalance *big.Int
+	MaxChange  *big.Int
+	Decimals   uint32
+	Ticker     string
+}
+
+type balanceChecker struct {
+	minBalance *big.Int
+	maxChange  *big.Int
+	decimals   uint32
+	ticker     string
+}
+
+// NewBalanceChecker creates a new balance checker for one account and token
+func NewBalanceChecker(args ArgsBalanceChecker) (*balanceChecker, error) {
+	if args.MinBalance == nil || args.MaxChange == nil {
+		return nil, errNilBalanceThreshold
+	}
+	if args.MinBalance.Sign() < 0 {
+		return nil, fmt.Errorf("%w, minimum balance: %s", errInvalidBalanceThreshold, args.MinBalance.String())
+	}
+	if args.MaxChange.Sign() < 0 {
+		return nil, fmt.Errorf("%w, maximum change: %s", errInvalidBalanceThreshold, args.MaxChange.String())
+	}
+
+	return &balanceChecker{
+		minBalance: big.NewInt(0).Set(args.MinBalance),
+		maxChange:  big.NewInt(0).Set(args.MaxChange),
+		decimals:   args.Decimals,
+		ticker:     args.Ticker,
+	}, nil
+}
+
+// Check returns the problems of the current balance and the changes compared with the previous balance. The changes
+// are not computed if the previous balance is nil
+func (checker *balanceChecker) Check(previous *big.Int, current *big.Int) ([]string, []string) {
+	problems := make([]string, 0)
+	if checker.minBalance.Sign() > 0 && current.Cmp(checker.minBalance) < 0 {
+		problems = append(problems, fmt.Sprintf(lowBalanceMessageFormat,
+			checker.format(current), checker.ticker, checker.format(checker.minBalance), checker.ticker))
+	}
+
+	changes := make([]string, 0)
+	if previous == nil || checker.maxChange.Sign() == 0 {
+		return problems, changes
+	}
+
+	delta := big.NewInt(0).Sub(current, previous)
+	if delta.Abs(delta).Cmp(checker.maxChange) > 0 {
+		changes = append(changes, fmt.Sprintf(balanceChangedMessageFormat,
+			checker.format(checker.maxChange), checker.ticker,
+			checker.format(previous), checker.ticker,
+			checker.format(current), checker.ticker))
+	}
+
+	return problems, changes
+}
+
+func (checker *balanceChecker) format(value *big.Int) string {
+	return formatAmount(value, checker.decimals)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *balanceChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
@@ -0,0 +1,147 @@
+package checkers
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsBalanceChecker() ArgsBalanceChecker {
+	return ArgsBalanceChecker{
+		MinBalance: egld(1),
+		MaxChange:  egld(10),
+		Decimals:   egldDecimals,
+		Ticker:     "EGLD",
+	}
+}
+
+func TestNewBalanceChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MinBalance = nil
+		checker, err := NewBalanceChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilBalanceThreshold, err)
+	})
+	t.Run("nil maximum change should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MaxChange = nil
+		checker, err := NewBalanceChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilBalanceThreshold, err)
+	})
+	t.Run("negative minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MinBalance = big.NewInt(-1)
+		checker, err := NewBalanceChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidBalanceThreshold)
+		assert.Contains(t, err.Error(), "minimum balance")
+	})
+	t.Run("negative maximum change should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MaxChange = big.NewInt(-1)
+		checker, err := NewBalanceChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidBalanceThreshold)
+		assert.Contains(t, err.Error(), "maximum change")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewBalanceChecker(createMockArgsBalanceChecker())
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *balanceChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &balanceChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestBalanceChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy balance should not report", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewBalanceChecker(createMockArgsBalanceChecker())
+		problems, changes := checker.Check(egld(20), egld(15))
+		assert.Empty(t, problems)
+		assert.Empty(t, changes)
+
+		problems, changes = checker.Check(nil, egld(1))
+		assert.Empty(t, problems)
+		assert.Empty(t, changes)
+	})
+	t.Run("balance below the threshold should report a problem", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewBalanceChecker(createMockArgsBalanceChecker())
+		problems, changes := checker.Check(nil, big.NewInt(500000000000000000))
+		assert.Equal(t, []string{"Balance below the threshold: balance: 0.50 EGLD, threshold: 1.00 EGLD"}, problems)
+		assert.Empty(t, changes)
+	})
+	t.Run("0 minimum balance should disable the threshold check", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MinBalance = big.NewInt(0)
+		checker, _ := NewBalanceChecker(args)
+		problems, _ := checker.Check(nil, big.NewInt(0))
+		assert.Empty(t, problems)
+	})
+	t.Run("large change should be reported in both directions", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewBalanceChecker(createMockArgsBalanceChecker())
+		problems, changes := checker.Check(egld(100), egld(89))
+		assert.Empty(t, problems)
+		assert.Equal(t, []string{"Balance changed by more than 10.00 EGLD: from 100.00 EGLD to 89.00 EGLD"}, changes)
+
+		_, changes = checker.Check(egld(89), egld(100))
+		assert.Equal(t, []string{"Balance changed by more than 10.00 EGLD: from 89.00 EGLD to 100.00 EGLD"}, changes)
+
+		_, changes = checker.Check(egld(100), egld(90))
+		assert.Empty(t, changes)
+	})
+	t.Run("0 maximum change should disable the change check", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceChecker()
+		args.MaxChange = big.NewInt(0)
+		checker, _ := NewBalanceChecker(args)
+		_, changes := checker.Check(egld(100), egld(2))
+		assert.Empty(t, changes)
+	})
+	t.Run("should use the token decimals", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsBalanceChecker{
+			MinBalance: big.NewInt(10000000),
+			MaxChange:  big.NewInt(0),
+			Decimals:   6,
+			Ticker:     "USDC-c76f1f",
+		}
+		checker, _ := NewBalanceChecker(args)
+		problems, _ := checker.Check(nil, big.NewInt(2500000))
+		assert.Equal(t, []string{"Balance below the threshold: balance: 2.50 USDC-c76f1f, threshold: 10.00 USDC-c76f1f"}, problems)
+	})
+}
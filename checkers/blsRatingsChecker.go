@@ -2,50 +2,83 @@ package checkers
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 const minAlarmDeltaRatingDrop = float64(0.0)
 const maxAlarmDeltaRatingDrop = float64(100.0)
-const jailThreshold = float32(10)
+const minJailThreshold = float64(0.0)
+const maxJailThreshold = float64(100.0)
+const defaultJailThreshold = float32(10)
 const ratingDropMessageFormat = "Rating drop detected: temp rating: %0.2f, rating: %0.2f"
 const imminentJailMessageFormat = "Imminent jail: temp rating: %0.2f, rating: %0.2f"
 
 var log = logger.GetOrCreate("checkers")
 
-type blsRatingsChecker struct {
-	name                 string
-	hexBlsKeys           []string
-	alarmDeltaRatingDrop float32
+// ArgsBLSRatingsChecker defines the DTO struct for the NewBLSRatingsChecker constructor function
+type ArgsBLSRatingsChecker struct {
+	Name               string
+	Identities         *core.IdentitiesHolder
+	ThresholdsResolver ThresholdsResolver
 }
 
-// NewBLSRatingsChecker creates a new instance of type blsRatingsChecker
-func NewBLSRatingsChecker(hexBlsKeys []string, name string, alarmDeltaRatingDrop float64) (*blsRatingsChecker, error) {
-	log.Debug("NewBLSRatingsChecker", "checker name", name, "num initial keys", len(hexBlsKeys))
+type blsRatingsChecker struct {
+	name               string
+	thresholdsResolver ThresholdsResolver
+	mutIdentities      sync.RWMutex
+	hexBlsKeys         []string
+}
 
-	if alarmDeltaRatingDrop < minAlarmDeltaRatingDrop || alarmDeltaRatingDrop > maxAlarmDeltaRatingDrop {
-		return nil, fmt.Errorf("%w, allowed interval [%0.2f, %0.2f]", errInvalidAlarmDeltaRatingDrop, minAlarmDeltaRatingDrop, maxAlarmDeltaRatingDrop)
+// NewBLSRatingsChecker creates a new instance of type blsRatingsChecker. The thresholds and the enabled checks of each
+// BLS key are provided by the thresholds resolver at check time
+func NewBLSRatingsChecker(args ArgsBLSRatingsChecker) (*blsRatingsChecker, error) {
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+	if check.IfNil(args.ThresholdsResolver) {
+		return nil, errNilThresholdsResolver
 	}
 
+	log.Debug("NewBLSRatingsChecker", "checker name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys))
+
 	return &blsRatingsChecker{
-		name:                 name,
-		hexBlsKeys:           hexBlsKeys,
-		alarmDeltaRatingDrop: float32(alarmDeltaRatingDrop),
+		name:               args.Name,
+		thresholdsResolver: args.ThresholdsResolver,
+		hexBlsKeys:         args.Identities.BlsHexKeys,
 	}, nil
 }
 
+// SetIdentities will atomically replace the monitored BLS keys
+func (checker *blsRatingsChecker) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	checker.mutIdentities.Lock()
+	checker.hexBlsKeys = identities.BlsHexKeys
+	checker.mutIdentities.Unlock()
+
+	log.Debug("blsRatingsChecker.SetIdentities", "checker name", checker.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
 // Check will check all containing BLS keys if there is a rating drop for that key. It returns the list of BLS keys with rating drop or if an error occurred
 func (checker *blsRatingsChecker) Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.CheckResponse, error) {
 	if statistics == nil {
 		return nil, errNilMapProvided
 	}
 
-	allKeys := checker.hexBlsKeys
-	if len(extraBLSKeys) > 0 {
-		allKeys = append(allKeys, extraBLSKeys...)
-	}
+	checker.mutIdentities.RLock()
+	defer checker.mutIdentities.RUnlock()
+
+	allKeys := make([]string, 0, len(checker.hexBlsKeys)+len(extraBLSKeys))
+	allKeys = append(allKeys, checker.hexBlsKeys...)
+	allKeys = append(allKeys, extraBLSKeys...)
 
 	log.Debug("blsRatingsChecker.Check", "checker name", checker.name, "num keys", len(allKeys))
 
@@ -60,18 +93,24 @@ func (checker *blsRatingsChecker) Check(statistics map[string]*core.ValidatorSta
 		if !found {
 			continue
 		}
-		if stats.TempRating < jailThreshold {
+
+		keyThresholds := checker.thresholdsResolver.GetThresholds(blsKey)
+		if keyThresholds.ImminentJailEnabled && stats.TempRating < keyThresholds.JailThreshold {
 			log.Debug("found imminent jail node", "checker", checker.name, "bls key", blsKey)
 			results = append(results, core.CheckResponse{
 				HexBLSKey: blsKey,
 				Status:    fmt.Sprintf(imminentJailMessageFormat, stats.TempRating, stats.Rating),
+				Check:     core.ImminentJailCheck,
 			})
 			continue
 		}
+		if !keyThresholds.RatingDropEnabled {
+			continue
+		}
 		if stats.TempRating >= stats.Rating {
 			continue
 		}
-		if stats.TempRating+checker.alarmDeltaRatingDrop > stats.Rating {
+		if stats.TempRating+keyThresholds.AlarmDeltaRatingDrop > stats.Rating {
 			continue
 		}
 
@@ -79,6 +118,7 @@ func (checker *blsRatingsChecker) Check(statistics map[string]*core.ValidatorSta
 		results = append(results, core.CheckResponse{
 			HexBLSKey: blsKey,
 			Status:    fmt.Sprintf(ratingDropMessageFormat, stats.TempRating, stats.Rating),
+			Check:     core.RatingDropCheck,
 		})
 	}
 
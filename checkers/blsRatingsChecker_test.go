@@ -8,41 +8,78 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func createTestArgsThresholdsResolver(
+	hexBlsKeys []string,
+	alarmDeltaRatingDrop float64,
+	alarmDeltaRatingDropOverrides map[string]float64,
+) ArgsThresholdsResolver {
+	overrides := make(map[string]core.ThresholdsOverride, len(alarmDeltaRatingDropOverrides))
+	for blsKey, value := range alarmDeltaRatingDropOverrides {
+		overrides[blsKey] = core.ThresholdsOverride{AlarmDeltaRatingDrop: floatPointer(value)}
+	}
+
+	return ArgsThresholdsResolver{
+		Name:                 "test",
+		AlarmDeltaRatingDrop: alarmDeltaRatingDrop,
+		Identities: &core.IdentitiesHolder{
+			BlsHexKeys: hexBlsKeys,
+			Overrides:  overrides,
+		},
+	}
+}
+
+func createTestBLSRatingsChecker(args ArgsThresholdsResolver) *blsRatingsChecker {
+	resolver, _ := NewThresholdsResolver(args)
+	instance, _ := NewBLSRatingsChecker(ArgsBLSRatingsChecker{
+		Name:               args.Name,
+		Identities:         args.Identities,
+		ThresholdsResolver: resolver,
+	})
+
+	return instance
+}
+
+func floatPointer(value float64) *float64 {
+	return &value
+}
+
 func TestNewBLSRatingsChecker(t *testing.T) {
 	t.Parallel()
 
-	t.Run("invalid values for alarmDeltaRatingDrop", func(t *testing.T) {
+	t.Run("nil identities should error", func(t *testing.T) {
 		t.Parallel()
 
-		hexBlsKeys := []string{"bls1", "bls2"}
-		instance, err := NewBLSRatingsChecker(hexBlsKeys, "test", -0.00001)
-		assert.Nil(t, instance)
-		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
-
-		instance, err = NewBLSRatingsChecker(hexBlsKeys, "test", -100)
+		instance, err := NewBLSRatingsChecker(ArgsBLSRatingsChecker{
+			Name:               "test",
+			ThresholdsResolver: &thresholdsResolver{},
+		})
 		assert.Nil(t, instance)
-		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
-
-		instance, err = NewBLSRatingsChecker(hexBlsKeys, "test", 100.000001)
-		assert.Nil(t, instance)
-		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("nil thresholds resolver should error", func(t *testing.T) {
+		t.Parallel()
 
-		instance, err = NewBLSRatingsChecker(hexBlsKeys, "test", 50000)
+		instance, err := NewBLSRatingsChecker(ArgsBLSRatingsChecker{
+			Name:       "test",
+			Identities: &core.IdentitiesHolder{},
+		})
 		assert.Nil(t, instance)
-		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		assert.Equal(t, errNilThresholdsResolver, err)
 	})
 	t.Run("should work with 0 keys", func(t *testing.T) {
 		t.Parallel()
 
-		instance, err := NewBLSRatingsChecker(nil, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver(nil, 1.0, nil))
 		assert.NotNil(t, instance)
-		assert.Nil(t, err)
 	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		hexBlsKeys := []string{"bls1", "bls2"}
-		instance, err := NewBLSRatingsChecker(hexBlsKeys, "test", 1.0)
+		instance, err := NewBLSRatingsChecker(ArgsBLSRatingsChecker{
+			Name:               "test",
+			Identities:         &core.IdentitiesHolder{BlsHexKeys: []string{"bls1", "bls2"}},
+			ThresholdsResolver: &thresholdsResolver{},
+		})
 		assert.NotNil(t, instance)
 		assert.Nil(t, err)
 	})
@@ -101,7 +138,7 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("nil map should error", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls1", "bls2"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls1", "bls2"}, 1.0, nil))
 		response, err := instance.Check(nil, nil)
 		assert.Equal(t, errNilMapProvided, err)
 		assert.Nil(t, response)
@@ -109,7 +146,7 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key is not a validator should not signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls_not_found"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls_not_found"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		assert.Empty(t, response)
@@ -117,17 +154,19 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("under the jail threshold should signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls1", "bls2"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls1", "bls2"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		expectedCheckResponse := []core.CheckResponse{
 			{
 				HexBLSKey: "bls1",
 				Status:    fmt.Sprintf(imminentJailMessageFormat, 0.0, 0.0),
+				Check:     core.ImminentJailCheck,
 			},
 			{
 				HexBLSKey: "bls2",
 				Status:    fmt.Sprintf(imminentJailMessageFormat, 9.99, 50.0),
+				Check:     core.ImminentJailCheck,
 			},
 		}
 		assert.Equal(t, expectedCheckResponse, response)
@@ -135,7 +174,7 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key same rating should not signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls4", "bls10"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls4", "bls10"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		assert.Empty(t, response)
@@ -143,7 +182,7 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key rating increase not signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls5", "bls6"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls5", "bls6"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		assert.Empty(t, response)
@@ -151,7 +190,7 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key rating drop above limit should not signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls7"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls7"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		assert.Empty(t, response)
@@ -159,21 +198,140 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key rating drop beyond limit should signal", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker([]string{"bls3", "bls8", "bls9"}, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls3", "bls8", "bls9"}, 1.0, nil))
 		response, err := instance.Check(testMap, nil)
 		assert.Nil(t, err)
 		expectedCheckResponse := []core.CheckResponse{
 			{
 				HexBLSKey: "bls3",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 10.0, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+			{
+				HexBLSKey: "bls8",
+				Status:    fmt.Sprintf(ratingDropMessageFormat, 48.999, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+			{
+				HexBLSKey: "bls9",
+				Status:    fmt.Sprintf(ratingDropMessageFormat, 49.0, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+		}
+		assert.Equal(t, expectedCheckResponse, response)
+	})
+	t.Run("bls key rating drop should use the overridden limit", func(t *testing.T) {
+		t.Parallel()
+
+		overrides := map[string]float64{
+			"bls3": 50,
+			"bls8": 2,
+		}
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls3", "bls8"}, 1.0, overrides))
+		response, err := instance.Check(testMap, []string{"bls9"})
+		assert.Nil(t, err)
+		expectedCheckResponse := []core.CheckResponse{
+			{
+				HexBLSKey: "bls9",
+				Status:    fmt.Sprintf(ratingDropMessageFormat, 49.0, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+		}
+		assert.Equal(t, expectedCheckResponse, response)
+	})
+	t.Run("the overrides should be resolved by priority", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver([]string{"bls2", "bls3", "bls8", "bls9"}, 1.0, map[string]float64{"bls8": 0.5})
+		args.Identities.Metadata = map[string]core.IdentityMetadata{
+			"bls2": {Label: "backup"},
+			"bls3": {Label: "main"},
+			"bls8": {Label: "main"},
+			"bls9": {Label: "main"},
+		}
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			// only the peers comparison is enabled, it is not done by this checker
+			"backup": {EnabledChecks: []string{core.PeersRatingCheck}},
+			"main":   {AlarmDeltaRatingDrop: floatPointer(2), JailThreshold: floatPointer(20)},
+		}
+		args.KeyOverrides = map[string]core.ThresholdsOverride{
+			"bls9": {AlarmDeltaRatingDrop: floatPointer(0.5), EnabledChecks: []string{core.RatingDropCheck}},
+		}
+		instance := createTestBLSRatingsChecker(args)
+
+		response, err := instance.Check(testMap, nil)
+		assert.Nil(t, err)
+		expectedCheckResponse := []core.CheckResponse{
+			// the label's jail threshold
+			{
+				HexBLSKey: "bls3",
+				Status:    fmt.Sprintf(imminentJailMessageFormat, 10.0, 50.0),
+				Check:     core.ImminentJailCheck,
 			},
+			// the rating drop threshold from the identities file has priority over the label's one
 			{
 				HexBLSKey: "bls8",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 48.999, 50.0),
+				Check:     core.RatingDropCheck,
 			},
+			// the key override has the highest priority
 			{
 				HexBLSKey: "bls9",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 49.0, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+		}
+		assert.Equal(t, expectedCheckResponse, response)
+	})
+	t.Run("the identities overrides should be merged with the key overrides", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver([]string{"bls3", "bls9"}, 1.0, nil)
+		args.Identities.Overrides = map[string]core.ThresholdsOverride{
+			"bls3": {JailThreshold: floatPointer(20), EnabledChecks: []string{core.NoChecks}},
+			"bls9": {AlarmDeltaRatingDrop: floatPointer(0.5)},
+		}
+		args.KeyOverrides = map[string]core.ThresholdsOverride{
+			"bls3": {EnabledChecks: []string{core.ImminentJailCheck}},
+			"bls9": {JailThreshold: floatPointer(5)},
+		}
+		instance := createTestBLSRatingsChecker(args)
+
+		response, err := instance.Check(testMap, nil)
+		assert.Nil(t, err)
+		expectedCheckResponse := []core.CheckResponse{
+			// the jail threshold from the identities file and the enabled checks from the key override
+			{
+				HexBLSKey: "bls3",
+				Status:    fmt.Sprintf(imminentJailMessageFormat, 10.0, 50.0),
+				Check:     core.ImminentJailCheck,
+			},
+			// the rating drop threshold from the identities file
+			{
+				HexBLSKey: "bls9",
+				Status:    fmt.Sprintf(ratingDropMessageFormat, 49.0, 50.0),
+				Check:     core.RatingDropCheck,
+			},
+		}
+		assert.Equal(t, expectedCheckResponse, response)
+	})
+	t.Run("disabled imminent jail check should still check the rating drop", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver([]string{"bls1", "bls2"}, 1.0, nil)
+		args.KeyOverrides = map[string]core.ThresholdsOverride{
+			"bls1": {EnabledChecks: []string{core.RatingDropCheck}},
+			"bls2": {EnabledChecks: []string{core.RatingDropCheck}},
+		}
+		instance := createTestBLSRatingsChecker(args)
+
+		response, err := instance.Check(testMap, nil)
+		assert.Nil(t, err)
+		expectedCheckResponse := []core.CheckResponse{
+			{
+				HexBLSKey: "bls2",
+				Status:    fmt.Sprintf(ratingDropMessageFormat, 9.99, 50.0),
+				Check:     core.RatingDropCheck,
 			},
 		}
 		assert.Equal(t, expectedCheckResponse, response)
@@ -181,27 +339,66 @@ func TestBlsRatingsChecker_Check(t *testing.T) {
 	t.Run("bls key rating drop beyond limit should signal on extra keys", func(t *testing.T) {
 		t.Parallel()
 
-		instance, _ := NewBLSRatingsChecker(nil, "test", 1.0)
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver(nil, 1.0, nil))
 		response, err := instance.Check(testMap, []string{"bls3", "bls8", "bls9", ""})
 		assert.Nil(t, err)
 		expectedCheckResponse := []core.CheckResponse{
 			{
 				HexBLSKey: "bls3",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 10.0, 50.0),
+				Check:     core.RatingDropCheck,
 			},
 			{
 				HexBLSKey: "bls8",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 48.999, 50.0),
+				Check:     core.RatingDropCheck,
 			},
 			{
 				HexBLSKey: "bls9",
 				Status:    fmt.Sprintf(ratingDropMessageFormat, 49.0, 50.0),
+				Check:     core.RatingDropCheck,
 			},
 		}
 		assert.Equal(t, expectedCheckResponse, response)
 	})
 }
 
+func TestBlsRatingsChecker_SetIdentities(t *testing.T) {
+	t.Parallel()
+
+	testMap := map[string]*core.ValidatorStatistics{
+		"bls1": {
+			TempRating: 48,
+			Rating:     50,
+		},
+		"bls2": {
+			TempRating: 48,
+			Rating:     50,
+		},
+	}
+
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls1"}, 1.0, nil))
+		err := instance.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("should replace the identities", func(t *testing.T) {
+		t.Parallel()
+
+		instance := createTestBLSRatingsChecker(createTestArgsThresholdsResolver([]string{"bls1"}, 1.0, nil))
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			BlsHexKeys: []string{"bls2"},
+		})
+		assert.Nil(t, err)
+
+		response, _ := instance.Check(testMap, nil)
+		assert.Equal(t, 1, len(response))
+		assert.Equal(t, "bls2", response[0].HexBLSKey)
+	})
+}
+
 func TestBlsRatingsChecker_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
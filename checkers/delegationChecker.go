@@ -0,0 +1,90 @@
+package checkers
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const (
+	serviceFeeChangedMessageFormat = "Service fee changed: from %s%% to %s%%"
+	numNodesChangedMessageFormat   = "Number of nodes changed: from %d to %d"
+	capReachedMessageFormat        = "Delegation cap reached: total active stake: %s EGLD, delegation cap: %s EGLD"
+	lowActiveStakeMessageFormat    = "Active stake below the threshold: total active stake: %s EGLD, nodes: %d, required: %s EGLD"
+	egldDecimals                   = 18
+	serviceFeeDenominator          = 100
+)
+
+type delegationChecker struct {
+	minStakePerNode *big.Int
+}
+
+// NewDelegationChecker creates a new delegation contract checker. The minimum stake per node is expressed in the
+// smallest denomination, a 0 value disabling the active stake check
+func NewDelegationChecker(minStakePerNode *big.Int) (*delegationChecker, error) {
+	if minStakePerNode == nil {
+		return nil, errNilMinStakePerNode
+	}
+	if minStakePerNode.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidMinStakePerNode, minStakePerNode.String())
+	}
+
+	return &delegationChecker{
+		minStakePerNode: big.NewInt(0).Set(minStakePerNode),
+	}, nil
+}
+
+// Check returns the problems of the delegation contract's current state and the changes compared with the previous
+// state. The changes are not computed if the previous state is nil
+func (checker *delegationChecker) Check(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+	problems := make([]string, 0)
+	if current.WithDelegationCap && current.MaxDelegationCap.Sign() > 0 && current.TotalActiveStake.Cmp(current.MaxDelegationCap) >= 0 {
+		problems = append(problems, fmt.Sprintf(capReachedMessageFormat,
+			formatEGLD(current.TotalActiveStake), formatEGLD(current.MaxDelegationCap)))
+	}
+
+	requiredStake := big.NewInt(0).Mul(checker.minStakePerNode, big.NewInt(0).SetUint64(current.NumNodes))
+	if current.TotalActiveStake.Cmp(requiredStake) < 0 {
+		problems = append(problems, fmt.Sprintf(lowActiveStakeMessageFormat,
+			formatEGLD(current.TotalActiveStake), current.NumNodes, formatEGLD(requiredStake)))
+	}
+
+	changes := make([]string, 0)
+	if previous == nil {
+		return problems, changes
+	}
+	if previous.ServiceFee != current.ServiceFee {
+		changes = append(changes, fmt.Sprintf(serviceFeeChangedMessageFormat,
+			formatServiceFee(previous.ServiceFee), formatServiceFee(current.ServiceFee)))
+	}
+	if previous.NumNodes != current.NumNodes {
+		changes = append(changes, fmt.Sprintf(numNodesChangedMessageFormat, previous.NumNodes, current.NumNodes))
+	}
+
+	return problems, changes
+}
+
+// formatEGLD converts the amount from the smallest denomination and formats it with 2 decimals
+func formatEGLD(value *big.Int) string {
+	return formatAmount(value, egldDecimals)
+}
+
+// formatAmount converts the amount from the smallest denomination, using the provided number of decimals, and formats
+// it with 2 decimals
+func formatAmount(value *big.Int, decimals uint32) string {
+	denomination := big.NewFloat(0).SetInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	amount := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(value), denomination)
+
+	return amount.Text('f', 2)
+}
+
+// formatServiceFee formats the service fee, expressed in hundredths of percent, as a percent with 2 decimals
+func formatServiceFee(serviceFee uint64) string {
+	return fmt.Sprintf("%d.%02d", serviceFee/serviceFeeDenominator, serviceFee%serviceFeeDenominator)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *delegationChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
@@ -0,0 +1,126 @@
+package checkers
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func egld(value int64) *big.Int {
+	oneEGLD := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(egldDecimals), nil)
+
+	return big.NewInt(0).Mul(big.NewInt(value), oneEGLD)
+}
+
+func createTestDelegationState() *core.DelegationContractState {
+	return &core.DelegationContractState{
+		ServiceFee:        1000,
+		MaxDelegationCap:  egld(20000),
+		WithDelegationCap: true,
+		TotalActiveStake:  egld(10000),
+		NumNodes:          3,
+	}
+}
+
+func TestNewDelegationChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil minimum stake per node should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewDelegationChecker(nil)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilMinStakePerNode, err)
+	})
+	t.Run("negative minimum stake per node should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewDelegationChecker(big.NewInt(-1))
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidMinStakePerNode)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewDelegationChecker(egld(2500))
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestDelegationChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *delegationChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &delegationChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDelegationChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy contract without previous state should not report", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewDelegationChecker(egld(2500))
+		problems, changes := checker.Check(nil, createTestDelegationState())
+		assert.Empty(t, problems)
+		assert.Empty(t, changes)
+	})
+	t.Run("delegation cap reached should report", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewDelegationChecker(egld(2500))
+		state := createTestDelegationState()
+		state.TotalActiveStake = egld(20000)
+		problems, changes := checker.Check(nil, state)
+		assert.Equal(t, []string{"Delegation cap reached: total active stake: 20000.00 EGLD, delegation cap: 20000.00 EGLD"}, problems)
+		assert.Empty(t, changes)
+
+		state.WithDelegationCap = false
+		problems, _ = checker.Check(nil, state)
+		assert.Empty(t, problems)
+
+		state.WithDelegationCap = true
+		state.MaxDelegationCap = big.NewInt(0)
+		problems, _ = checker.Check(nil, state)
+		assert.Empty(t, problems)
+	})
+	t.Run("active stake below the threshold should report", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewDelegationChecker(egld(2500))
+		state := createTestDelegationState()
+		state.TotalActiveStake = big.NewInt(0).Sub(egld(7500), egld(1))
+		state.TotalActiveStake.Add(state.TotalActiveStake, big.NewInt(500000000000000000))
+		problems, _ := checker.Check(nil, state)
+		assert.Equal(t, []string{"Active stake below the threshold: total active stake: 7499.50 EGLD, nodes: 3, required: 7500.00 EGLD"}, problems)
+
+		checker, _ = NewDelegationChecker(big.NewInt(0))
+		problems, _ = checker.Check(nil, state)
+		assert.Empty(t, problems)
+	})
+	t.Run("changes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewDelegationChecker(egld(2500))
+		previous := createTestDelegationState()
+		current := createTestDelegationState()
+		current.ServiceFee = 1205
+		current.NumNodes = 4
+		problems, changes := checker.Check(previous, current)
+		assert.Empty(t, problems)
+		expectedChanges := []string{
+			"Service fee changed: from 10.00% to 12.05%",
+			"Number of nodes changed: from 3 to 4",
+		}
+		assert.Equal(t, expectedChanges, changes)
+
+		_, changes = checker.Check(current, current)
+		assert.Empty(t, changes)
+	})
+}
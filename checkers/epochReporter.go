@@ -0,0 +1,105 @@
+package checkers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const epochReportMessageFormat = "Epoch report: %s, final rating: %0.2f, status: %s, " +
+	"proposed blocks: %d ok / %d failed, signatures: %d ok / %d failed / %d ignored"
+const unknownValidatorStatus = "n/a"
+
+// ArgsEpochReporter defines the DTO struct for the NewEpochReporter constructor function
+type ArgsEpochReporter struct {
+	Name       string
+	Identities *core.IdentitiesHolder
+}
+
+type epochReporter struct {
+	name          string
+	mutIdentities sync.RWMutex
+	hexBlsKeys    []string
+}
+
+// NewEpochReporter creates a new instance of type epochReporter
+func NewEpochReporter(args ArgsEpochReporter) (*epochReporter, error) {
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+
+	log.Debug("NewEpochReporter", "reporter name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys))
+
+	return &epochReporter{
+		name:       args.Name,
+		hexBlsKeys: args.Identities.BlsHexKeys,
+	}, nil
+}
+
+// SetIdentities will atomically replace the monitored BLS keys
+func (reporter *epochReporter) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	reporter.mutIdentities.Lock()
+	reporter.hexBlsKeys = identities.BlsHexKeys
+	reporter.mutIdentities.Unlock()
+
+	log.Debug("epochReporter.SetIdentities", "reporter name", reporter.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
+// Report returns, for each monitored BLS key, the shard, the rating, the status and the signature counters found in the
+// provided validator statistics. The temp rating is reported as the final rating since it becomes the rating at the end
+// of the epoch. The BLS keys not found in the statistics are not reported
+func (reporter *epochReporter) Report(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+	reporter.mutIdentities.RLock()
+	allKeys := make([]string, 0, len(reporter.hexBlsKeys)+len(extraBLSKeys))
+	allKeys = append(allKeys, reporter.hexBlsKeys...)
+	reporter.mutIdentities.RUnlock()
+	allKeys = append(allKeys, extraBLSKeys...)
+
+	results := make([]core.CheckResponse, 0, len(allKeys))
+	reportedKeys := make(map[string]struct{}, len(allKeys))
+	for _, blsKey := range allKeys {
+		_, alreadyReported := reportedKeys[blsKey]
+		if alreadyReported {
+			continue
+		}
+
+		stats, found := statistics[blsKey]
+		if !found || stats == nil {
+			continue
+		}
+
+		reportedKeys[blsKey] = struct{}{}
+		results = append(results, core.CheckResponse{
+			HexBLSKey: blsKey,
+			Status:    createEpochReport(stats),
+		})
+	}
+
+	log.Debug("epochReporter.Report", "reporter name", reporter.name, "num keys", len(allKeys),
+		"num reported keys", len(results))
+
+	return results
+}
+
+func createEpochReport(stats *core.ValidatorStatistics) string {
+	status := stats.ValidatorStatus
+	if len(status) == 0 {
+		status = unknownValidatorStatus
+	}
+
+	return fmt.Sprintf(epochReportMessageFormat, core.ShardName(stats.ShardID), stats.TempRating, status,
+		stats.NumLeaderSuccess, stats.NumLeaderFailure,
+		stats.NumValidatorSuccess, stats.NumValidatorFailure, stats.NumValidatorIgnoredSignatures)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reporter *epochReporter) IsInterfaceNil() bool {
+	return reporter == nil
+}
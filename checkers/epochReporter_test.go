@@ -0,0 +1,119 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsEpochReporter() ArgsEpochReporter {
+	return ArgsEpochReporter{
+		Name: "test",
+		Identities: &core.IdentitiesHolder{
+			BlsHexKeys: []string{"key1", "key2"},
+		},
+	}
+}
+
+func TestNewEpochReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEpochReporter()
+		args.Identities = nil
+		reporter, err := NewEpochReporter(args)
+		assert.Nil(t, reporter)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, err := NewEpochReporter(createMockArgsEpochReporter())
+		assert.NotNil(t, reporter)
+		assert.Nil(t, err)
+	})
+}
+
+func TestEpochReporter_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *epochReporter
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &epochReporter{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestEpochReporter_Report(t *testing.T) {
+	t.Parallel()
+
+	statistics := map[string]*core.ValidatorStatistics{
+		"key1": {
+			TempRating:                    98.5,
+			Rating:                        100,
+			ShardID:                       core.MetachainShardID,
+			ValidatorStatus:               "eligible",
+			NumLeaderSuccess:              3,
+			NumLeaderFailure:              1,
+			NumValidatorSuccess:           1200,
+			NumValidatorFailure:           2,
+			NumValidatorIgnoredSignatures: 5,
+		},
+		"key3": {
+			TempRating: 50,
+			Rating:     50,
+			ShardID:    2,
+		},
+		"key4": {
+			TempRating:      100,
+			Rating:          100,
+			ValidatorStatus: "waiting",
+		},
+	}
+
+	t.Run("should report the monitored keys found in the statistics", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, _ := NewEpochReporter(createMockArgsEpochReporter())
+		results := reporter.Report(statistics, []string{"key3", "key1"})
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key1",
+				Status: "Epoch report: metachain, final rating: 98.50, status: eligible, " +
+					"proposed blocks: 3 ok / 1 failed, signatures: 1200 ok / 2 failed / 5 ignored",
+			},
+			{
+				HexBLSKey: "key3",
+				Status: "Epoch report: shard 2, final rating: 50.00, status: n/a, " +
+					"proposed blocks: 0 ok / 0 failed, signatures: 0 ok / 0 failed / 0 ignored",
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("set identities should replace the monitored keys", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, _ := NewEpochReporter(createMockArgsEpochReporter())
+		err := reporter.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+
+		err = reporter.SetIdentities(&core.IdentitiesHolder{
+			BlsHexKeys: []string{"key4"},
+		})
+		assert.Nil(t, err)
+
+		results := reporter.Report(statistics, nil)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key4", results[0].HexBLSKey)
+	})
+	t.Run("no statistics should not report", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, _ := NewEpochReporter(createMockArgsEpochReporter())
+		results := reporter.Report(nil, nil)
+		assert.Empty(t, results)
+	})
+}
@@ -3,6 +3,28 @@ package checkers
 import "errors"
 
 var (
-	errInvalidAlarmDeltaRatingDrop = errors.New("invalid value for AlarmDeltaRatingDrop")
-	errNilMapProvided              = errors.New("nil map provided")
+	errInvalidAlarmDeltaRatingDrop   = errors.New("invalid value for AlarmDeltaRatingDrop")
+	errNilMapProvided                = errors.New("nil map provided")
+	errInvalidDegradedPercent        = errors.New("invalid value for the network-wide degraded percent")
+	errNilIdentitiesHolder           = errors.New("nil identities holder")
+	errInvalidJailThreshold          = errors.New("invalid value for JailThreshold")
+	errInvalidEnabledChecks          = errors.New("invalid enabled checks")
+	errNoStatisticsSources           = errors.New("no additional validator statistics sources")
+	errNilValidatorStatisticsQuerier = errors.New("nil validator statistics querier")
+	errNilRatingsChecker             = errors.New("nil ratings checker")
+	errInvalidQuorum                 = errors.New("invalid quorum")
+	errNilHeartbeatStatusQuerier     = errors.New("nil heartbeat status querier")
+	errInvalidPeerType               = errors.New("invalid peer type")
+	errNilMinStakePerNode            = errors.New("nil minimum stake per node")
+	errInvalidMinStakePerNode        = errors.New("invalid minimum stake per node")
+	errNilBalanceThreshold           = errors.New("nil balance threshold")
+	errInvalidBalanceThreshold       = errors.New("invalid balance threshold")
+	errNilAuctionListQuerier         = errors.New("nil auction list querier")
+	errInvalidWorstPercentile        = errors.New("invalid value for WorstPercentile")
+	errInvalidStandardDeviations     = errors.New("invalid value for StandardDeviations")
+	errNoPeersComparisonCriteria     = errors.New("no peers comparison criteria, WorstPercentile or StandardDeviations should be set")
+	errInvalidMinGroupSize           = errors.New("invalid value for MinGroupSize")
+	errNilThresholdsResolver         = errors.New("nil thresholds resolver")
+	errUndefinedOverrideLabel        = errors.New("the overridden label is not defined in the identities")
+	errInvalidExpectedAppVersion     = errors.New("invalid expected app version")
 )
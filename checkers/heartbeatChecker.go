@@ -0,0 +1,174 @@
+package checkers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const offlineMessageFormat = "Offline: node name: %s, peer type: %s"
+const unexpectedPeerTypeMessageFormat = "Unexpected peer type: %s, expected: %s"
+const heartbeatVersionMismatchMessageFormat = "App version mismatch: running: %s, expected: %s"
+
+// knownPeerTypes holds the peer types reported by the heartbeat status endpoint
+var knownPeerTypes = map[string]struct{}{
+	"eligible": {},
+	"waiting":  {},
+	"new":      {},
+	"jailed":   {},
+	"leaving":  {},
+	"inactive": {},
+	"observer": {},
+}
+
+// ArgsHeartbeatChecker defines the DTO struct for the NewHeartbeatChecker constructor function
+type ArgsHeartbeatChecker struct {
+	Name               string
+	Querier            HeartbeatStatusQuerier
+	Identities         *core.IdentitiesHolder
+	ExpectedAppVersion string
+	ExpectedPeerTypes  []string
+}
+
+type heartbeatChecker struct {
+	name               string
+	querier            HeartbeatStatusQuerier
+	expectedAppVersion string
+	expectedPeerTypes  map[string]struct{}
+	mutIdentities      sync.RWMutex
+	hexBlsKeys         []string
+}
+
+// NewHeartbeatChecker creates a new instance of type heartbeatChecker. An empty expected app version or an empty list
+// of expected peer types disable the corresponding check, the offline keys are always reported
+func NewHeartbeatChecker(args ArgsHeartbeatChecker) (*heartbeatChecker, error) {
+	if check.IfNil(args.Querier) {
+		return nil, errNilHeartbeatStatusQuerier
+	}
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+
+	expectedPeerTypes := make(map[string]struct{}, len(args.ExpectedPeerTypes))
+	for _, peerType := range args.ExpectedPeerTypes {
+		_, isKnown := knownPeerTypes[peerType]
+		if !isKnown {
+			return nil, fmt.Errorf("%w: %s", errInvalidPeerType, peerType)
+		}
+
+		expectedPeerTypes[peerType] = struct{}{}
+	}
+
+	log.Debug("NewHeartbeatChecker", "checker name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys),
+		"expected app version", args.ExpectedAppVersion, "expected peer types", strings.Join(args.ExpectedPeerTypes, ", "))
+
+	return &heartbeatChecker{
+		name:               args.Name,
+		querier:            args.Querier,
+		expectedAppVersion: args.ExpectedAppVersion,
+		expectedPeerTypes:  expectedPeerTypes,
+		hexBlsKeys:         args.Identities.BlsHexKeys,
+	}, nil
+}
+
+// SetIdentities will atomically replace the monitored BLS keys
+func (checker *heartbeatChecker) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	checker.mutIdentities.Lock()
+	checker.hexBlsKeys = identities.BlsHexKeys
+	checker.mutIdentities.Unlock()
+
+	log.Debug("heartbeatChecker.SetIdentities", "checker name", checker.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
+// Check queries the heartbeats and returns the monitored BLS keys that are offline, have an unexpected peer type or
+// run an unexpected app version. The BLS keys not found in the heartbeats are not reported
+func (checker *heartbeatChecker) Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+	heartbeats, err := checker.querier.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checker.mutIdentities.RLock()
+	allKeys := make([]string, 0, len(checker.hexBlsKeys)+len(extraBLSKeys))
+	allKeys = append(allKeys, checker.hexBlsKeys...)
+	checker.mutIdentities.RUnlock()
+	allKeys = append(allKeys, extraBLSKeys...)
+
+	log.Debug("heartbeatChecker.Check", "checker name", checker.name, "num keys", len(allKeys),
+		"num heartbeats", len(heartbeats))
+
+	results := make([]core.CheckResponse, 0)
+	checkedKeys := make(map[string]struct{}, len(allKeys))
+	for _, blsKey := range allKeys {
+		_, alreadyChecked := checkedKeys[blsKey]
+		if len(blsKey) == 0 || alreadyChecked {
+			continue
+		}
+		checkedKeys[blsKey] = struct{}{}
+
+		heartbeat, found := heartbeats[blsKey]
+		if !found {
+			continue
+		}
+
+		problems := checker.checkHeartbeat(heartbeat)
+		if len(problems) == 0 {
+			continue
+		}
+
+		log.Debug("found node with heartbeat problems", "checker", checker.name, "bls key", blsKey)
+		results = append(results, core.CheckResponse{
+			HexBLSKey: blsKey,
+			Status:    strings.Join(problems, "; "),
+		})
+	}
+
+	return results, nil
+}
+
+func (checker *heartbeatChecker) checkHeartbeat(heartbeat *core.HeartbeatStatus) []string {
+	problems := make([]string, 0)
+	if !heartbeat.IsActive {
+		problems = append(problems, fmt.Sprintf(offlineMessageFormat, heartbeat.NodeDisplayName, heartbeat.PeerType))
+	}
+	if len(checker.expectedPeerTypes) > 0 {
+		_, isExpected := checker.expectedPeerTypes[heartbeat.PeerType]
+		if !isExpected {
+			problems = append(problems, fmt.Sprintf(unexpectedPeerTypeMessageFormat,
+				heartbeat.PeerType, checker.expectedPeerTypesString()))
+		}
+	}
+	// the version number also contains the commit, the go version and the platform, so only the prefix is compared
+	if len(checker.expectedAppVersion) > 0 && !strings.HasPrefix(heartbeat.VersionNumber, checker.expectedAppVersion) {
+		problems = append(problems, fmt.Sprintf(heartbeatVersionMismatchMessageFormat,
+			heartbeat.VersionNumber, checker.expectedAppVersion))
+	}
+
+	return problems
+}
+
+func (checker *heartbeatChecker) expectedPeerTypesString() string {
+	peerTypes := make([]string, 0, len(checker.expectedPeerTypes))
+	for peerType := range checker.expectedPeerTypes {
+		peerTypes = append(peerTypes, peerType)
+	}
+	sort.Strings(peerTypes)
+
+	return strings.Join(peerTypes, ", ")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *heartbeatChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
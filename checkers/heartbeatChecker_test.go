@@ -0,0 +1,181 @@
+package checkers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestHeartbeatQuerier(heartbeats ...*core.HeartbeatStatus) HeartbeatStatusQuerier {
+	return &mock.HeartbeatStatusQuerierStub{
+		QueryHandler: func(ctx context.Context) (map[string]*core.HeartbeatStatus, error) {
+			result := make(map[string]*core.HeartbeatStatus)
+			for _, heartbeat := range heartbeats {
+				result[heartbeat.PublicKey] = heartbeat
+			}
+
+			return result, nil
+		},
+	}
+}
+
+func createMockArgsHeartbeatChecker() ArgsHeartbeatChecker {
+	return ArgsHeartbeatChecker{
+		Name:    "test",
+		Querier: &mock.HeartbeatStatusQuerierStub{},
+		Identities: &core.IdentitiesHolder{
+			BlsHexKeys: []string{"key1", "key2"},
+		},
+	}
+}
+
+func TestNewHeartbeatChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.Querier = nil
+		checker, err := NewHeartbeatChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilHeartbeatStatusQuerier, err)
+	})
+	t.Run("nil identities holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.Identities = nil
+		checker, err := NewHeartbeatChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("unknown peer type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.ExpectedPeerTypes = []string{"eligible", "validator"}
+		checker, err := NewHeartbeatChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidPeerType)
+		assert.Contains(t, err.Error(), "validator")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.ExpectedPeerTypes = []string{"eligible", "waiting"}
+		checker, err := NewHeartbeatChecker(args)
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestHeartbeatChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *heartbeatChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &heartbeatChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestHeartbeatChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("querier errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsHeartbeatChecker()
+		args.Querier = &mock.HeartbeatStatusQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.HeartbeatStatus, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewHeartbeatChecker(args)
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, results)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("only the offline keys should be reported with the other checks disabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.Querier = createTestHeartbeatQuerier(
+			&core.HeartbeatStatus{PublicKey: "key1", IsActive: true, PeerType: "jailed", VersionNumber: "v1"},
+			&core.HeartbeatStatus{PublicKey: "key2", IsActive: false, PeerType: "eligible", NodeDisplayName: "node-2"},
+			&core.HeartbeatStatus{PublicKey: "key3", IsActive: false, PeerType: "waiting", NodeDisplayName: "node-3"},
+			&core.HeartbeatStatus{PublicKey: "key4", IsActive: false, PeerType: "waiting", NodeDisplayName: "not monitored"},
+		)
+		checker, _ := NewHeartbeatChecker(args)
+		results, err := checker.Check(context.Background(), []string{"key3", "key2", "key5"})
+		assert.Nil(t, err)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key2",
+				Status:    "Offline: node name: node-2, peer type: eligible",
+			},
+			{
+				HexBLSKey: "key3",
+				Status:    "Offline: node name: node-3, peer type: waiting",
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("unexpected peer types and app versions should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatChecker()
+		args.ExpectedPeerTypes = []string{"waiting", "eligible"}
+		args.ExpectedAppVersion = "v1.7.13"
+		args.Querier = createTestHeartbeatQuerier(
+			&core.HeartbeatStatus{PublicKey: "key1", IsActive: true, PeerType: "eligible", VersionNumber: "v1.7.13-0-g1234/go1.20.7"},
+			&core.HeartbeatStatus{PublicKey: "key2", IsActive: false, PeerType: "jailed", VersionNumber: "v1.7.12", NodeDisplayName: "node-2"},
+			&core.HeartbeatStatus{PublicKey: "key3", IsActive: true, PeerType: "waiting", VersionNumber: "v1.7.12"},
+		)
+		checker, _ := NewHeartbeatChecker(args)
+		results, err := checker.Check(context.Background(), []string{"key3"})
+		assert.Nil(t, err)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key2",
+				Status: "Offline: node name: node-2, peer type: jailed; " +
+					"Unexpected peer type: jailed, expected: eligible, waiting; " +
+					"App version mismatch: running: v1.7.12, expected: v1.7.13",
+			},
+			{
+				HexBLSKey: "key3",
+				Status:    "App version mismatch: running: v1.7.12, expected: v1.7.13",
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+}
+
+func TestHeartbeatChecker_SetIdentities(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeartbeatChecker()
+	args.Querier = createTestHeartbeatQuerier(
+		&core.HeartbeatStatus{PublicKey: "key1", IsActive: false},
+		&core.HeartbeatStatus{PublicKey: "key3", IsActive: false},
+	)
+	checker, _ := NewHeartbeatChecker(args)
+
+	err := checker.SetIdentities(nil)
+	assert.Equal(t, errNilIdentitiesHolder, err)
+
+	err = checker.SetIdentities(&core.IdentitiesHolder{BlsHexKeys: []string{"key3"}})
+	assert.Nil(t, err)
+
+	results, err := checker.Check(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "key3", results[0].HexBLSKey)
+}
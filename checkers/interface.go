@@ -0,0 +1,38 @@
+package checkers
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// ValidatorStatisticsQuerier defines the operations of a component able to query the validators statistics
+type ValidatorStatisticsQuerier interface {
+	Query(ctx context.Context) (map[string]*core.ValidatorStatistics, error)
+	IsInterfaceNil() bool
+}
+
+// RatingsChecker defines the operations of a component able to check the ratings of the monitored BLS keys
+type RatingsChecker interface {
+	Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.CheckResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ThresholdsResolver defines the operations of a component able to resolve the thresholds and the enabled checks of
+// each BLS key
+type ThresholdsResolver interface {
+	GetThresholds(blsKey string) Thresholds
+	IsInterfaceNil() bool
+}
+
+// HeartbeatStatusQuerier defines the operations of a component able to query the heartbeats of the network's nodes
+type HeartbeatStatusQuerier interface {
+	Query(ctx context.Context) (map[string]*core.HeartbeatStatus, error)
+	IsInterfaceNil() bool
+}
+
+// AuctionListQuerier defines the operations of a component able to query the staking auction list
+type AuctionListQuerier interface {
+	Query(ctx context.Context) ([]*core.AuctionListValidator, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,69 @@
+package checkers
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const minDegradedPercent = float64(0.0)
+const maxDegradedPercent = float64(100.0)
+
+type networkAnomalyDetector struct {
+	name                 string
+	alarmDeltaRatingDrop float32
+	degradedPercent      float64
+}
+
+// NewNetworkAnomalyDetector creates a new instance of type networkAnomalyDetector. A network-wide event is detected
+// when at least degradedPercent of all validators have a rating drop of at least alarmDeltaRatingDrop
+func NewNetworkAnomalyDetector(name string, alarmDeltaRatingDrop float64, degradedPercent float64) (*networkAnomalyDetector, error) {
+	if alarmDeltaRatingDrop < minAlarmDeltaRatingDrop || alarmDeltaRatingDrop > maxAlarmDeltaRatingDrop {
+		return nil, fmt.Errorf("%w, allowed interval [%0.2f, %0.2f]", errInvalidAlarmDeltaRatingDrop, minAlarmDeltaRatingDrop, maxAlarmDeltaRatingDrop)
+	}
+	if degradedPercent <= minDegradedPercent || degradedPercent > maxDegradedPercent {
+		return nil, fmt.Errorf("%w, allowed interval (%0.2f, %0.2f]", errInvalidDegradedPercent, minDegradedPercent, maxDegradedPercent)
+	}
+
+	return &networkAnomalyDetector{
+		name:                 name,
+		alarmDeltaRatingDrop: float32(alarmDeltaRatingDrop),
+		degradedPercent:      degradedPercent,
+	}, nil
+}
+
+// Detect returns the percentage of all validators from the statistics map that are degrading and true if this
+// percentage reached the network-wide event threshold. The validators that are already under the jail threshold
+// (usually jailed or inactive) are not counted
+func (detector *networkAnomalyDetector) Detect(statistics map[string]*core.ValidatorStatistics) (float64, bool) {
+	numValidators := 0
+	numDegraded := 0
+	for _, stats := range statistics {
+		if stats == nil || stats.Rating < defaultJailThreshold {
+			continue
+		}
+
+		numValidators++
+		// as in the ratings checker, a zero alarmDeltaRatingDrop should not count the validators without a rating drop
+		isDegraded := stats.TempRating < stats.Rating && stats.TempRating+detector.alarmDeltaRatingDrop <= stats.Rating
+		if isDegraded {
+			numDegraded++
+		}
+	}
+
+	if numValidators == 0 {
+		return 0, false
+	}
+
+	percent := float64(numDegraded) * 100 / float64(numValidators)
+	isNetworkWide := percent >= detector.degradedPercent
+	log.Debug("networkAnomalyDetector.Detect", "checker", detector.name, "num validators", numValidators,
+		"num degraded", numDegraded, "percent", fmt.Sprintf("%0.2f", percent), "network-wide event", isNetworkWide)
+
+	return percent, isNetworkWide
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (detector *networkAnomalyDetector) IsInterfaceNil() bool {
+	return detector == nil
+}
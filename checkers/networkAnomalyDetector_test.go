@@ -0,0 +1,124 @@
+package checkers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNetworkAnomalyDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid values for alarmDeltaRatingDrop", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewNetworkAnomalyDetector("test", -0.00001, 30)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+
+		instance, err = NewNetworkAnomalyDetector("test", 100.000001, 30)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+	})
+	t.Run("invalid values for degradedPercent", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewNetworkAnomalyDetector("test", 1, 0)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidDegradedPercent)
+
+		instance, err = NewNetworkAnomalyDetector("test", 1, 100.000001)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidDegradedPercent)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewNetworkAnomalyDetector("test", 1, 100)
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNetworkAnomalyDetector_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *networkAnomalyDetector
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &networkAnomalyDetector{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestNetworkAnomalyDetector_Detect(t *testing.T) {
+	t.Parallel()
+
+	createStatistics := func(numHealthy int, numDegraded int, numJailed int) map[string]*core.ValidatorStatistics {
+		statistics := make(map[string]*core.ValidatorStatistics)
+		for i := 0; i < numHealthy; i++ {
+			statistics[fmt.Sprintf("healthy%d", i)] = &core.ValidatorStatistics{TempRating: 100, Rating: 100}
+		}
+		for i := 0; i < numDegraded; i++ {
+			statistics[fmt.Sprintf("degraded%d", i)] = &core.ValidatorStatistics{TempRating: 95, Rating: 100}
+		}
+		for i := 0; i < numJailed; i++ {
+			statistics[fmt.Sprintf("jailed%d", i)] = &core.ValidatorStatistics{TempRating: 0, Rating: 0}
+		}
+		statistics["nil"] = nil
+
+		return statistics
+	}
+
+	detector, _ := NewNetworkAnomalyDetector("test", 1, 30)
+	t.Run("empty or nil statistics", func(t *testing.T) {
+		t.Parallel()
+
+		percent, isNetworkWide := detector.Detect(nil)
+		assert.Zero(t, percent)
+		assert.False(t, isNetworkWide)
+
+		percent, isNetworkWide = detector.Detect(createStatistics(0, 0, 10))
+		assert.Zero(t, percent)
+		assert.False(t, isNetworkWide)
+	})
+	t.Run("under the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		percent, isNetworkWide := detector.Detect(createStatistics(80, 20, 100))
+		assert.Equal(t, 20.0, percent)
+		assert.False(t, isNetworkWide)
+	})
+	t.Run("small rating drops should not count", func(t *testing.T) {
+		t.Parallel()
+
+		statistics := createStatistics(50, 0, 0)
+		for i := 0; i < 50; i++ {
+			statistics[fmt.Sprintf("small drop%d", i)] = &core.ValidatorStatistics{TempRating: 99.5, Rating: 100}
+		}
+
+		percent, isNetworkWide := detector.Detect(statistics)
+		assert.Zero(t, percent)
+		assert.False(t, isNetworkWide)
+	})
+	t.Run("network-wide event", func(t *testing.T) {
+		t.Parallel()
+
+		percent, isNetworkWide := detector.Detect(createStatistics(70, 30, 100))
+		assert.Equal(t, 30.0, percent)
+		assert.True(t, isNetworkWide)
+	})
+	t.Run("zero alarm delta should not count the unchanged ratings", func(t *testing.T) {
+		t.Parallel()
+
+		zeroDeltaDetector, _ := NewNetworkAnomalyDetector("test", 0, 30)
+		percent, isNetworkWide := zeroDeltaDetector.Detect(createStatistics(100, 0, 10))
+		assert.Zero(t, percent)
+		assert.False(t, isNetworkWide)
+
+		percent, isNetworkWide = zeroDeltaDetector.Detect(createStatistics(70, 30, 10))
+		assert.Equal(t, 30.0, percent)
+		assert.True(t, isNetworkWide)
+	})
+}
@@ -0,0 +1,69 @@
+package checkers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// ArgsNodeStatusChecker is the DTO used to create a new instance of type nodeStatusChecker
+type ArgsNodeStatusChecker struct {
+	MaxNonceLag          uint64
+	MinNumConnectedPeers uint64
+	ExpectedAppVersion   string
+}
+
+type nodeStatusChecker struct {
+	maxNonceLag          uint64
+	minNumConnectedPeers uint64
+	expectedAppVersion   string
+}
+
+// NewNodeStatusChecker creates a new node status checker. A 0 value for the maximum nonce lag or for the minimum number
+// of connected peers and an empty expected app version disable the corresponding check
+func NewNodeStatusChecker(args ArgsNodeStatusChecker) (*nodeStatusChecker, error) {
+	// the expected app version is compared as a prefix, a surrounding space would report all the nodes
+	if strings.TrimSpace(args.ExpectedAppVersion) != args.ExpectedAppVersion {
+		return nil, fmt.Errorf("%w: %q", errInvalidExpectedAppVersion, args.ExpectedAppVersion)
+	}
+
+	log.Debug("NewNodeStatusChecker", "max nonce lag", args.MaxNonceLag,
+		"min num connected peers", args.MinNumConnectedPeers, "expected app version", args.ExpectedAppVersion)
+
+	return &nodeStatusChecker{
+		maxNonceLag:          args.MaxNonceLag,
+		minNumConnectedPeers: args.MinNumConnectedPeers,
+		expectedAppVersion:   args.ExpectedAppVersion,
+	}, nil
+}
+
+// Check returns the problems of the node, compared with the provided network nonce. An empty result means the node is
+// performing normally
+func (checker *nodeStatusChecker) Check(status *core.NodeStatus, networkNonce uint64) []string {
+	problems := make([]string, 0)
+	if status.IsSyncing != 0 {
+		problems = append(problems, fmt.Sprintf("Not synchronized: nonce: %d, probable highest nonce: %d",
+			status.Nonce, status.ProbableHighestNonce))
+	}
+	if checker.maxNonceLag > 0 && networkNonce > status.Nonce && networkNonce-status.Nonce > checker.maxNonceLag {
+		problems = append(problems, fmt.Sprintf("Nonce lag: nonce: %d, network nonce: %d, lag: %d blocks",
+			status.Nonce, networkNonce, networkNonce-status.Nonce))
+	}
+	if status.NumConnectedPeers < checker.minNumConnectedPeers {
+		problems = append(problems, fmt.Sprintf("Low peer count: connected peers: %d, minimum: %d",
+			status.NumConnectedPeers, checker.minNumConnectedPeers))
+	}
+	// the node's app version also contains the commit, the go version and the platform, so only the prefix is compared
+	if len(checker.expectedAppVersion) > 0 && !strings.HasPrefix(status.AppVersion, checker.expectedAppVersion) {
+		problems = append(problems, fmt.Sprintf("App version mismatch: running: %s, expected: %s",
+			status.AppVersion, checker.expectedAppVersion))
+	}
+
+	return problems
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *nodeStatusChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
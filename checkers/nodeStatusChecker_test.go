@@ -0,0 +1,109 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestArgsNodeStatusChecker() ArgsNodeStatusChecker {
+	return ArgsNodeStatusChecker{
+		MaxNonceLag:          10,
+		MinNumConnectedPeers: 20,
+		ExpectedAppVersion:   "v1.6.",
+	}
+}
+
+func createHealthyNodeStatus() *core.NodeStatus {
+	return &core.NodeStatus{
+		Nonce:                1000,
+		ProbableHighestNonce: 1000,
+		IsSyncing:            0,
+		NumConnectedPeers:    30,
+		AppVersion:           "v1.6.18-0-gabcdef/go1.20.7/linux-amd64",
+		ShardID:              1,
+	}
+}
+
+func TestNewNodeStatusChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("expected app version with spaces should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsNodeStatusChecker()
+		args.ExpectedAppVersion = " v1.6."
+		checker, err := NewNodeStatusChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidExpectedAppVersion)
+
+		args.ExpectedAppVersion = "v1.6.\n"
+		checker, err = NewNodeStatusChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidExpectedAppVersion)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewNodeStatusChecker(createTestArgsNodeStatusChecker())
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+
+		checker, err = NewNodeStatusChecker(ArgsNodeStatusChecker{})
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodeStatusChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *nodeStatusChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &nodeStatusChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestNodeStatusChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy node should not return problems", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewNodeStatusChecker(createTestArgsNodeStatusChecker())
+		assert.Empty(t, checker.Check(createHealthyNodeStatus(), 1010))
+		// a node ahead of the network is not lagging
+		assert.Empty(t, checker.Check(createHealthyNodeStatus(), 900))
+	})
+	t.Run("all problems should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		status := &core.NodeStatus{
+			Nonce:                1000,
+			ProbableHighestNonce: 1100,
+			IsSyncing:            1,
+			NumConnectedPeers:    3,
+			AppVersion:           "v1.5.13-0-g123456/go1.20.7/linux-amd64",
+		}
+		checker, _ := NewNodeStatusChecker(createTestArgsNodeStatusChecker())
+		expectedProblems := []string{
+			"Not synchronized: nonce: 1000, probable highest nonce: 1100",
+			"Nonce lag: nonce: 1000, network nonce: 1011, lag: 11 blocks",
+			"Low peer count: connected peers: 3, minimum: 20",
+			"App version mismatch: running: v1.5.13-0-g123456/go1.20.7/linux-amd64, expected: v1.6.",
+		}
+		assert.Equal(t, expectedProblems, checker.Check(status, 1011))
+	})
+	t.Run("disabled checks should not return problems", func(t *testing.T) {
+		t.Parallel()
+
+		status := createHealthyNodeStatus()
+		status.NumConnectedPeers = 0
+		status.AppVersion = "v1.5.0"
+
+		checker, _ := NewNodeStatusChecker(ArgsNodeStatusChecker{})
+		assert.Empty(t, checker.Check(status, 100000))
+	})
+}
@@ -0,0 +1,271 @@
+package checkers
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const maxWorstPercentile = uint64(50)
+const minPeersGroupSize = uint64(2)
+const medianPercentile = float64(50)
+const peersRatingMessageFormat = "Rating below peers: temp rating - rating: %0.2f, peers median: %0.2f, %s (%d %s validators in %s)"
+const worstPercentileReasonFormat = "in the worst %d%% (threshold %0.2f)"
+const standardDeviationsReasonFormat = "%0.2f standard deviations below the peers mean %0.2f"
+
+// ArgsPeersRatingChecker defines the DTO struct for the NewPeersRatingChecker constructor function
+type ArgsPeersRatingChecker struct {
+	Name               string
+	Identities         *core.IdentitiesHolder
+	WorstPercentile    uint64
+	StandardDeviations float64
+	MinGroupSize       uint64
+	ThresholdsResolver ThresholdsResolver
+}
+
+// peersGroupKey identifies the validators compared with each other: the ones in the same shard, having the same status
+type peersGroupKey struct {
+	shardID uint32
+	status  string
+}
+
+// peersGroup holds the distribution of the temp rating - rating differences of a group of validators
+type peersGroup struct {
+	sortedDeltas      []float64
+	median            float64
+	worstThreshold    float64
+	mean              float64
+	standardDeviation float64
+}
+
+type peersRatingChecker struct {
+	name               string
+	worstPercentile    uint64
+	standardDeviations float64
+	minGroupSize       uint64
+	thresholdsResolver ThresholdsResolver
+	mutIdentities      sync.RWMutex
+	hexBlsKeys         []string
+}
+
+// NewPeersRatingChecker creates a new instance of type peersRatingChecker. A zero WorstPercentile or a zero
+// StandardDeviations disables the corresponding criterion, but at least one of them should be set. The BLS keys for
+// which the thresholds resolver disables the peersRating check are not compared with their peers
+func NewPeersRatingChecker(args ArgsPeersRatingChecker) (*peersRatingChecker, error) {
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+	if check.IfNil(args.ThresholdsResolver) {
+		return nil, errNilThresholdsResolver
+	}
+	if args.WorstPercentile >= maxWorstPercentile {
+		return nil, fmt.Errorf("%w, allowed interval [0, %d)", errInvalidWorstPercentile, maxWorstPercentile)
+	}
+	if args.StandardDeviations < 0 || math.IsNaN(args.StandardDeviations) || math.IsInf(args.StandardDeviations, 0) {
+		return nil, fmt.Errorf("%w, should be a positive value", errInvalidStandardDeviations)
+	}
+	if args.WorstPercentile == 0 && args.StandardDeviations == 0 {
+		return nil, errNoPeersComparisonCriteria
+	}
+	if args.MinGroupSize < minPeersGroupSize {
+		return nil, fmt.Errorf("%w, minimum value %d", errInvalidMinGroupSize, minPeersGroupSize)
+	}
+
+	log.Debug("NewPeersRatingChecker", "checker name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys),
+		"worst percentile", args.WorstPercentile, "standard deviations", args.StandardDeviations,
+		"min group size", args.MinGroupSize)
+
+	return &peersRatingChecker{
+		name:               args.Name,
+		worstPercentile:    args.WorstPercentile,
+		standardDeviations: args.StandardDeviations,
+		minGroupSize:       args.MinGroupSize,
+		thresholdsResolver: args.ThresholdsResolver,
+		hexBlsKeys:         args.Identities.BlsHexKeys,
+	}, nil
+}
+
+// SetIdentities will atomically replace the monitored BLS keys
+func (checker *peersRatingChecker) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	checker.mutIdentities.Lock()
+	checker.hexBlsKeys = identities.BlsHexKeys
+	checker.mutIdentities.Unlock()
+
+	log.Debug("peersRatingChecker.SetIdentities", "checker name", checker.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
+// Check compares the temp rating - rating difference of each monitored BLS key with the ones of all the validators from
+// the statistics having the same shard and the same status. A BLS key is reported if its rating dropped more than the
+// peers median and it is in the worst percentile of its peers or the configured number of standard deviations below
+// the peers mean. The groups smaller than the minimum group size are not compared, as their distribution is not relevant
+func (checker *peersRatingChecker) Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+	checker.mutIdentities.RLock()
+	allKeys := make([]string, 0, len(checker.hexBlsKeys)+len(extraBLSKeys))
+	allKeys = append(allKeys, checker.hexBlsKeys...)
+	checker.mutIdentities.RUnlock()
+	allKeys = append(allKeys, extraBLSKeys...)
+
+	groups := checker.createPeersGroups(statistics)
+
+	results := make([]core.CheckResponse, 0)
+	checkedKeys := make(map[string]struct{}, len(allKeys))
+	for _, blsKey := range allKeys {
+		_, alreadyChecked := checkedKeys[blsKey]
+		if alreadyChecked {
+			continue
+		}
+		checkedKeys[blsKey] = struct{}{}
+
+		if !checker.thresholdsResolver.GetThresholds(blsKey).PeersRatingEnabled {
+			continue
+		}
+
+		stats, found := statistics[blsKey]
+		if !found || stats == nil {
+			continue
+		}
+
+		groupKey := createPeersGroupKey(stats)
+		group, found := groups[groupKey]
+		if !found {
+			continue
+		}
+
+		delta := ratingDelta(stats)
+		reasons, isBelowPeers := checker.compareWithPeers(delta, group)
+		if !isBelowPeers {
+			continue
+		}
+
+		log.Debug("found node with the rating below its peers", "checker", checker.name, "bls key", blsKey)
+		results = append(results, core.CheckResponse{
+			HexBLSKey: blsKey,
+			Status: fmt.Sprintf(peersRatingMessageFormat, delta, group.median, reasons,
+				len(group.sortedDeltas), groupKey.status, core.ShardName(groupKey.shardID)),
+			Check: core.PeersRatingCheck,
+		})
+	}
+
+	log.Debug("peersRatingChecker.Check", "checker name", checker.name, "num keys", len(allKeys),
+		"num groups", len(groups), "num reported keys", len(results))
+
+	return results
+}
+
+// createPeersGroups computes the distribution of each group of validators, only for the groups that are large enough
+func (checker *peersRatingChecker) createPeersGroups(statistics map[string]*core.ValidatorStatistics) map[peersGroupKey]*peersGroup {
+	deltas := make(map[peersGroupKey][]float64)
+	for _, stats := range statistics {
+		if stats == nil {
+			continue
+		}
+
+		groupKey := createPeersGroupKey(stats)
+		deltas[groupKey] = append(deltas[groupKey], ratingDelta(stats))
+	}
+
+	groups := make(map[peersGroupKey]*peersGroup, len(deltas))
+	for groupKey, groupDeltas := range deltas {
+		if uint64(len(groupDeltas)) < checker.minGroupSize {
+			continue
+		}
+
+		groups[groupKey] = checker.createPeersGroup(groupDeltas)
+	}
+
+	return groups
+}
+
+func (checker *peersRatingChecker) createPeersGroup(deltas []float64) *peersGroup {
+	sort.Float64s(deltas)
+
+	sum := float64(0)
+	for _, delta := range deltas {
+		sum += delta
+	}
+	mean := sum / float64(len(deltas))
+
+	squaresSum := float64(0)
+	for _, delta := range deltas {
+		squaresSum += (delta - mean) * (delta - mean)
+	}
+
+	return &peersGroup{
+		sortedDeltas:      deltas,
+		median:            percentile(deltas, medianPercentile),
+		worstThreshold:    percentile(deltas, float64(checker.worstPercentile)),
+		mean:              mean,
+		standardDeviation: math.Sqrt(squaresSum / float64(len(deltas))),
+	}
+}
+
+// compareWithPeers returns the reasons for which the BLS key is below its peers and true if it is below its peers
+func (checker *peersRatingChecker) compareWithPeers(delta float64, group *peersGroup) (string, bool) {
+	// a key that did not lose rating or that is not worse than most of its peers is performing normally
+	if delta >= 0 || delta >= group.median {
+		return "", false
+	}
+
+	reasons := make([]string, 0, 2)
+	if checker.worstPercentile > 0 && delta <= group.worstThreshold {
+		reasons = append(reasons, fmt.Sprintf(worstPercentileReasonFormat, checker.worstPercentile, group.worstThreshold))
+	}
+	if checker.standardDeviations > 0 && group.standardDeviation > 0 {
+		numStandardDeviations := (group.mean - delta) / group.standardDeviation
+		if numStandardDeviations >= checker.standardDeviations {
+			reasons = append(reasons, fmt.Sprintf(standardDeviationsReasonFormat, numStandardDeviations, group.mean))
+		}
+	}
+	if len(reasons) == 0 {
+		return "", false
+	}
+
+	return strings.Join(reasons, ", "), true
+}
+
+func createPeersGroupKey(stats *core.ValidatorStatistics) peersGroupKey {
+	status := stats.ValidatorStatus
+	if len(status) == 0 {
+		status = unknownValidatorStatus
+	}
+
+	return peersGroupKey{
+		shardID: stats.ShardID,
+		status:  status,
+	}
+}
+
+func ratingDelta(stats *core.ValidatorStatistics) float64 {
+	return float64(stats.TempRating) - float64(stats.Rating)
+}
+
+// percentile returns the value at the provided percentile of the sorted values, using the linear interpolation between
+// the closest ranks
+func percentile(sortedValues []float64, percent float64) float64 {
+	if len(sortedValues) == 0 {
+		return 0
+	}
+
+	position := percent / 100 * float64(len(sortedValues)-1)
+	lowerIndex := int(math.Floor(position))
+	upperIndex := int(math.Ceil(position))
+	fraction := position - float64(lowerIndex)
+
+	return sortedValues[lowerIndex] + fraction*(sortedValues[upperIndex]-sortedValues[lowerIndex])
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *peersRatingChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
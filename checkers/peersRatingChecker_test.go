@@ -0,0 +1,321 @@
+package checkers
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsPeersRatingChecker() ArgsPeersRatingChecker {
+	identities := &core.IdentitiesHolder{
+		BlsHexKeys: []string{"key1", "key2"},
+	}
+	resolver, _ := NewThresholdsResolver(ArgsThresholdsResolver{
+		Name:       "test",
+		Identities: identities,
+	})
+
+	return ArgsPeersRatingChecker{
+		Name:               "test",
+		Identities:         identities,
+		WorstPercentile:    10,
+		StandardDeviations: 0,
+		MinGroupSize:       10,
+		ThresholdsResolver: resolver,
+	}
+}
+
+// createPeersStatistics returns the statistics of 20 eligible validators in shard 1: key1 lost 5 rating points, key2
+// lost 0.5 rating points and the other 18 validators did not lose rating
+func createPeersStatistics() map[string]*core.ValidatorStatistics {
+	statistics := map[string]*core.ValidatorStatistics{
+		"key1": {TempRating: 95, Rating: 100, ShardID: 1, ValidatorStatus: "eligible"},
+		"key2": {TempRating: 99.5, Rating: 100, ShardID: 1, ValidatorStatus: "eligible"},
+	}
+	for i := 0; i < 18; i++ {
+		statistics[fmt.Sprintf("peer%d", i)] = &core.ValidatorStatistics{
+			TempRating:      100,
+			Rating:          100,
+			ShardID:         1,
+			ValidatorStatus: "eligible",
+		}
+	}
+
+	return statistics
+}
+
+func TestNewPeersRatingChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.Identities = nil
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("nil thresholds resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.ThresholdsResolver = nil
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilThresholdsResolver, err)
+	})
+	t.Run("invalid worst percentile should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.WorstPercentile = 50
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidWorstPercentile)
+		assert.Contains(t, err.Error(), "allowed interval [0, 50)")
+	})
+	t.Run("invalid standard deviations should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.StandardDeviations = -1
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidStandardDeviations)
+
+		args.StandardDeviations = math.NaN()
+		checker, err = NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidStandardDeviations)
+	})
+	t.Run("no criteria should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.WorstPercentile = 0
+		args.StandardDeviations = 0
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNoPeersComparisonCriteria, err)
+	})
+	t.Run("invalid min group size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.MinGroupSize = 1
+		checker, err := NewPeersRatingChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidMinGroupSize)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewPeersRatingChecker(createMockArgsPeersRatingChecker())
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+
+		args := createMockArgsPeersRatingChecker()
+		args.WorstPercentile = 0
+		args.StandardDeviations = 2.5
+		checker, err = NewPeersRatingChecker(args)
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestPeersRatingChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *peersRatingChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &peersRatingChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestPeersRatingChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keys in the worst percentile should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewPeersRatingChecker(createMockArgsPeersRatingChecker())
+		results := checker.Check(createPeersStatistics(), nil)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key1",
+				Status: "Rating below peers: temp rating - rating: -5.00, peers median: 0.00, " +
+					"in the worst 10% (threshold -0.05) (20 eligible validators in shard 1)",
+				Check: core.PeersRatingCheck,
+			},
+			{
+				HexBLSKey: "key2",
+				Status: "Rating below peers: temp rating - rating: -0.50, peers median: 0.00, " +
+					"in the worst 10% (threshold -0.05) (20 eligible validators in shard 1)",
+				Check: core.PeersRatingCheck,
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("keys below the standard deviations threshold should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.WorstPercentile = 0
+		args.StandardDeviations = 3
+		checker, _ := NewPeersRatingChecker(args)
+		results := checker.Check(createPeersStatistics(), nil)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key1",
+				Status: "Rating below peers: temp rating - rating: -5.00, peers median: 0.00, " +
+					"4.34 standard deviations below the peers mean -0.28 (20 eligible validators in shard 1)",
+				Check: core.PeersRatingCheck,
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("both criteria met should be listed in the same response", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.WorstPercentile = 5
+		args.StandardDeviations = 3
+		checker, _ := NewPeersRatingChecker(args)
+		results := checker.Check(createPeersStatistics(), nil)
+		expectedResults := []core.CheckResponse{
+			{
+				HexBLSKey: "key1",
+				Status: "Rating below peers: temp rating - rating: -5.00, peers median: 0.00, " +
+					"in the worst 5% (threshold -0.72), 4.34 standard deviations below the peers mean -0.28 " +
+					"(20 eligible validators in shard 1)",
+				Check: core.PeersRatingCheck,
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("network-wide rating drops should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		// all the validators lost rating, key1 and key2 lost less than the median of their peers
+		statistics := createPeersStatistics()
+		for key, stats := range statistics {
+			if key != "key1" && key != "key2" {
+				stats.TempRating = 90
+			}
+		}
+		statistics["key1"].TempRating = 91
+		statistics["key2"].TempRating = 90
+
+		args := createMockArgsPeersRatingChecker()
+		args.StandardDeviations = 1
+		checker, _ := NewPeersRatingChecker(args)
+		results := checker.Check(statistics, nil)
+		assert.Empty(t, results)
+	})
+	t.Run("keys should be compared only with the peers in the same shard and status", func(t *testing.T) {
+		t.Parallel()
+
+		statistics := createPeersStatistics()
+		// the same shard but another status, the group is too small to be compared
+		statistics["key1"].ValidatorStatus = "waiting"
+		// another shard, the key is the only one not losing rating in its group
+		statistics["key3"] = &core.ValidatorStatistics{TempRating: 100, Rating: 100, ShardID: 2, ValidatorStatus: "eligible"}
+		for i := 0; i < 10; i++ {
+			statistics[fmt.Sprintf("shard2peer%d", i)] = &core.ValidatorStatistics{
+				TempRating:      90,
+				Rating:          100,
+				ShardID:         2,
+				ValidatorStatus: "eligible",
+			}
+		}
+
+		checker, _ := NewPeersRatingChecker(createMockArgsPeersRatingChecker())
+		results := checker.Check(statistics, []string{"key3"})
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key2", results[0].HexBLSKey)
+		assert.Contains(t, results[0].Status, "(19 eligible validators in shard 1)")
+	})
+	t.Run("extra keys should be checked once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.Identities = &core.IdentitiesHolder{}
+		checker, _ := NewPeersRatingChecker(args)
+		results := checker.Check(createPeersStatistics(), []string{"key1", "key1", "missing"})
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key1", results[0].HexBLSKey)
+	})
+	t.Run("set identities should replace the monitored keys", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewPeersRatingChecker(createMockArgsPeersRatingChecker())
+		err := checker.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+
+		err = checker.SetIdentities(&core.IdentitiesHolder{BlsHexKeys: []string{"key2"}})
+		assert.Nil(t, err)
+
+		results := checker.Check(createPeersStatistics(), nil)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key2", results[0].HexBLSKey)
+	})
+	t.Run("keys not having the peers rating check enabled by the overrides should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersRatingChecker()
+		args.Identities = &core.IdentitiesHolder{
+			BlsHexKeys: []string{"key1", "key2", "peer0"},
+			Metadata: map[string]core.IdentityMetadata{
+				"key1": {Label: "backup"},
+				"key2": {Label: "backup"},
+			},
+		}
+		argsResolver := ArgsThresholdsResolver{
+			Name:       "test",
+			Identities: args.Identities,
+			LabelOverrides: map[string]core.ThresholdsOverride{
+				"backup": {EnabledChecks: []string{core.NoChecks}},
+			},
+			KeyOverrides: map[string]core.ThresholdsOverride{
+				"key2": {EnabledChecks: []string{core.RatingDropCheck, core.PeersRatingCheck}},
+			},
+		}
+		args.ThresholdsResolver, _ = NewThresholdsResolver(argsResolver)
+		checker, _ := NewPeersRatingChecker(args)
+		results := checker.Check(createPeersStatistics(), nil)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "key2", results[0].HexBLSKey)
+
+		// the enabled checks not listing the peers rating check disable it
+		argsResolver.KeyOverrides = map[string]core.ThresholdsOverride{
+			"key2": {EnabledChecks: []string{core.RatingDropCheck, core.ImminentJailCheck}},
+		}
+		args.ThresholdsResolver, _ = NewThresholdsResolver(argsResolver)
+		checker, _ = NewPeersRatingChecker(args)
+		results = checker.Check(createPeersStatistics(), nil)
+		assert.Empty(t, results)
+	})
+	t.Run("no statistics should not report", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewPeersRatingChecker(createMockArgsPeersRatingChecker())
+		results := checker.Check(nil, nil)
+		assert.Empty(t, results)
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, float64(0), percentile(nil, 50))
+	assert.Equal(t, float64(3), percentile([]float64{3}, 10))
+	assert.Equal(t, float64(2), percentile([]float64{1, 2, 3}, 50))
+	assert.Equal(t, 2.5, percentile([]float64{1, 2, 3, 4}, 50))
+	assert.Equal(t, float64(1), percentile([]float64{1, 2, 3, 4}, 0))
+	assert.InDelta(t, 1.3, percentile([]float64{1, 2, 3, 4}, 10), 0.0001)
+}
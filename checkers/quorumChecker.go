@@ -0,0 +1,180 @@
+package checkers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const minQuorum = 1
+
+// StatisticsSource defines an additional source of the validator statistics
+type StatisticsSource struct {
+	Name    string
+	Querier ValidatorStatisticsQuerier
+}
+
+// ArgsQuorumChecker is the DTO used to create a new instance of type quorumChecker
+type ArgsQuorumChecker struct {
+	Name              string
+	PrimarySourceName string
+	Sources           []StatisticsSource
+	RatingsChecker    RatingsChecker
+	Quorum            int
+}
+
+type quorumChecker struct {
+	name              string
+	primarySourceName string
+	sources           []StatisticsSource
+	ratingsChecker    RatingsChecker
+	quorum            int
+}
+
+type keyVotes struct {
+	status          string
+	check           string
+	degradedSources []string
+}
+
+// NewQuorumChecker creates a new instance of type quorumChecker. A BLS key is reported only if it is found
+// problematic on at least quorum sources, the primary source included
+func NewQuorumChecker(args ArgsQuorumChecker) (*quorumChecker, error) {
+	err := checkArgsQuorumChecker(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quorumChecker{
+		name:              args.Name,
+		primarySourceName: args.PrimarySourceName,
+		sources:           args.Sources,
+		ratingsChecker:    args.RatingsChecker,
+		quorum:            args.Quorum,
+	}, nil
+}
+
+func checkArgsQuorumChecker(args ArgsQuorumChecker) error {
+	if len(args.Sources) == 0 {
+		return errNoStatisticsSources
+	}
+	for index, source := range args.Sources {
+		if check.IfNil(source.Querier) {
+			return fmt.Errorf("%w at index %d", errNilValidatorStatisticsQuerier, index)
+		}
+	}
+	if check.IfNil(args.RatingsChecker) {
+		return errNilRatingsChecker
+	}
+	maxQuorum := len(args.Sources) + 1
+	if args.Quorum < minQuorum || args.Quorum > maxQuorum {
+		return fmt.Errorf("%w, allowed interval [%d, %d]", errInvalidQuorum, minQuorum, maxQuorum)
+	}
+
+	return nil
+}
+
+// Check queries the additional sources and returns the BLS keys found problematic on at least quorum sources and the
+// BLS keys found problematic only on some of the sources. The provided problematic keys are the ones found on the
+// primary source. The sources that can not be queried are not counted. If fewer sources than the quorum responded,
+// the BLS keys found problematic on all responding sources are returned, so a sources outage will not hide the alerts
+func (checker *quorumChecker) Check(
+	ctx context.Context,
+	problematicKeys []core.CheckResponse,
+	extraBLSKeys []string,
+) ([]core.CheckResponse, []core.SourcesDisagreement) {
+	votes := make(map[string]*keyVotes)
+	orderedKeys := make([]string, 0, len(problematicKeys))
+	addVotes := func(sourceName string, keys []core.CheckResponse) {
+		for _, key := range keys {
+			vote, found := votes[key.HexBLSKey]
+			if !found {
+				vote = &keyVotes{
+					status: key.Status,
+					check:  key.Check,
+				}
+				votes[key.HexBLSKey] = vote
+				orderedKeys = append(orderedKeys, key.HexBLSKey)
+			}
+
+			vote.degradedSources = append(vote.degradedSources, sourceName)
+		}
+	}
+
+	addVotes(checker.primarySourceName, problematicKeys)
+	respondingSources := []string{checker.primarySourceName}
+	for _, source := range checker.sources {
+		keys, err := checker.checkSource(ctx, source, extraBLSKeys)
+		if err != nil {
+			log.Warn("quorumChecker.Check: statistics source not counted", "checker", checker.name,
+				"source", source.Name, "error", err)
+			continue
+		}
+
+		respondingSources = append(respondingSources, source.Name)
+		addVotes(source.Name, keys)
+	}
+
+	quorum := checker.quorum
+	if quorum > len(respondingSources) {
+		quorum = len(respondingSources)
+	}
+
+	confirmedKeys := make([]core.CheckResponse, 0, len(orderedKeys))
+	disagreements := make([]core.SourcesDisagreement, 0)
+	for _, key := range orderedKeys {
+		vote := votes[key]
+		if len(vote.degradedSources) >= quorum {
+			confirmedKeys = append(confirmedKeys, core.CheckResponse{
+				HexBLSKey: key,
+				Status:    vote.status,
+				Check:     vote.check,
+			})
+		}
+		if len(vote.degradedSources) < len(respondingSources) {
+			disagreements = append(disagreements, core.SourcesDisagreement{
+				HexBLSKey:       key,
+				DegradedSources: vote.degradedSources,
+				HealthySources:  difference(respondingSources, vote.degradedSources),
+			})
+		}
+	}
+
+	log.Debug("quorumChecker.Check", "checker", checker.name, "num responding sources", len(respondingSources),
+		"quorum", quorum, "num confirmed keys", len(confirmedKeys), "num disagreements", len(disagreements))
+
+	return confirmedKeys, disagreements
+}
+
+func (checker *quorumChecker) checkSource(ctx context.Context, source StatisticsSource, extraBLSKeys []string) ([]core.CheckResponse, error) {
+	statistics, err := source.Querier.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.ratingsChecker.Check(statistics, extraBLSKeys)
+}
+
+func difference(all []string, excluded []string) []string {
+	excludedMap := make(map[string]struct{}, len(excluded))
+	for _, item := range excluded {
+		excludedMap[item] = struct{}{}
+	}
+
+	result := make([]string, 0, len(all))
+	for _, item := range all {
+		_, isExcluded := excludedMap[item]
+		if !isExcluded {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *quorumChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
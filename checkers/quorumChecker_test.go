@@ -0,0 +1,256 @@
+package checkers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestStatisticsSource creates a source that responds with statistics containing only the provided degraded keys
+func createTestStatisticsSource(name string, degradedKeys ...string) StatisticsSource {
+	return StatisticsSource{
+		Name: name,
+		Querier: &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				statistics := make(map[string]*core.ValidatorStatistics)
+				for _, key := range degradedKeys {
+					statistics[key] = &core.ValidatorStatistics{}
+				}
+
+				return statistics, nil
+			},
+		},
+	}
+}
+
+func createTestRatingsChecker() RatingsChecker {
+	return &mock.RatingsCheckerStub{
+		CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.CheckResponse, error) {
+			keys := make([]string, 0, len(statistics))
+			for key := range statistics {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			result := make([]core.CheckResponse, 0, len(keys))
+			for _, key := range keys {
+				result = append(result, core.CheckResponse{
+					HexBLSKey: key,
+					Status:    "status " + key,
+				})
+			}
+
+			return result, nil
+		},
+	}
+}
+
+func createTestArgsQuorumChecker(quorum int, sources ...StatisticsSource) ArgsQuorumChecker {
+	return ArgsQuorumChecker{
+		Name:              "test",
+		PrimarySourceName: "primary",
+		Sources:           sources,
+		RatingsChecker:    createTestRatingsChecker(),
+		Quorum:            quorum,
+	}
+}
+
+func createTestProblematicKeys(keys ...string) []core.CheckResponse {
+	result := make([]core.CheckResponse, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, core.CheckResponse{
+			HexBLSKey: key,
+			Status:    "status " + key,
+		})
+	}
+
+	return result
+}
+
+func TestNewQuorumChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(createTestArgsQuorumChecker(1))
+		assert.Nil(t, checker)
+		assert.Equal(t, errNoStatisticsSources, err)
+	})
+	t.Run("nil querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(2, createTestStatisticsSource("source1"), StatisticsSource{Name: "source2"})
+		checker, err := NewQuorumChecker(args)
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errNilValidatorStatisticsQuerier)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("nil ratings checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(2, createTestStatisticsSource("source1"))
+		args.RatingsChecker = nil
+		checker, err := NewQuorumChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilRatingsChecker, err)
+	})
+	t.Run("invalid quorum should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(createTestArgsQuorumChecker(0, createTestStatisticsSource("source1")))
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidQuorum)
+
+		checker, err = NewQuorumChecker(createTestArgsQuorumChecker(3, createTestStatisticsSource("source1")))
+		assert.Nil(t, checker)
+		assert.ErrorIs(t, err, errInvalidQuorum)
+		assert.Contains(t, err.Error(), "allowed interval [1, 2]")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(createTestArgsQuorumChecker(2, createTestStatisticsSource("source1")))
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestQuorumChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *quorumChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &quorumChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestQuorumChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all sources agree should return the keys without disagreements", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(2,
+			createTestStatisticsSource("source1", "bls1", "bls2"),
+			createTestStatisticsSource("source2", "bls1", "bls2"))
+		checker, _ := NewQuorumChecker(args)
+
+		keys, disagreements := checker.Check(context.Background(), createTestProblematicKeys("bls1", "bls2"), nil)
+		assert.Equal(t, createTestProblematicKeys("bls1", "bls2"), keys)
+		assert.Empty(t, disagreements)
+	})
+	t.Run("stale primary source should not report the key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(2,
+			createTestStatisticsSource("source1"),
+			createTestStatisticsSource("source2"))
+		checker, _ := NewQuorumChecker(args)
+
+		keys, disagreements := checker.Check(context.Background(), createTestProblematicKeys("bls1"), nil)
+		assert.Empty(t, keys)
+		expectedDisagreements := []core.SourcesDisagreement{
+			{
+				HexBLSKey:       "bls1",
+				DegradedSources: []string{"primary"},
+				HealthySources:  []string{"source1", "source2"},
+			},
+		}
+		assert.Equal(t, expectedDisagreements, disagreements)
+	})
+	t.Run("keys found only on the additional sources can reach the quorum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(2,
+			createTestStatisticsSource("source1", "bls2", "bls3"),
+			createTestStatisticsSource("source2", "bls2"))
+		checker, _ := NewQuorumChecker(args)
+
+		keys, disagreements := checker.Check(context.Background(), createTestProblematicKeys("bls1"), nil)
+		assert.Equal(t, createTestProblematicKeys("bls2"), keys)
+		expectedDisagreements := []core.SourcesDisagreement{
+			{
+				HexBLSKey:       "bls1",
+				DegradedSources: []string{"primary"},
+				HealthySources:  []string{"source1", "source2"},
+			},
+			{
+				HexBLSKey:       "bls2",
+				DegradedSources: []string{"source1", "source2"},
+				HealthySources:  []string{"primary"},
+			},
+			{
+				HexBLSKey:       "bls3",
+				DegradedSources: []string{"source1"},
+				HealthySources:  []string{"primary", "source2"},
+			},
+		}
+		assert.Equal(t, expectedDisagreements, disagreements)
+	})
+	t.Run("failing sources should not be counted", func(t *testing.T) {
+		t.Parallel()
+
+		failingSource := StatisticsSource{
+			Name: "failing",
+			Querier: &mock.ValidatorStatisticsQuerierStub{
+				QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+					return nil, errors.New("expected error")
+				},
+			},
+		}
+		args := createTestArgsQuorumChecker(3, failingSource, createTestStatisticsSource("source2", "bls1"))
+		checker, _ := NewQuorumChecker(args)
+
+		// only 2 sources responded, the quorum of 3 can not be reached so the keys found on both are reported
+		keys, disagreements := checker.Check(context.Background(), createTestProblematicKeys("bls1", "bls2"), nil)
+		assert.Equal(t, createTestProblematicKeys("bls1"), keys)
+		expectedDisagreements := []core.SourcesDisagreement{
+			{
+				HexBLSKey:       "bls2",
+				DegradedSources: []string{"primary"},
+				HealthySources:  []string{"source2"},
+			},
+		}
+		assert.Equal(t, expectedDisagreements, disagreements)
+	})
+	t.Run("extra BLS keys should be passed to the ratings checker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(1, createTestStatisticsSource("source1"))
+		var providedExtraBLSKeys []string
+		args.RatingsChecker = &mock.RatingsCheckerStub{
+			CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				providedExtraBLSKeys = extraBLSKeys
+				return nil, nil
+			},
+		}
+		checker, _ := NewQuorumChecker(args)
+
+		keys, _ := checker.Check(context.Background(), createTestProblematicKeys("bls1"), []string{"bls3"})
+		assert.Equal(t, []string{"bls3"}, providedExtraBLSKeys)
+		assert.Equal(t, createTestProblematicKeys("bls1"), keys)
+	})
+	t.Run("the check name of the problematic keys should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsQuorumChecker(1, createTestStatisticsSource("source1"))
+		checker, _ := NewQuorumChecker(args)
+
+		problematicKeys := []core.CheckResponse{
+			{
+				HexBLSKey: "bls1",
+				Status:    "status bls1",
+				Check:     core.RatingDropCheck,
+			},
+		}
+		keys, _ := checker.Check(context.Background(), problematicKeys, nil)
+		assert.Equal(t, problematicKeys, keys)
+	})
+}
@@ -0,0 +1,101 @@
+package checkers
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// ArgsShardChecker defines the DTO struct for the NewShardChecker constructor function
+type ArgsShardChecker struct {
+	Name       string
+	Identities *core.IdentitiesHolder
+}
+
+type shardChecker struct {
+	name        string
+	mut         sync.RWMutex
+	hexBlsKeys  []string
+	knownShards map[string]uint32
+}
+
+// NewShardChecker creates a new instance of type shardChecker
+func NewShardChecker(args ArgsShardChecker) (*shardChecker, error) {
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+
+	log.Debug("NewShardChecker", "checker name", args.Name, "num initial keys", len(args.Identities.BlsHexKeys))
+
+	return &shardChecker{
+		name:        args.Name,
+		hexBlsKeys:  args.Identities.BlsHexKeys,
+		knownShards: make(map[string]uint32),
+	}, nil
+}
+
+// SetIdentities will atomically replace the monitored BLS keys
+func (checker *shardChecker) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	checker.mut.Lock()
+	checker.hexBlsKeys = identities.BlsHexKeys
+	checker.mut.Unlock()
+
+	log.Debug("shardChecker.SetIdentities", "checker name", checker.name, "num keys", len(identities.BlsHexKeys))
+
+	return nil
+}
+
+// Check records the shard of each monitored BLS key found in the provided validator statistics. It returns the BLS keys
+// that changed their shard since the previous check and the current shard of each monitored BLS key. The first shard
+// recorded for a BLS key is not a shard change and a BLS key missing from the statistics keeps its last known shard
+func (checker *shardChecker) Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.ShardChange, map[string]uint32) {
+	checker.mut.Lock()
+	defer checker.mut.Unlock()
+
+	allKeys := make([]string, 0, len(checker.hexBlsKeys)+len(extraBLSKeys))
+	allKeys = append(allKeys, checker.hexBlsKeys...)
+	allKeys = append(allKeys, extraBLSKeys...)
+
+	changes := make([]core.ShardChange, 0)
+	shards := make(map[string]uint32, len(allKeys))
+	for _, blsKey := range allKeys {
+		_, alreadyChecked := shards[blsKey]
+		if alreadyChecked {
+			continue
+		}
+
+		knownShardID, isKnown := checker.knownShards[blsKey]
+		stats, found := statistics[blsKey]
+		if !found || stats == nil {
+			if isKnown {
+				shards[blsKey] = knownShardID
+			}
+			continue
+		}
+
+		shards[blsKey] = stats.ShardID
+		if isKnown && knownShardID != stats.ShardID {
+			log.Debug("found BLS key that changed the shard", "checker", checker.name, "bls key", blsKey,
+				"old shard", knownShardID, "new shard", stats.ShardID)
+			changes = append(changes, core.ShardChange{
+				HexBLSKey:  blsKey,
+				OldShardID: knownShardID,
+				NewShardID: stats.ShardID,
+			})
+		}
+	}
+
+	// the BLS keys no longer monitored are forgotten. The map is replaced, not updated, so it can be safely returned
+	checker.knownShards = shards
+
+	return changes, shards
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *shardChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
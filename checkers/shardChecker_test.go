@@ -0,0 +1,115 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsShardChecker() ArgsShardChecker {
+	return ArgsShardChecker{
+		Name: "test",
+		Identities: &core.IdentitiesHolder{
+			BlsHexKeys: []string{"key1", "key2"},
+		},
+	}
+}
+
+func TestNewShardChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardChecker()
+		args.Identities = nil
+		checker, err := NewShardChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewShardChecker(createMockArgsShardChecker())
+		assert.NotNil(t, checker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestShardChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *shardChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &shardChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestShardChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report the shard changes of the monitored keys", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewShardChecker(createMockArgsShardChecker())
+
+		// the first recorded shards are not shard changes
+		statistics := map[string]*core.ValidatorStatistics{
+			"key1":  {ShardID: 0},
+			"key2":  {ShardID: 1},
+			"key3":  {ShardID: 2},
+			"other": {ShardID: 0},
+		}
+		changes, shards := checker.Check(statistics, []string{"key3"})
+		assert.Empty(t, changes)
+		assert.Equal(t, map[string]uint32{"key1": 0, "key2": 1, "key3": 2}, shards)
+
+		statistics = map[string]*core.ValidatorStatistics{
+			"key1":  {ShardID: core.MetachainShardID},
+			"key2":  {ShardID: 1},
+			"key3":  {ShardID: 0},
+			"other": {ShardID: 1},
+		}
+		changes, shards = checker.Check(statistics, []string{"key3"})
+		expectedChanges := []core.ShardChange{
+			{HexBLSKey: "key1", OldShardID: 0, NewShardID: core.MetachainShardID},
+			{HexBLSKey: "key3", OldShardID: 2, NewShardID: 0},
+		}
+		assert.Equal(t, expectedChanges, changes)
+		assert.Equal(t, map[string]uint32{"key1": core.MetachainShardID, "key2": 1, "key3": 0}, shards)
+	})
+	t.Run("missing keys should keep the last known shard", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewShardChecker(createMockArgsShardChecker())
+		_, _ = checker.Check(map[string]*core.ValidatorStatistics{"key1": {ShardID: 1}}, nil)
+
+		changes, shards := checker.Check(map[string]*core.ValidatorStatistics{}, nil)
+		assert.Empty(t, changes)
+		assert.Equal(t, map[string]uint32{"key1": 1}, shards)
+
+		changes, _ = checker.Check(map[string]*core.ValidatorStatistics{"key1": {ShardID: 2}}, nil)
+		assert.Equal(t, []core.ShardChange{{HexBLSKey: "key1", OldShardID: 1, NewShardID: 2}}, changes)
+	})
+	t.Run("keys no longer monitored should be forgotten", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewShardChecker(createMockArgsShardChecker())
+		_, _ = checker.Check(map[string]*core.ValidatorStatistics{"key3": {ShardID: 1}}, []string{"key3"})
+
+		err := checker.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+		err = checker.SetIdentities(&core.IdentitiesHolder{BlsHexKeys: []string{"key1"}})
+		assert.Nil(t, err)
+
+		_, shards := checker.Check(map[string]*core.ValidatorStatistics{}, nil)
+		assert.Empty(t, shards)
+
+		// the key is monitored again, its shard is recorded without reporting a change
+		changes, shards := checker.Check(map[string]*core.ValidatorStatistics{"key3": {ShardID: 2}}, []string{"key3"})
+		assert.Empty(t, changes)
+		assert.Equal(t, map[string]uint32{"key3": 2}, shards)
+	})
+}
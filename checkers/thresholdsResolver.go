@@ -0,0 +1,302 @@
+package checkers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// Thresholds holds the values used when checking a BLS key
+type Thresholds struct {
+	AlarmDeltaRatingDrop float32
+	JailThreshold        float32
+	RatingDropEnabled    bool
+	ImminentJailEnabled  bool
+	PeersRatingEnabled   bool
+}
+
+// thresholdsOverride is the validated form of the core.ThresholdsOverride, the nil values are not overridden
+type thresholdsOverride struct {
+	alarmDeltaRatingDrop *float32
+	jailThreshold        *float32
+	enabledChecks        *enabledChecks
+}
+
+type enabledChecks struct {
+	ratingDrop   bool
+	imminentJail bool
+	peersRating  bool
+}
+
+// ArgsThresholdsResolver defines the DTO struct for the NewThresholdsResolver constructor function
+type ArgsThresholdsResolver struct {
+	Name                 string
+	AlarmDeltaRatingDrop float64
+	Identities           *core.IdentitiesHolder
+	KeyOverrides         map[string]core.ThresholdsOverride
+	LabelOverrides       map[string]core.ThresholdsOverride
+}
+
+type thresholdsResolver struct {
+	name                 string
+	alarmDeltaRatingDrop float32
+	configKeyOverrides   map[string]core.ThresholdsOverride
+	labelOverrides       map[string]thresholdsOverride
+	mutOverrides         sync.RWMutex
+	labels               map[string]string
+	keyOverrides         map[string]thresholdsOverride
+	owners               map[string]string
+}
+
+// NewThresholdsResolver creates a new instance of type thresholdsResolver. The thresholds and the enabled checks are
+// resolved for each BLS key, in this order: the key overrides, the overrides of the key's label and the monitor's
+// values. The BLS keys without a label inherit the label of their owner address. The key overrides defined in the
+// identities files are merged with the provided key overrides, the latter ones having priority. The same instance is
+// shared by all the checkers of a monitor
+func NewThresholdsResolver(args ArgsThresholdsResolver) (*thresholdsResolver, error) {
+	if args.Identities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+	if !isAlarmDeltaRatingDropValid(args.AlarmDeltaRatingDrop) {
+		return nil, fmt.Errorf("%w, allowed interval [%0.2f, %0.2f]", errInvalidAlarmDeltaRatingDrop, minAlarmDeltaRatingDrop, maxAlarmDeltaRatingDrop)
+	}
+
+	labelOverrides, err := createThresholdsOverrides(args.LabelOverrides, "label")
+	if err != nil {
+		return nil, err
+	}
+
+	labels := createLabels(args.Identities.Metadata)
+	err = checkLabelsDefined(labelOverrides, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	keyOverrides, err := createKeyOverrides(args.KeyOverrides, args.Identities.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("NewThresholdsResolver", "name", args.Name, "num identities overrides", len(args.Identities.Overrides),
+		"num key overrides", len(args.KeyOverrides), "num label overrides", len(args.LabelOverrides))
+
+	return &thresholdsResolver{
+		name:                 args.Name,
+		alarmDeltaRatingDrop: float32(args.AlarmDeltaRatingDrop),
+		configKeyOverrides:   args.KeyOverrides,
+		labelOverrides:       labelOverrides,
+		labels:               labels,
+		keyOverrides:         keyOverrides,
+		owners:               make(map[string]string),
+	}, nil
+}
+
+func isAlarmDeltaRatingDropValid(value float64) bool {
+	return value >= minAlarmDeltaRatingDrop && value <= maxAlarmDeltaRatingDrop
+}
+
+func isJailThresholdValid(value float64) bool {
+	return value >= minJailThreshold && value <= maxJailThreshold
+}
+
+// SetIdentities will atomically replace the labels and the key overrides defined in the identities files. The current
+// identities are kept if the provided overrides are not valid or if a label override refers to a missing label
+func (resolver *thresholdsResolver) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	keyOverrides, err := createKeyOverrides(resolver.configKeyOverrides, identities.Overrides)
+	if err != nil {
+		return err
+	}
+
+	labels := createLabels(identities.Metadata)
+	err = checkLabelsDefined(resolver.labelOverrides, labels)
+	if err != nil {
+		return err
+	}
+
+	resolver.mutOverrides.Lock()
+	resolver.labels = labels
+	resolver.keyOverrides = keyOverrides
+	resolver.mutOverrides.Unlock()
+
+	log.Debug("thresholdsResolver.SetIdentities", "name", resolver.name, "num key overrides", len(keyOverrides))
+
+	return nil
+}
+
+// SetOwnersBLSKeys will atomically replace the BLS keys found through the monitored owner addresses. These keys inherit
+// the label of their owner address, unless they have their own label
+func (resolver *thresholdsResolver) SetOwnersBLSKeys(ownersBLSKeys []core.OwnerBLSKeys) {
+	owners := make(map[string]string)
+	for _, ownerBLSKeys := range ownersBLSKeys {
+		for _, blsKey := range ownerBLSKeys.BLSKeys {
+			owners[blsKey] = ownerBLSKeys.Owner.Bech32
+		}
+	}
+
+	resolver.mutOverrides.Lock()
+	resolver.owners = owners
+	resolver.mutOverrides.Unlock()
+}
+
+// GetThresholds resolves the thresholds of the BLS key, starting with the monitor's values and applying the overrides
+// from the lowest to the highest priority
+func (resolver *thresholdsResolver) GetThresholds(blsKey string) Thresholds {
+	result := Thresholds{
+		AlarmDeltaRatingDrop: resolver.alarmDeltaRatingDrop,
+		JailThreshold:        defaultJailThreshold,
+		RatingDropEnabled:    true,
+		ImminentJailEnabled:  true,
+		PeersRatingEnabled:   true,
+	}
+
+	resolver.mutOverrides.RLock()
+	defer resolver.mutOverrides.RUnlock()
+
+	label, hasLabel := resolver.labels[blsKey]
+	if !hasLabel {
+		label, hasLabel = resolver.labels[resolver.owners[blsKey]]
+	}
+	if hasLabel {
+		applyOverride(&result, resolver.labelOverrides[label])
+	}
+
+	applyOverride(&result, resolver.keyOverrides[blsKey])
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (resolver *thresholdsResolver) IsInterfaceNil() bool {
+	return resolver == nil
+}
+
+// createKeyOverrides merges the key overrides defined in the identities files with the configured ones, the configured
+// values having priority, and validates the result
+func createKeyOverrides(
+	configOverrides map[string]core.ThresholdsOverride,
+	identitiesOverrides map[string]core.ThresholdsOverride,
+) (map[string]thresholdsOverride, error) {
+	merged := make(map[string]core.ThresholdsOverride, len(configOverrides)+len(identitiesOverrides))
+	for blsKey, override := range identitiesOverrides {
+		merged[blsKey] = override
+	}
+	for blsKey, override := range configOverrides {
+		merged[blsKey] = merged[blsKey].Merge(override)
+	}
+
+	return createThresholdsOverrides(merged, "BLS key")
+}
+
+func createThresholdsOverrides(overrides map[string]core.ThresholdsOverride, identifierType string) (map[string]thresholdsOverride, error) {
+	result := make(map[string]thresholdsOverride, len(overrides))
+	for identifier, override := range overrides {
+		converted, err := createThresholdsOverride(override)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s %s", err, identifierType, identifier)
+		}
+
+		result[identifier] = converted
+	}
+
+	return result, nil
+}
+
+func createThresholdsOverride(override core.ThresholdsOverride) (thresholdsOverride, error) {
+	result := thresholdsOverride{}
+	if override.AlarmDeltaRatingDrop != nil {
+		if !isAlarmDeltaRatingDropValid(*override.AlarmDeltaRatingDrop) {
+			return thresholdsOverride{}, fmt.Errorf("%w, allowed interval [%0.2f, %0.2f]",
+				errInvalidAlarmDeltaRatingDrop, minAlarmDeltaRatingDrop, maxAlarmDeltaRatingDrop)
+		}
+
+		value := float32(*override.AlarmDeltaRatingDrop)
+		result.alarmDeltaRatingDrop = &value
+	}
+	if override.JailThreshold != nil {
+		if !isJailThresholdValid(*override.JailThreshold) {
+			return thresholdsOverride{}, fmt.Errorf("%w, allowed interval [%0.2f, %0.2f]",
+				errInvalidJailThreshold, minJailThreshold, maxJailThreshold)
+		}
+
+		value := float32(*override.JailThreshold)
+		result.jailThreshold = &value
+	}
+	if len(override.EnabledChecks) > 0 {
+		checks, err := createEnabledChecks(override.EnabledChecks)
+		if err != nil {
+			return thresholdsOverride{}, err
+		}
+
+		result.enabledChecks = checks
+	}
+
+	return result, nil
+}
+
+func createEnabledChecks(checks []string) (*enabledChecks, error) {
+	result := &enabledChecks{}
+	for _, check := range checks {
+		switch check {
+		case core.RatingDropCheck:
+			result.ratingDrop = true
+		case core.ImminentJailCheck:
+			result.imminentJail = true
+		case core.PeersRatingCheck:
+			result.peersRating = true
+		case core.NoChecks:
+			if len(checks) > 1 {
+				return nil, fmt.Errorf("%w: %s can not be used with other checks", errInvalidEnabledChecks, core.NoChecks)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown check %s", errInvalidEnabledChecks, check)
+		}
+	}
+
+	return result, nil
+}
+
+func createLabels(metadata map[string]core.IdentityMetadata) map[string]string {
+	labels := make(map[string]string, len(metadata))
+	for identity, identityMetadata := range metadata {
+		if len(identityMetadata.Label) > 0 {
+			labels[identity] = identityMetadata.Label
+		}
+	}
+
+	return labels
+}
+
+func checkLabelsDefined(labelOverrides map[string]thresholdsOverride, labels map[string]string) error {
+	definedLabels := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		definedLabels[label] = struct{}{}
+	}
+
+	for label := range labelOverrides {
+		_, exists := definedLabels[label]
+		if !exists {
+			return fmt.Errorf("%w: %s", errUndefinedOverrideLabel, label)
+		}
+	}
+
+	return nil
+}
+
+func applyOverride(result *Thresholds, override thresholdsOverride) {
+	if override.alarmDeltaRatingDrop != nil {
+		result.AlarmDeltaRatingDrop = *override.alarmDeltaRatingDrop
+	}
+	if override.jailThreshold != nil {
+		result.JailThreshold = *override.jailThreshold
+	}
+	if override.enabledChecks != nil {
+		result.RatingDropEnabled = override.enabledChecks.ratingDrop
+		result.ImminentJailEnabled = override.enabledChecks.imminentJail
+		result.PeersRatingEnabled = override.enabledChecks.peersRating
+	}
+}
@@ -0,0 +1,251 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewThresholdsResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.Identities = nil
+		instance, err := NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("invalid values for alarmDeltaRatingDrop", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []float64{-0.00001, -100, 100.000001, 50000} {
+			instance, err := NewThresholdsResolver(createTestArgsThresholdsResolver(nil, value, nil))
+			assert.Nil(t, instance)
+			assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		}
+	})
+	t.Run("invalid override for alarmDeltaRatingDrop", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewThresholdsResolver(createTestArgsThresholdsResolver(nil, 1.0, map[string]float64{"bls2": 100.1}))
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		assert.Contains(t, err.Error(), "for BLS key bls2")
+	})
+	t.Run("invalid key override should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.KeyOverrides = map[string]core.ThresholdsOverride{
+			"bls1": {AlarmDeltaRatingDrop: floatPointer(-1)},
+		}
+		instance, err := NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		assert.Contains(t, err.Error(), "for BLS key bls1")
+	})
+	t.Run("invalid label override should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {JailThreshold: floatPointer(100.1)},
+		}
+		instance, err := NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidJailThreshold)
+		assert.Contains(t, err.Error(), "for label backup")
+	})
+	t.Run("invalid enabled checks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {EnabledChecks: []string{core.RatingDropCheck, "unknown"}},
+		}
+		instance, err := NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidEnabledChecks)
+		assert.Contains(t, err.Error(), "unknown check unknown")
+
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {EnabledChecks: []string{core.NoChecks, core.RatingDropCheck}},
+		}
+		instance, err = NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidEnabledChecks)
+	})
+	t.Run("undefined label should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {EnabledChecks: []string{core.NoChecks}},
+		}
+		instance, err := NewThresholdsResolver(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errUndefinedOverrideLabel)
+		assert.Contains(t, err.Error(), "backup")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewThresholdsResolver(createTestArgsThresholdsResolver([]string{"bls1", "bls2"}, 1.0, nil))
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestThresholdsResolver_GetThresholds(t *testing.T) {
+	t.Parallel()
+
+	args := createTestArgsThresholdsResolver(nil, 1.0, map[string]float64{"bls3": 0.5})
+	args.Identities.Metadata = map[string]core.IdentityMetadata{
+		"bls2": {Label: "backup"},
+		"bls3": {Label: "backup"},
+	}
+	args.LabelOverrides = map[string]core.ThresholdsOverride{
+		"backup": {AlarmDeltaRatingDrop: floatPointer(2), EnabledChecks: []string{core.PeersRatingCheck}},
+	}
+	args.KeyOverrides = map[string]core.ThresholdsOverride{
+		"bls3": {JailThreshold: floatPointer(20)},
+	}
+	instance, _ := NewThresholdsResolver(args)
+
+	expectedDefaults := Thresholds{
+		AlarmDeltaRatingDrop: 1,
+		JailThreshold:        defaultJailThreshold,
+		RatingDropEnabled:    true,
+		ImminentJailEnabled:  true,
+		PeersRatingEnabled:   true,
+	}
+	assert.Equal(t, expectedDefaults, instance.GetThresholds("bls1"))
+
+	expectedLabelThresholds := Thresholds{
+		AlarmDeltaRatingDrop: 2,
+		JailThreshold:        defaultJailThreshold,
+		PeersRatingEnabled:   true,
+	}
+	assert.Equal(t, expectedLabelThresholds, instance.GetThresholds("bls2"))
+
+	expectedKeyThresholds := Thresholds{
+		AlarmDeltaRatingDrop: 0.5,
+		JailThreshold:        20,
+		PeersRatingEnabled:   true,
+	}
+	assert.Equal(t, expectedKeyThresholds, instance.GetThresholds("bls3"))
+}
+
+func TestThresholdsResolver_SetIdentities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewThresholdsResolver(createTestArgsThresholdsResolver(nil, 1.0, nil))
+		err := instance.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("invalid override should keep the old identities", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewThresholdsResolver(createTestArgsThresholdsResolver(nil, 1.0, map[string]float64{"bls1": 5}))
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Overrides: map[string]core.ThresholdsOverride{"bls1": {AlarmDeltaRatingDrop: floatPointer(-1)}},
+		})
+		assert.ErrorIs(t, err, errInvalidAlarmDeltaRatingDrop)
+		assert.Equal(t, float32(5), instance.GetThresholds("bls1").AlarmDeltaRatingDrop)
+	})
+	t.Run("undefined label should keep the old identities", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+		args.Identities.Metadata = map[string]core.IdentityMetadata{
+			"bls1": {Label: "backup"},
+		}
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {EnabledChecks: []string{core.NoChecks}},
+		}
+		instance, _ := NewThresholdsResolver(args)
+
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Metadata: map[string]core.IdentityMetadata{
+				"bls1": {Label: "main"},
+			},
+		})
+		assert.ErrorIs(t, err, errUndefinedOverrideLabel)
+		assert.False(t, instance.GetThresholds("bls1").RatingDropEnabled)
+	})
+	t.Run("should replace the labels and the overrides", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsThresholdsResolver(nil, 1.0, map[string]float64{"bls1": 5})
+		args.Identities.Metadata = map[string]core.IdentityMetadata{
+			"bls3": {Label: "backup"},
+		}
+		args.LabelOverrides = map[string]core.ThresholdsOverride{
+			"backup": {EnabledChecks: []string{core.NoChecks}},
+		}
+		instance, _ := NewThresholdsResolver(args)
+		assert.True(t, instance.GetThresholds("bls2").RatingDropEnabled)
+
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Metadata: map[string]core.IdentityMetadata{
+				"bls2": {Label: "backup"},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, float32(1), instance.GetThresholds("bls1").AlarmDeltaRatingDrop)
+		assert.False(t, instance.GetThresholds("bls2").RatingDropEnabled)
+	})
+}
+
+func TestThresholdsResolver_SetOwnersBLSKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createTestArgsThresholdsResolver(nil, 1.0, nil)
+	args.Identities.Metadata = map[string]core.IdentityMetadata{
+		"erd1owner": {Label: "backup"},
+		"bls2":      {Label: "main"},
+	}
+	args.LabelOverrides = map[string]core.ThresholdsOverride{
+		"backup": {EnabledChecks: []string{core.NoChecks}},
+		"main":   {JailThreshold: floatPointer(20)},
+	}
+	instance, _ := NewThresholdsResolver(args)
+	assert.True(t, instance.GetThresholds("bls1").RatingDropEnabled)
+
+	instance.SetOwnersBLSKeys([]core.OwnerBLSKeys{
+		{
+			Owner:   core.Address{Bech32: "erd1owner"},
+			BLSKeys: []string{"bls1", "bls2"},
+		},
+		{
+			Owner:   core.Address{Bech32: "erd1other"},
+			BLSKeys: []string{"bls3"},
+		},
+	})
+
+	// the key without a label inherits the label of its owner address
+	assert.False(t, instance.GetThresholds("bls1").RatingDropEnabled)
+	// the key's own label has priority
+	keyThresholds := instance.GetThresholds("bls2")
+	assert.True(t, keyThresholds.RatingDropEnabled)
+	assert.Equal(t, float32(20), keyThresholds.JailThreshold)
+	// the owner address without a label
+	assert.True(t, instance.GetThresholds("bls3").RatingDropEnabled)
+}
+
+func TestThresholdsResolver_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *thresholdsResolver
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &thresholdsResolver{}
+	assert.False(t, instance.IsInterfaceNil())
+}
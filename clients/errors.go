@@ -0,0 +1,17 @@
+package clients
+
+import "errors"
+
+var (
+	errNoEndpoints                   = errors.New("no API endpoints provided")
+	errEmptyEndpointURL              = errors.New("empty API endpoint URL")
+	errNilHTTPClient                 = errors.New("nil HTTP client")
+	errInvalidRequestTimeout         = errors.New("invalid request timeout")
+	errInvalidUnhealthyRetryInterval = errors.New("invalid unhealthy retry interval")
+	errNilTimeFunc                   = errors.New("nil time function")
+	errInvalidHTTPClientTimeout      = errors.New("invalid HTTP client timeout")
+	errBothBasicAndBearerAuth        = errors.New("both basic and bearer authorization are set")
+	errInvalidProxyURL               = errors.New("invalid proxy URL")
+	errInvalidCABundle               = errors.New("no valid PEM certificate found in the CA bundle")
+	errIncompleteClientCertificate   = errors.New("the client certificate and the client key files should be set together")
+)
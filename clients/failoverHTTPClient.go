@@ -0,0 +1,205 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("clients")
+
+// Endpoint defines one API endpoint and the HTTP client used to query it
+type Endpoint struct {
+	URL    string
+	Client HTTPClient
+}
+
+// ArgsFailoverHTTPClient is the DTO used to create a new instance of type failoverHTTPClient
+type ArgsFailoverHTTPClient struct {
+	Endpoints              []Endpoint
+	RequestTimeout         time.Duration
+	UnhealthyRetryInterval time.Duration
+	TimeFunc               func() time.Time
+	Name                   string
+}
+
+type endpointHealth struct {
+	numConsecutiveFailures uint32
+	unhealthyUntil         time.Time
+}
+
+type failoverHTTPClient struct {
+	endpoints              []Endpoint
+	requestTimeout         time.Duration
+	unhealthyRetryInterval time.Duration
+	timeFunc               func() time.Time
+	name                   string
+
+	mut         sync.RWMutex
+	health      []endpointHealth
+	activeIndex int
+}
+
+// NewFailoverHTTPClient creates an HTTP client that queries a list of API endpoints. The first endpoint is the
+// primary one. A request is sent to the next endpoint if the current one errors, times out or responds with a
+// non-2xx status code. The failed endpoints are skipped until the retry interval passes, so the primary endpoint is
+// preferred again as soon as it recovers
+func NewFailoverHTTPClient(args ArgsFailoverHTTPClient) (*failoverHTTPClient, error) {
+	err := checkArgsFailoverHTTPClient(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failoverHTTPClient{
+		endpoints:              args.Endpoints,
+		requestTimeout:         args.RequestTimeout,
+		unhealthyRetryInterval: args.UnhealthyRetryInterval,
+		timeFunc:               args.TimeFunc,
+		name:                   args.Name,
+		health:                 make([]endpointHealth, len(args.Endpoints)),
+	}, nil
+}
+
+func checkArgsFailoverHTTPClient(args ArgsFailoverHTTPClient) error {
+	if len(args.Endpoints) == 0 {
+		return errNoEndpoints
+	}
+	for index, endpoint := range args.Endpoints {
+		if len(endpoint.URL) == 0 {
+			return fmt.Errorf("%w at index %d", errEmptyEndpointURL, index)
+		}
+		if check.IfNil(endpoint.Client) {
+			return fmt.Errorf("%w for endpoint %s", errNilHTTPClient, endpoint.URL)
+		}
+	}
+	if args.RequestTimeout <= 0 {
+		return errInvalidRequestTimeout
+	}
+	if args.UnhealthyRetryInterval <= 0 {
+		return errInvalidUnhealthyRetryInterval
+	}
+	if args.TimeFunc == nil {
+		return errNilTimeFunc
+	}
+
+	return nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint, failing over to the next API endpoints if required
+func (client *failoverHTTPClient) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return client.do(ctx, func(requestCtx context.Context, httpClient HTTPClient) ([]byte, int, error) {
+		return httpClient.GetHTTP(requestCtx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the specified endpoint, failing over to the next API endpoints if required
+func (client *failoverHTTPClient) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return client.do(ctx, func(requestCtx context.Context, httpClient HTTPClient) ([]byte, int, error) {
+		return httpClient.PostHTTP(requestCtx, endpoint, data)
+	})
+}
+
+// do sends the request on the API endpoints, in order, until one of them responds with a 2xx status code. If all
+// endpoints fail, the last response is returned so the caller can handle it
+func (client *failoverHTTPClient) do(
+	ctx context.Context,
+	request func(requestCtx context.Context, httpClient HTTPClient) ([]byte, int, error),
+) ([]byte, int, error) {
+	var body []byte
+	var statusCode int
+	var err error
+	for _, index := range client.endpointsOrder() {
+		requestCtx, cancel := context.WithTimeout(ctx, client.requestTimeout)
+		body, statusCode, err = request(requestCtx, client.endpoints[index].Client)
+		cancel()
+
+		if err == nil && core.IsHttpStatusCodeSuccess(statusCode) {
+			client.markHealthy(index)
+			return body, statusCode, nil
+		}
+
+		client.markUnhealthy(index, err, statusCode)
+		if ctx.Err() != nil {
+			// the caller gave up, there is no point in trying the other endpoints
+			break
+		}
+	}
+
+	return body, statusCode, err
+}
+
+// endpointsOrder returns the indexes of the endpoints that can be tried, in the configured order, followed by the
+// indexes of the endpoints still considered unhealthy as a last resort
+func (client *failoverHTTPClient) endpointsOrder() []int {
+	now := client.timeFunc()
+
+	client.mut.RLock()
+	defer client.mut.RUnlock()
+
+	available := make([]int, 0, len(client.endpoints))
+	unhealthy := make([]int, 0)
+	for index, health := range client.health {
+		if now.Before(health.unhealthyUntil) {
+			unhealthy = append(unhealthy, index)
+			continue
+		}
+
+		available = append(available, index)
+	}
+
+	return append(available, unhealthy...)
+}
+
+func (client *failoverHTTPClient) markHealthy(index int) {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+
+	client.health[index] = endpointHealth{}
+	if client.activeIndex == index {
+		return
+	}
+
+	log.Info("switched the active API endpoint", "name", client.name,
+		"from", client.endpoints[client.activeIndex].URL, "to", client.endpoints[index].URL)
+	client.activeIndex = index
+}
+
+func (client *failoverHTTPClient) markUnhealthy(index int, err error, statusCode int) {
+	lastError := fmt.Sprintf("status code %d", statusCode)
+	if err != nil {
+		lastError = err.Error()
+	}
+
+	client.mut.Lock()
+	defer client.mut.Unlock()
+
+	health := &client.health[index]
+	health.numConsecutiveFailures++
+	health.unhealthyUntil = client.timeFunc().Add(client.unhealthyRetryInterval)
+
+	log.Debug("API endpoint request failed", "name", client.name, "URL", client.endpoints[index].URL,
+		"consecutive failures", health.numConsecutiveFailures, "error", lastError)
+}
+
+// ActiveURL returns the URL of the API endpoint that responded successfully last time
+func (client *failoverHTTPClient) ActiveURL() string {
+	client.mut.RLock()
+	defer client.mut.RUnlock()
+
+	return client.endpoints[client.activeIndex].URL
+}
+
+// PrimaryURL returns the URL of the first configured API endpoint
+func (client *failoverHTTPClient) PrimaryURL() string {
+	return client.endpoints[0].URL
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *failoverHTTPClient) IsInterfaceNil() bool {
+	return client == nil
+}
@@ -0,0 +1,304 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type endpointBehaviour struct {
+	statusCode int
+	err        error
+	numCalls   int
+}
+
+func createTestEndpoints(behaviours map[string]*endpointBehaviour, urls ...string) []Endpoint {
+	endpoints := make([]Endpoint, 0, len(urls))
+	for _, url := range urls {
+		url := url
+		behaviour := behaviours[url]
+		endpoints = append(endpoints, Endpoint{
+			URL: url,
+			Client: &mock.HTTPClientWrapperStub{
+				GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+					behaviour.numCalls++
+					return []byte(url + "/" + endpoint), behaviour.statusCode, behaviour.err
+				},
+				PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+					behaviour.numCalls++
+					return append([]byte(url+"/"+endpoint+":"), data...), behaviour.statusCode, behaviour.err
+				},
+			},
+		})
+	}
+
+	return endpoints
+}
+
+func createMockArgsFailoverHTTPClient(endpoints []Endpoint, currentTime *time.Time) ArgsFailoverHTTPClient {
+	return ArgsFailoverHTTPClient{
+		Endpoints:              endpoints,
+		RequestTimeout:         time.Second,
+		UnhealthyRetryInterval: time.Minute,
+		TimeFunc: func() time.Time {
+			return *currentTime
+		},
+		Name: "test",
+	}
+}
+
+func TestNewFailoverHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	behaviours := map[string]*endpointBehaviour{"url1": {}}
+	t.Run("no endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverHTTPClient(nil, &currentTime)
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.Equal(t, errNoEndpoints, err)
+	})
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := createTestEndpoints(behaviours, "url1")
+		endpoints = append(endpoints, Endpoint{Client: &mock.HTTPClientWrapperStub{}})
+		args := createMockArgsFailoverHTTPClient(endpoints, &currentTime)
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errEmptyEndpointURL)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []Endpoint{{URL: "url2"}}
+		args := createMockArgsFailoverHTTPClient(endpoints, &currentTime)
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errNilHTTPClient)
+		assert.Contains(t, err.Error(), "url2")
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1"), &currentTime)
+		args.RequestTimeout = 0
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.Equal(t, errInvalidRequestTimeout, err)
+	})
+	t.Run("invalid unhealthy retry interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1"), &currentTime)
+		args.UnhealthyRetryInterval = 0
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.Equal(t, errInvalidUnhealthyRetryInterval, err)
+	})
+	t.Run("nil time function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1"), &currentTime)
+		args.TimeFunc = nil
+		client, err := NewFailoverHTTPClient(args)
+		assert.Nil(t, client)
+		assert.Equal(t, errNilTimeFunc, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1"), &currentTime)
+		client, err := NewFailoverHTTPClient(args)
+		assert.NotNil(t, client)
+		assert.Nil(t, err)
+		assert.Equal(t, "url1", client.ActiveURL())
+		assert.Equal(t, "url1", client.PrimaryURL())
+	})
+}
+
+func TestFailoverHTTPClient_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *failoverHTTPClient
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &failoverHTTPClient{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestFailoverHTTPClient_GetHTTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("primary works should not query the other endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		behaviours := map[string]*endpointBehaviour{
+			"url1": {statusCode: http.StatusOK},
+			"url2": {statusCode: http.StatusOK},
+		}
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2"), &currentTime)
+		client, _ := NewFailoverHTTPClient(args)
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "url1/endpoint", string(body))
+		assert.Equal(t, 1, behaviours["url1"].numCalls)
+		assert.Zero(t, behaviours["url2"].numCalls)
+		assert.Equal(t, "url1", client.ActiveURL())
+	})
+	t.Run("failover on errors, timeouts and non-2xx status codes", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		behaviours := map[string]*endpointBehaviour{
+			"url1": {err: errors.New("connection refused")},
+			"url2": {err: context.DeadlineExceeded},
+			"url3": {statusCode: http.StatusBadGateway},
+			"url4": {statusCode: http.StatusOK},
+		}
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2", "url3", "url4"), &currentTime)
+		client, _ := NewFailoverHTTPClient(args)
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "url4/endpoint", string(body))
+		assert.Equal(t, "url4", client.ActiveURL())
+		assert.Equal(t, "url1", client.PrimaryURL())
+
+		// the unhealthy endpoints are skipped during the retry interval
+		_, _, _ = client.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, 1, behaviours["url1"].numCalls)
+		assert.Equal(t, 1, behaviours["url2"].numCalls)
+		assert.Equal(t, 1, behaviours["url3"].numCalls)
+		assert.Equal(t, 2, behaviours["url4"].numCalls)
+	})
+	t.Run("all endpoints fail should return the last response", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		behaviours := map[string]*endpointBehaviour{
+			"url1": {err: errors.New("connection refused")},
+			"url2": {statusCode: http.StatusNotFound},
+		}
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2"), &currentTime)
+		client, _ := NewFailoverHTTPClient(args)
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+		assert.Equal(t, "url2/endpoint", string(body))
+		assert.Equal(t, "url1", client.ActiveURL())
+
+		// all endpoints are unhealthy, all of them are tried as a last resort
+		_, _, _ = client.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, 2, behaviours["url1"].numCalls)
+		assert.Equal(t, 2, behaviours["url2"].numCalls)
+	})
+	t.Run("primary recovers should be preferred again", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		behaviours := map[string]*endpointBehaviour{
+			"url1": {statusCode: http.StatusServiceUnavailable},
+			"url2": {statusCode: http.StatusOK},
+		}
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2"), &currentTime)
+		client, _ := NewFailoverHTTPClient(args)
+
+		_, _, _ = client.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, "url2", client.ActiveURL())
+
+		behaviours["url1"].statusCode = http.StatusOK
+		currentTime = currentTime.Add(time.Second * 59)
+		body, _, _ := client.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, "url2/endpoint", string(body))
+		assert.Equal(t, 1, behaviours["url1"].numCalls)
+
+		currentTime = currentTime.Add(time.Second)
+		body, _, _ = client.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, "url1/endpoint", string(body))
+		assert.Equal(t, "url1", client.ActiveURL())
+		assert.Equal(t, 2, behaviours["url1"].numCalls)
+		assert.Equal(t, 2, behaviours["url2"].numCalls)
+	})
+	t.Run("request timeout should be applied on each endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		endpoints := []Endpoint{
+			{
+				URL: "url1",
+				Client: &mock.HTTPClientWrapperStub{
+					GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+						<-ctx.Done()
+						return nil, http.StatusBadRequest, ctx.Err()
+					},
+				},
+			},
+			{
+				URL: "url2",
+				Client: &mock.HTTPClientWrapperStub{
+					GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+						return []byte("response"), http.StatusOK, nil
+					},
+				},
+			},
+		}
+		args := createMockArgsFailoverHTTPClient(endpoints, &currentTime)
+		args.RequestTimeout = time.Millisecond * 10
+		client, _ := NewFailoverHTTPClient(args)
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "response", string(body))
+		assert.Equal(t, "url2", client.ActiveURL())
+	})
+	t.Run("canceled context should not try the other endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		behaviours := map[string]*endpointBehaviour{
+			"url1": {err: context.Canceled},
+			"url2": {statusCode: http.StatusOK},
+		}
+		args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2"), &currentTime)
+		client, _ := NewFailoverHTTPClient(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := client.GetHTTP(ctx, "endpoint")
+		assert.Equal(t, context.Canceled, err)
+		assert.Zero(t, behaviours["url2"].numCalls)
+	})
+}
+
+func TestFailoverHTTPClient_PostHTTP(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	behaviours := map[string]*endpointBehaviour{
+		"url1": {statusCode: http.StatusInternalServerError},
+		"url2": {statusCode: http.StatusOK},
+	}
+	args := createMockArgsFailoverHTTPClient(createTestEndpoints(behaviours, "url1", "url2"), &currentTime)
+	client, _ := NewFailoverHTTPClient(args)
+
+	body, statusCode, err := client.PostHTTP(context.Background(), "endpoint", []byte("data"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "url2/endpoint:data", string(body))
+	assert.Equal(t, "url2", client.ActiveURL())
+}
@@ -0,0 +1,144 @@
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+const authorizationHeaderKey = "Authorization"
+
+// ArgsHTTPClient is the DTO used to create a new custom HTTP client
+type ArgsHTTPClient struct {
+	Timeout        time.Duration
+	Headers        map[string]string
+	Username       string
+	Password       string
+	BearerToken    string
+	ProxyURL       string
+	CABundleFile   string
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewHTTPClient creates an HTTP client with the provided options. The headers and the authorization are added to each
+// request that does not already define them. The proxy URL, the CA bundle and the client certificate are optional, if
+// not set the default transport options are used. A 0 timeout means no timeout
+func NewHTTPClient(args ArgsHTTPClient) (*http.Client, error) {
+	if args.Timeout < 0 {
+		return nil, errInvalidHTTPClientTimeout
+	}
+	if len(args.BearerToken) > 0 && len(args.Username) > 0 {
+		return nil, errBothBasicAndBearerAuth
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(args.ProxyURL) > 0 {
+		proxyURL, err := url.Parse(args.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidProxyURL, err.Error())
+		}
+		if len(proxyURL.Scheme) == 0 || len(proxyURL.Host) == 0 {
+			return nil, fmt.Errorf("%w: %s", errInvalidProxyURL, args.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := createTLSConfig(args)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	headers := make(http.Header)
+	for key, value := range args.Headers {
+		headers.Set(key, value)
+	}
+	if len(args.BearerToken) > 0 {
+		headers.Set(authorizationHeaderKey, "Bearer "+args.BearerToken)
+	}
+
+	return &http.Client{
+		Timeout: args.Timeout,
+		Transport: &headersRoundTripper{
+			transport: transport,
+			headers:   headers,
+			username:  args.Username,
+			password:  args.Password,
+		},
+	}, nil
+}
+
+func createTLSConfig(args ArgsHTTPClient) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(args.CABundleFile) > 0 {
+		caBundle, err := os.ReadFile(args.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the CA bundle file %s", err, args.CABundleFile)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("%w in file %s", errInvalidCABundle, args.CABundleFile)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	hasCertFile := len(args.ClientCertFile) > 0
+	hasKeyFile := len(args.ClientKeyFile) > 0
+	if hasCertFile != hasKeyFile {
+		return nil, errIncompleteClientCertificate
+	}
+	if hasCertFile {
+		certificate, err := tls.LoadX509KeyPair(args.ClientCertFile, args.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the client certificate %s", err, args.ClientCertFile)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// headersRoundTripper adds the configured headers and the basic authorization to the requests
+type headersRoundTripper struct {
+	transport http.RoundTripper
+	headers   http.Header
+	username  string
+	password  string
+}
+
+// RoundTrip adds the headers that are not already set on the request and sends it using the wrapped transport
+func (roundTripper *headersRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if len(roundTripper.headers) == 0 && len(roundTripper.username) == 0 {
+		return roundTripper.transport.RoundTrip(request)
+	}
+
+	// a round tripper should not modify the provided request
+	request = request.Clone(request.Context())
+	for key, values := range roundTripper.headers {
+		if len(request.Header.Values(key)) > 0 {
+			continue
+		}
+
+		request.Header[key] = values
+	}
+	if len(roundTripper.username) > 0 && len(request.Header.Get(authorizationHeaderKey)) == 0 {
+		request.SetBasicAuth(roundTripper.username, roundTripper.password)
+	}
+
+	return roundTripper.transport.RoundTrip(request)
+}
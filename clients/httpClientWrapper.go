@@ -0,0 +1,127 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const (
+	httpUserAgentKey   = "User-Agent"
+	httpUserAgent      = "MultiversX keys monitor"
+	httpAcceptTypeKey  = "Accept"
+	httpAcceptType     = "application/json"
+	httpContentTypeKey = "Content-Type"
+	httpContentType    = "application/json"
+)
+
+type httpClientWrapper struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPClientWrapper creates a new HTTP client wrapper. Unlike the mx-sdk-go wrapper, this one can also return
+// the response headers. If the provided client is nil, the default HTTP client will be used
+func NewHTTPClientWrapper(client *http.Client, url string) *httpClientWrapper {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &httpClientWrapper{
+		url:    url,
+		client: client,
+	}
+}
+
+// GetHTTP does a GET method operation on the specified endpoint
+func (wrapper *httpClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	response, err := wrapper.do(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return response.Body, response.StatusCode, nil
+}
+
+// PostHTTP does a POST method operation on the specified endpoint with the provided raw data bytes
+func (wrapper *httpClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	response, err := wrapper.do(ctx, http.MethodPost, endpoint, data, nil)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return response.Body, response.StatusCode, nil
+}
+
+// PostHTTPWithResponse does a POST method operation on the specified endpoint and returns the whole response
+func (wrapper *httpClientWrapper) PostHTTPWithResponse(ctx context.Context, endpoint string, data []byte) (*core.HTTPResponse, error) {
+	return wrapper.do(ctx, http.MethodPost, endpoint, data, nil)
+}
+
+// GetHTTPWithHeaders does a GET method operation on the specified endpoint, adding the provided headers to the request.
+// An empty endpoint will call the wrapper's URL. Returns the whole response
+func (wrapper *httpClientWrapper) GetHTTPWithHeaders(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+	return wrapper.do(ctx, http.MethodGet, endpoint, nil, headers)
+}
+
+func (wrapper *httpClientWrapper) do(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	data []byte,
+	headers http.Header,
+) (*core.HTTPResponse, error) {
+	url := wrapper.url
+	if len(endpoint) > 0 {
+		url = fmt.Sprintf("%s/%s", wrapper.url, endpoint)
+	}
+
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set(httpAcceptTypeKey, httpAcceptType)
+	request.Header.Set(httpUserAgentKey, httpUserAgent)
+	if method == http.MethodPost {
+		request.Header.Set(httpContentTypeKey, httpContentType)
+	}
+	for key, values := range headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
+	response, err := wrapper.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.HTTPResponse{
+		Body:       responseBody,
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *httpClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
@@ -0,0 +1,132 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClientWrapper(t *testing.T) {
+	t.Parallel()
+
+	wrapper := NewHTTPClientWrapper(nil, "url")
+	assert.NotNil(t, wrapper)
+	assert.Equal(t, http.DefaultClient, wrapper.client)
+}
+
+func TestHttpClientWrapper_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *httpClientWrapper
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &httpClientWrapper{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestHttpClientWrapper_GetHTTP(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/endpoint", req.URL.Path)
+		assert.Equal(t, httpAcceptType, req.Header.Get(httpAcceptTypeKey))
+		assert.Equal(t, httpUserAgent, req.Header.Get(httpUserAgentKey))
+		assert.Empty(t, req.Header.Get(httpContentTypeKey))
+
+		rw.WriteHeader(http.StatusAccepted)
+		_, _ = rw.Write([]byte("response"))
+	}))
+	defer testServer.Close()
+
+	wrapper := NewHTTPClientWrapper(nil, testServer.URL)
+	body, statusCode, err := wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, statusCode)
+	assert.Equal(t, []byte("response"), body)
+}
+
+func TestHttpClientWrapper_GetHTTPWithHeaders(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/inventory.json", req.URL.Path)
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		assert.Equal(t, httpUserAgent, req.Header.Get(httpUserAgentKey))
+
+		rw.Header().Set("ETag", "etag")
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte("response"))
+	}))
+	defer testServer.Close()
+
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer token")
+
+	// the full URL is provided, without endpoint
+	wrapper := NewHTTPClientWrapper(nil, testServer.URL+"/inventory.json")
+	response, err := wrapper.GetHTTPWithHeaders(context.Background(), "", headers)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, []byte("response"), response.Body)
+	assert.Equal(t, "etag", response.Header.Get("ETag"))
+}
+
+func TestHttpClientWrapper_PostHTTP(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, httpContentType, req.Header.Get(httpContentTypeKey))
+
+		data, _ := io.ReadAll(req.Body)
+		assert.Equal(t, []byte("request"), data)
+
+		rw.Header().Set("Retry-After", "37")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		_, _ = rw.Write([]byte("response"))
+	}))
+	defer testServer.Close()
+
+	wrapper := NewHTTPClientWrapper(nil, testServer.URL)
+	body, statusCode, err := wrapper.PostHTTP(context.Background(), "endpoint", []byte("request"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, statusCode)
+	assert.Equal(t, []byte("response"), body)
+
+	response, err := wrapper.PostHTTPWithResponse(context.Background(), "endpoint", []byte("request"))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, []byte("response"), response.Body)
+	assert.Equal(t, "37", response.Header.Get("Retry-After"))
+}
+
+func TestHttpClientWrapper_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(time.Second)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	wrapper := NewHTTPClientWrapper(nil, testServer.URL)
+	body, statusCode, err := wrapper.GetHTTP(ctx, "endpoint")
+	assert.Nil(t, body)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Contains(t, err.Error(), "context deadline exceeded")
+
+	response, err := wrapper.PostHTTPWithResponse(ctx, "endpoint", nil)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "context deadline exceeded")
+}
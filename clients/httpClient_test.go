@@ -0,0 +1,257 @@
+package clients
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeServerCertificate(t *testing.T, server *httptest.Server) string {
+	filename := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.Nil(t, os.WriteFile(filename, data, 0600))
+
+	return filename
+}
+
+// writeClientCertificate generates a self-signed client certificate and returns the certificate, the certificate
+// file and the key file
+func writeClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	require.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateBytes}), 0600))
+	require.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+
+	return certificate, certFile, keyFile
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{Timeout: -time.Second})
+		assert.Nil(t, client)
+		assert.Equal(t, errInvalidHTTPClientTimeout, err)
+	})
+	t.Run("both basic and bearer authorization should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{Username: "user", BearerToken: "token"})
+		assert.Nil(t, client)
+		assert.Equal(t, errBothBasicAndBearerAuth, err)
+	})
+	t.Run("invalid proxy URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{ProxyURL: "proxy:8080"})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errInvalidProxyURL)
+
+		client, err = NewHTTPClient(ArgsHTTPClient{ProxyURL: "http://proxy\n"})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errInvalidProxyURL)
+	})
+	t.Run("missing CA bundle file should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{CABundleFile: "missing.pem"})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("invalid CA bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "ca.pem")
+		require.Nil(t, os.WriteFile(filename, []byte("not a certificate"), 0600))
+
+		client, err := NewHTTPClient(ArgsHTTPClient{CABundleFile: filename})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errInvalidCABundle)
+	})
+	t.Run("client certificate without key should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{ClientCertFile: "client.pem"})
+		assert.Nil(t, client)
+		assert.Equal(t, errIncompleteClientCertificate, err)
+	})
+	t.Run("invalid client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{ClientCertFile: "client.pem", ClientKeyFile: "client.key"})
+		assert.Nil(t, client)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "while loading the client certificate client.pem")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{
+			Timeout:  time.Second,
+			ProxyURL: "http://127.0.0.1:3128",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, time.Second, client.Timeout)
+	})
+}
+
+func TestHTTPClient_Headers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should add the headers and the bearer authorization", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "key", req.Header.Get("X-Api-Key"))
+			assert.Equal(t, "Bearer token", req.Header.Get(authorizationHeaderKey))
+			assert.Equal(t, httpUserAgent, req.Header.Get(httpUserAgentKey))
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		client, _ := NewHTTPClient(ArgsHTTPClient{
+			Headers:     map[string]string{"X-Api-Key": "key"},
+			BearerToken: "token",
+		})
+		body, statusCode, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Empty(t, body)
+	})
+	t.Run("should add the basic authorization", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			username, password, ok := req.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		client, _ := NewHTTPClient(ArgsHTTPClient{Username: "user", Password: "pass"})
+		_, statusCode, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+	})
+	t.Run("the request headers should not be overwritten", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Bearer list token", req.Header.Get(authorizationHeaderKey))
+			assert.Equal(t, httpAcceptType, req.Header.Get(httpAcceptTypeKey))
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		client, _ := NewHTTPClient(ArgsHTTPClient{
+			Headers:     map[string]string{httpAcceptTypeKey: "text/plain"},
+			BearerToken: "token",
+		})
+		headers := http.Header{}
+		headers.Set(authorizationHeaderKey, "Bearer list token")
+		response, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTPWithHeaders(context.Background(), "", headers)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+}
+
+func TestHTTPClient_TLS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown server certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		client, _ := NewHTTPClient(ArgsHTTPClient{})
+		_, _, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.NotNil(t, err)
+	})
+	t.Run("custom CA bundle should work", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		client, err := NewHTTPClient(ArgsHTTPClient{CABundleFile: writeServerCertificate(t, testServer)})
+		require.Nil(t, err)
+		_, statusCode, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+	})
+	t.Run("client certificate should work", func(t *testing.T) {
+		t.Parallel()
+
+		clientCertificate, certFile, keyFile := writeClientCertificate(t)
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(clientCertificate)
+
+		testServer := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+		}))
+		testServer.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+		testServer.StartTLS()
+		defer testServer.Close()
+
+		caBundleFile := writeServerCertificate(t, testServer)
+		client, _ := NewHTTPClient(ArgsHTTPClient{CABundleFile: caBundleFile})
+		_, _, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.NotNil(t, err)
+
+		client, err = NewHTTPClient(ArgsHTTPClient{
+			CABundleFile:   caBundleFile,
+			ClientCertFile: certFile,
+			ClientKeyFile:  keyFile,
+		})
+		require.Nil(t, err)
+		_, statusCode, err := NewHTTPClientWrapper(client, testServer.URL).GetHTTP(context.Background(), "")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+	})
+}
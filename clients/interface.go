@@ -0,0 +1,10 @@
+package clients
+
+import "context"
+
+// HTTPClient defines the operations of an HTTP client bound to one API endpoint
+type HTTPClient interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
@@ -192,7 +192,7 @@ func execute(c *cli.Context, allConfigs config.AllConfigs, baseVersion string, l
 func testNotifiersCommand(allConfigs config.AllConfigs, log logger.Logger) error {
 	log.Info("testing the configured notifiers")
 
-	notifiers, err := factory.CreateOutputNotifiers(allConfigs)
+	notifiers, _, err := factory.CreateOutputNotifiers(allConfigs)
 	if err != nil {
 		return err
 	}
@@ -224,6 +224,7 @@ func startMonitoringTool(allConfigs config.AllConfigs, baseVersion string, log l
 
 	err := startMonitoring(allConfigs)
 	if err != nil {
+		closeAll(log)
 		return err
 	}
 
@@ -231,6 +232,7 @@ func startMonitoringTool(allConfigs config.AllConfigs, baseVersion string, log l
 	log.Info("terminating at user's signal...")
 	statusHandler.SendCloseMessage()
 
+	// best effort: the close message is not stored in the outbox, so it is lost if not delivered in this interval
 	time.Sleep(time.Second * 5)
 
 	closeAll(log)
@@ -239,21 +241,19 @@ func startMonitoringTool(allConfigs config.AllConfigs, baseVersion string, log l
 }
 
 func startMonitoring(allConfigs config.AllConfigs) error {
-	notifiersList, err := factory.CreateOutputNotifiers(allConfigs)
+	notifiersList, rateLimiters, err := factory.CreateOutputNotifiers(allConfigs)
 	if err != nil {
 		return err
 	}
 
-	argsNotifiersHandler := executors.ArgsNotifiersHandler{
-		Notifiers:          notifiersList,
-		NumRetries:         allConfigs.Config.OutputNotifiers.NumRetries,
-		TimeBetweenRetries: time.Duration(allConfigs.Config.OutputNotifiers.SecondsBetweenRetries) * time.Second,
-	}
-
-	notifiersHandler, err := executors.NewNotifiersHandler(argsNotifiersHandler)
+	notifiersHandler, notifiersHandlerCloser, err := factory.CreateNotifiersHandler(allConfigs.Config.OutputNotifiers, notifiersList, rateLimiters)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		// the notifiers handler should be the last one closed, so the other components can still push notifications
+		closers = append(closers, notifiersHandlerCloser)
+	}()
 
 	var polling io.Closer
 	statusHandler, polling, err = factory.CreateStatusHandler(allConfigs.Config.General, notifiersHandler)
@@ -263,10 +263,86 @@ func startMonitoring(allConfigs config.AllConfigs) error {
 
 	closers = append(closers, polling)
 
+	statisticsCache, err := factory.NewStatisticsCache(allConfigs.Config.General)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the statistics cache is closed after the monitors using it
+		closers = append(closers, statisticsCache)
+	}()
+
+	// the identities of all monitors are loaded and checked for duplicates before any monitor is started
+	monitorsIdentities := make([]*factory.BLSKeysMonitorIdentities, 0, len(allConfigs.Config.BLSKeysMonitoring))
 	for _, blsKeysConfig := range allConfigs.Config.BLSKeysMonitoring {
+		monitorIdentities, errLoad := factory.LoadBLSKeysMonitorIdentities(blsKeysConfig, allConfigs.Credentials)
+		if errLoad != nil {
+			return errLoad
+		}
+
+		monitorsIdentities = append(monitorsIdentities, monitorIdentities)
+	}
+
+	err = factory.CheckDuplicatedIdentities(
+		monitorsIdentities,
+		allConfigs.Config.General.FailOnDuplicatedIdentities,
+		notifiersHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, monitorIdentities := range monitorsIdentities {
 		monitor, errCreate := factory.NewBLSKeysMonitor(
-			blsKeysConfig,
+			monitorIdentities,
+			allConfigs.Config.General.AlarmSnooze,
+			allConfigs.Credentials,
+			notifiersHandler,
+			statusHandler,
+			statisticsCache,
+		)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		closers = append(closers, monitor)
+	}
+
+	for _, nodesConfig := range allConfigs.Config.NodesMonitoring {
+		monitor, errCreate := factory.NewNodesMonitor(
+			nodesConfig,
+			allConfigs.Config.General.AlarmSnooze,
+			allConfigs.Credentials,
+			notifiersHandler,
+			statusHandler,
+		)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		closers = append(closers, monitor)
+	}
+
+	for _, delegationConfig := range allConfigs.Config.DelegationMonitoring {
+		monitor, errCreate := factory.NewDelegationMonitor(
+			delegationConfig,
+			allConfigs.Config.General.AlarmSnooze,
+			allConfigs.Credentials,
+			notifiersHandler,
+			statusHandler,
+		)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		closers = append(closers, monitor)
+	}
+
+	for _, balanceConfig := range allConfigs.Config.BalanceMonitoring {
+		monitor, errCreate := factory.NewBalanceMonitor(
+			balanceConfig,
 			allConfigs.Config.General.AlarmSnooze,
+			allConfigs.Credentials,
 			notifiersHandler,
 			statusHandler,
 		)
@@ -2,10 +2,12 @@ package config
 
 // CredentialsConfig defines the credentials configuration file
 type CredentialsConfig struct {
-	Pushover PushoverCredentialsConfig
-	Smtp     EmailPasswordConfig
-	Telegram TelegramCredentialsConfig
-	Slack    SlackCredentialsConfig
+	Pushover    PushoverCredentialsConfig
+	Smtp        EmailPasswordConfig
+	Telegram    TelegramCredentialsConfig
+	Slack       SlackCredentialsConfig
+	ListURLs    []ListURLCredentialsConfig
+	HTTPClients []HTTPClientCredentialsConfig
 }
 
 // TokenUserKeyConfig defines a struct that contains one token and one user key
@@ -48,3 +50,19 @@ type SlackCredentialsConfig struct {
 	SlackSecretConfig
 	Additional []SlackSecretConfig
 }
+
+// ListURLCredentialsConfig defines the credentials used when fetching the list URL of a BLS keys monitor
+type ListURLCredentialsConfig struct {
+	Name        string
+	BearerToken string
+}
+
+// HTTPClientCredentialsConfig defines the authorization and the secret headers of the HTTP clients that reference it by
+// Name. Only one of the basic (Username & Password) or the bearer authorization can be set
+type HTTPClientCredentialsConfig struct {
+	Name        string
+	Username    string
+	Password    string
+	BearerToken string
+	Headers     map[string]string
+}
@@ -2,17 +2,22 @@ package config
 
 // MainConfig defines the main configuration file
 type MainConfig struct {
-	General           GeneralConfigs
-	OutputNotifiers   OutputNotifiersConfig
-	BLSKeysMonitoring []BLSKeysMonitorConfig
+	General              GeneralConfigs
+	OutputNotifiers      OutputNotifiersConfig
+	BLSKeysMonitoring    []BLSKeysMonitorConfig
+	NodesMonitoring      []NodesMonitorConfig
+	DelegationMonitoring []DelegationMonitorConfig
+	BalanceMonitoring    []BalanceMonitorConfig
 }
 
 // GeneralConfigs defines the general configurations for the app
 type GeneralConfigs struct {
-	ApplicationName string
-	SystemSelfCheck SystemSelfCheckConfig
-	Logs            LogsConfig
-	AlarmSnooze     AlarmSnoozeConfig
+	ApplicationName             string
+	FailOnDuplicatedIdentities  bool
+	StatisticsCacheTTLInSeconds int
+	SystemSelfCheck             SystemSelfCheckConfig
+	Logs                        LogsConfig
+	AlarmSnooze                 AlarmSnoozeConfig
 }
 
 // SystemSelfCheckConfig defines the configuration for the self check system
@@ -39,46 +44,231 @@ type AlarmSnoozeConfig struct {
 
 // OutputNotifiersConfig specifies the implemented types of output notifiers
 type OutputNotifiersConfig struct {
-	NumRetries            uint32
-	SecondsBetweenRetries int
-	Pushover              PushoverNotifierConfig
-	Smtp                  SmtpNotifierConfig
-	Telegram              TelegramNotifierConfig
-	Slack                 SlackNotifierConfig
+	NumRetries               uint32
+	SecondsBetweenRetries    int
+	MaxSecondsBetweenRetries int
+	RetryJitter              float64
+	SendTimeoutInSeconds     int
+	QueueSize                uint32
+	Outbox                   OutboxConfig
+	Pushover                 PushoverNotifierConfig
+	Smtp                     SmtpNotifierConfig
+	Telegram                 TelegramNotifierConfig
+	Slack                    SlackNotifierConfig
+}
+
+// OutboxConfig specifies the options for the persistent storage of the undelivered notifications
+type OutboxConfig struct {
+	Enabled             bool
+	Directory           string
+	MaxAgeInSec         uint64
+	InitialBackoffInSec uint64
+	MaxBackoffInSec     uint64
+	CheckIntervalInSec  int
+}
+
+// RateLimitConfig specifies the token bucket options used to limit the rate of the sent notifications. The
+// MessagesPerMinute and Burst values apply for each credential while the Type* values are shared by all notifiers of
+// the same type. A 0 value for the messages per minute disables the corresponding limit
+type RateLimitConfig struct {
+	MessagesPerMinute     float64
+	Burst                 uint32
+	TypeMessagesPerMinute float64
+	TypeBurst             uint32
 }
 
 // PushoverNotifierConfig specifies the options for the Pushover service
 type PushoverNotifierConfig struct {
-	Enabled bool
-	URL     string
+	Enabled    bool
+	URL        string
+	RateLimit  RateLimitConfig
+	HTTPClient HTTPClientConfig
 }
 
 // SmtpNotifierConfig specifies the options for the SMTP email service
 type SmtpNotifierConfig struct {
-	Enabled  bool
-	To       string
-	SmtpPort int
-	SmtpHost string
+	Enabled   bool
+	To        string
+	SmtpPort  int
+	SmtpHost  string
+	RateLimit RateLimitConfig
 }
 
 // TelegramNotifierConfig specifies the options for the Telegram service
 type TelegramNotifierConfig struct {
-	Enabled bool
-	URL     string
+	Enabled    bool
+	URL        string
+	RateLimit  RateLimitConfig
+	HTTPClient HTTPClientConfig
 }
 
 // SlackNotifierConfig specifies the options for the Slack service
 type SlackNotifierConfig struct {
-	Enabled bool
-	URL     string
+	Enabled    bool
+	URL        string
+	RateLimit  RateLimitConfig
+	HTTPClient HTTPClientConfig
+}
+
+// HTTPClientConfig specifies the options of an HTTP client. The authorization and the secret headers are defined in
+// the credentials file, in the HTTPClients entry named CredentialsName
+type HTTPClientConfig struct {
+	TimeoutInSeconds int
+	Headers          map[string]string
+	ProxyURL         string
+	CABundleFile     string
+	ClientCertFile   string
+	ClientKeyFile    string
+	CredentialsName  string
 }
 
 // BLSKeysMonitorConfig defines the configuration for the BLS keys monitor
 type BLSKeysMonitorConfig struct {
-	AlarmDeltaRatingDrop     float64
+	AlarmDeltaRatingDrop               float64
+	Name                               string
+	ApiURL                             string
+	FallbackApiURLs                    []string
+	ApiRequestTimeoutInSeconds         int
+	ApiUnhealthyRetryIntervalInSeconds int
+	HTTPClient                         HTTPClientConfig
+	BLSKeysFetchMaxConcurrentRequests  int
+	BLSKeysFetchRequestsPerSecond      float64
+	BLSKeysRefreshIntervalInSeconds    int
+	ExplorerURL                        string
+	PollingIntervalInSeconds           int
+	ListFile                           string
+	ListFormat                         string
+	ListReloadIntervalInSeconds        int
+	ListURL                            string
+	ListURLRefreshIntervalInSeconds    int
+	ListURLCacheFile                   string
+	PemFiles                           []string
+	PemDirs                            []string
+	NetworkWideDegradedPercent         float64
+	Overrides                          []ThresholdsOverrideConfig
+	StatisticsSources                  []StatisticsSourceConfig
+	StatisticsQuorum                   int
+	Heartbeat                          HeartbeatCheckConfig
+	Auction                            AuctionCheckConfig
+	Epoch                              EpochConfig
+	PeersComparison                    PeersComparisonConfig
+}
+
+// StatisticsSourceConfig defines an additional API or proxy queried for the validator statistics, used to confirm the
+// problematic BLS keys
+type StatisticsSourceConfig struct {
+	Name   string
+	ApiURL string
+}
+
+// HeartbeatCheckConfig defines the heartbeat check of the monitored BLS keys. The heartbeats are queried from the
+// ApiURL, if set, otherwise from the monitor's API URLs. An empty expected app version or an empty list of expected
+// peer types disable the corresponding check
+type HeartbeatCheckConfig struct {
+	Enabled            bool
+	ApiURL             string
+	ExpectedAppVersion string
+	ExpectedPeerTypes  []string
+}
+
+// AuctionCheckConfig defines the staking auction check of the monitored BLS keys. The auction list is queried from the
+// ApiURL, if set, otherwise from the monitor's API URLs, and should be a proxy (gateway) URL
+type AuctionCheckConfig struct {
+	Enabled bool
+	ApiURL  string
+}
+
+// EpochConfig defines the epoch tracking of the monitor. The current epoch is queried from the metachain network status
+// of the ApiURL, if set, otherwise of the monitor's API URLs, and should be a proxy (gateway) URL. The rating drops are
+// not reported for RatingDropGracePeriodInSeconds after an epoch change, the imminent jail alerts are still reported.
+// If ReportEnabled is set, the final rating, status and signature counters of each monitored BLS key are sent when the
+// epoch ends
+type EpochConfig struct {
+	Enabled                        bool
+	ApiURL                         string
+	RatingDropGracePeriodInSeconds uint64
+	ReportEnabled                  bool
+}
+
+// PeersComparisonConfig defines the comparison of the rating drops of the monitored BLS keys with the ones of all the
+// validators from the same shard, having the same status. A BLS key is reported if it is in the WorstPercentile of its
+// peers or StandardDeviations below the peers mean, a zero value disables the corresponding criterion. The groups with
+// less than MinGroupSize validators are not compared
+type PeersComparisonConfig struct {
+	Enabled            bool
+	WorstPercentile    uint64
+	StandardDeviations float64
+	MinGroupSize       uint64
+}
+
+// ThresholdsOverrideConfig defines the thresholds and the checks redefined for one BLS key or for all the BLS keys
+// having the provided label. The options that are not set keep the monitor's values
+type ThresholdsOverrideConfig struct {
+	BLSKey               string
+	Label                string
+	AlarmDeltaRatingDrop *float64
+	JailThreshold        *float64
+	EnabledChecks        []string
+}
+
+// NodesMonitorConfig defines the configuration for the nodes monitor, querying the status of each node through its REST
+// API. A 0 value for the maximum nonce lag or for the minimum number of connected peers and an empty expected app
+// version disable the corresponding check
+type NodesMonitorConfig struct {
+	Name                     string
+	NetworkURL               string
+	PollingIntervalInSeconds int
+	RequestTimeoutInSeconds  int
+	MaxNonceLag              uint64
+	MinNumConnectedPeers     uint64
+	ExpectedAppVersion       string
+	HTTPClient               HTTPClientConfig
+	Nodes                    []NodeConfig
+}
+
+// NodeConfig defines a node monitored through its REST API
+type NodeConfig struct {
+	Name string
+	URL  string
+}
+
+// DelegationMonitorConfig defines the configuration for the delegation contracts monitor, querying the contracts through
+// the vm-values/query endpoint. A 0 value for the minimum stake per node disables the active stake check
+type DelegationMonitorConfig struct {
 	Name                     string
 	ApiURL                   string
 	ExplorerURL              string
 	PollingIntervalInSeconds int
-	ListFile                 string
+	MinStakePerNodeInEGLD    uint64
+	HTTPClient               HTTPClientConfig
+	Contracts                []DelegationContractConfig
+}
+
+// DelegationContractConfig defines a monitored delegation contract and its optional label
+type DelegationContractConfig struct {
+	Address string
+	Label   string
+}
+
+// BalanceMonitorConfig defines the configuration for the account balances monitor, querying the balances through the
+// address endpoints of the API
+type BalanceMonitorConfig struct {
+	Name                     string
+	ApiURL                   string
+	ExplorerURL              string
+	PollingIntervalInSeconds int
+	HTTPClient               HTTPClientConfig
+	Accounts                 []BalanceAccountConfig
+}
+
+// BalanceAccountConfig defines a monitored account balance. The EGLD balance is monitored if the token is empty,
+// otherwise the balance of the ESDT token having the provided identifier and number of decimals. The thresholds are
+// expressed in tokens, a 0 value disabling the corresponding check
+type BalanceAccountConfig struct {
+	Address    string
+	Label      string
+	Token      string
+	Decimals   uint32
+	MinBalance float64
+	MaxChange  float64
 }
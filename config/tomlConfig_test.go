@@ -13,6 +13,8 @@ func TestLoadConfig(t *testing.T) {
 	testString := `
 [General]
     ApplicationName = "Keys monitoring app"
+    FailOnDuplicatedIdentities = true
+    StatisticsCacheTTLInSeconds = 30
     # the application can send messages about the internal status at regular intervals
     [General.SystemSelfCheck]
         Enabled = true
@@ -30,13 +32,37 @@ func TestLoadConfig(t *testing.T) {
 
 [OutputNotifiers]
     NumRetries = 3
-    SecondsBetweenRetries = 10
+    SecondsBetweenRetries = 10 # initial delay, doubled on each retry
+    MaxSecondsBetweenRetries = 300
+    RetryJitter = 0.2 # the delay is randomly varied with +/- 20% so the notifiers do not retry all at once
+    # rate limited notifiers will retry after the delay requested by the service (Retry-After), while rejected
+    # notifications (bad credentials, wrong chat ID...) are not retried and are reported in the status report
+    SendTimeoutInSeconds = 30 # maximum time allowed for one sending attempt, on each notifier
+    QueueSize = 100 # each notifier has its own queue, messages are dropped if the queue is full
+
+    # The notifications that could not be delivered after all retries (or dropped because of a full queue) are stored
+    # on the disk, for each notifier, and are resent later, also after the application restarts
+    [OutputNotifiers.Outbox]
+        Enabled = true
+        Directory = "./db/outbox"
+        MaxAgeInSec = 86400 # 1 day, older undelivered notifications are discarded
+        InitialBackoffInSec = 60 # the time between resend attempts doubles after each failure
+        MaxBackoffInSec = 3600 # 1 hour
+        CheckIntervalInSec = 30
 
     # Uses Pushover service that can notify Desktop, Android or iOS devices. Requires a valid subscription.
     # If you enable this notifier, remember to specify the credentials in credentials.toml file
     [OutputNotifiers.Pushover]
         Enabled = true
         URL = "https://api.pushover.net/1/messages.json"
+        # Token bucket rate limit, applied for each credential. The messages that exceed the limit are merged and sent
+        # together as soon as the limit allows it. The Type* options define a limit shared by all credentials of this
+        # notifier type. A value of 0 messages per minute disables the limit
+        [OutputNotifiers.Pushover.RateLimit]
+            MessagesPerMinute = 60.0
+            Burst = 10
+            TypeMessagesPerMinute = 0.0
+            TypeBurst = 0
     
     # SMTP (email) based notification
     # If you enable this notifier, remember to specify the credentials in credentials.toml file
@@ -46,6 +72,11 @@ func TestLoadConfig(t *testing.T) {
         To = "to@email.com"
 	    SmtpPort = 587
 	    SmtpHost = "smtp.gmail.com"
+	    [OutputNotifiers.Smtp.RateLimit]
+	        MessagesPerMinute = 20.0
+	        Burst = 5
+	        TypeMessagesPerMinute = 0.0
+	        TypeBurst = 0
 
     # Uses Telegram service that can notify Desktop, Android or iOS devices. Requires a running bot and the chat ID for
     # the user that will be notified. 
@@ -53,12 +84,25 @@ func TestLoadConfig(t *testing.T) {
     [OutputNotifiers.Telegram]
         Enabled = true
         URL = "https://api.telegram.org"
+        [OutputNotifiers.Telegram.RateLimit]
+            MessagesPerMinute = 20.0
+            Burst = 5
+            TypeMessagesPerMinute = 1800.0
+            TypeBurst = 30
 
     # Uses Slack service that can notify Slack app. Requires an app and the credentials.
     # If you enable this notifier, remember to specify the credentials in credentials.toml file
     [OutputNotifiers.Slack]
         Enabled = true
         URL = "https://hooks.slack.com/services"
+        [OutputNotifiers.Slack.RateLimit]
+            MessagesPerMinute = 60.0
+            Burst = 5
+            TypeMessagesPerMinute = 0.0
+            TypeBurst = 0
+        [OutputNotifiers.Slack.HTTPClient]
+            TimeoutInSeconds = 15
+            ProxyURL = "http://proxy.example.com:3128"
 
 [[BLSKeysMonitoring]]
     AlarmDeltaRatingDrop = 1.0 # maximum Rating-TempRating value that will trigger an alarm, for the public testnet might use a higher value (2 or 3)
@@ -67,19 +111,124 @@ func TestLoadConfig(t *testing.T) {
     ExplorerURL = "explorer URL 1"
     PollingIntervalInSeconds = 300  # 5 minutes
     ListFile = "./config/network1.list"
+    ListReloadIntervalInSeconds = 10
+    PemFiles = ["./node-0/config/validatorKey.pem"]
+    PemDirs = ["./keys", "./backup-keys"]
+    NetworkWideDegradedPercent = 30.0
+    [[BLSKeysMonitoring.Overrides]]
+        Label = "backup"
+        EnabledChecks = ["none"]
+    [[BLSKeysMonitoring.Overrides]]
+        BLSKey = "bls1"
+        AlarmDeltaRatingDrop = 3.0
+        JailThreshold = 15.0
+        EnabledChecks = ["ratingDrop", "imminentJail"]
 
 [[BLSKeysMonitoring]]
     AlarmDeltaRatingDrop = 2.0 # maximum Rating-TempRating value that will trigger an alarm, for the public testnet might use a higher value (2 or 3)
     Name = "test 2"
     ApiURL = "test URL 2"
+    FallbackApiURLs = ["test URL 2 fallback 1", "test URL 2 fallback 2"]
+    ApiRequestTimeoutInSeconds = 20
+    ApiUnhealthyRetryIntervalInSeconds = 120
+    BLSKeysFetchMaxConcurrentRequests = 8
+    BLSKeysFetchRequestsPerSecond = 2.5
+    BLSKeysRefreshIntervalInSeconds = 3600
     ExplorerURL = "explorer URL 2"
     PollingIntervalInSeconds = 301   # 5 minutes and 1 second
     ListFile = "./config/network2.list"
+    ListFormat = "yaml"
+    ListURL = "https://example.com/network2.yaml"
+    ListURLRefreshIntervalInSeconds = 600
+    ListURLCacheFile = "./db/network2.yaml"
+    StatisticsQuorum = 2
+    [BLSKeysMonitoring.HTTPClient]
+        TimeoutInSeconds = 60
+        Headers = { "X-Client" = "keys monitor" }
+        CABundleFile = "./config/ca.pem"
+        ClientCertFile = "./config/client.pem"
+        ClientKeyFile = "./config/client.key"
+        CredentialsName = "private gateway"
+    [BLSKeysMonitoring.Heartbeat]
+        Enabled = true
+        ApiURL = "https://gateway.example.com"
+        ExpectedAppVersion = "v1.7.13"
+        ExpectedPeerTypes = ["eligible", "waiting"]
+    [BLSKeysMonitoring.Auction]
+        Enabled = true
+        ApiURL = "https://gateway.example.com"
+    [BLSKeysMonitoring.Epoch]
+        Enabled = true
+        ApiURL = "https://gateway.example.com"
+        RatingDropGracePeriodInSeconds = 1800
+        ReportEnabled = true
+    [BLSKeysMonitoring.PeersComparison]
+        Enabled = true
+        WorstPercentile = 5
+        StandardDeviations = 2.5
+        MinGroupSize = 20
+    [[BLSKeysMonitoring.StatisticsSources]]
+        Name = "observer"
+        ApiURL = "http://127.0.0.1:8080"
+    [[BLSKeysMonitoring.StatisticsSources]]
+        Name = "proxy"
+        ApiURL = "http://127.0.0.1:8079"
+
+[[NodesMonitoring]]
+    Name = "nodes"
+    NetworkURL = "https://gateway.multiversx.com"
+    PollingIntervalInSeconds = 60
+    RequestTimeoutInSeconds = 5
+    MaxNonceLag = 10
+    MinNumConnectedPeers = 20
+    ExpectedAppVersion = "v1.7.13"
+    [NodesMonitoring.HTTPClient]
+        TimeoutInSeconds = 10
+    [[NodesMonitoring.Nodes]]
+        Name = "validator 0"
+        URL = "http://127.0.0.1:8080"
+    [[NodesMonitoring.Nodes]]
+        Name = "validator 1"
+        URL = "http://127.0.0.1:8081"
+
+[[DelegationMonitoring]]
+    Name = "delegation"
+    ApiURL = "https://api.multiversx.com"
+    ExplorerURL = "https://explorer.multiversx.com"
+    PollingIntervalInSeconds = 300
+    MinStakePerNodeInEGLD = 2500
+    [DelegationMonitoring.HTTPClient]
+        TimeoutInSeconds = 20
+    [[DelegationMonitoring.Contracts]]
+        Address = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllllls27850s"
+        Label = "provider"
+
+[[BalanceMonitoring]]
+    Name = "wallets"
+    ApiURL = "https://gateway.multiversx.com"
+    ExplorerURL = "https://explorer.multiversx.com"
+    PollingIntervalInSeconds = 600
+    [BalanceMonitoring.HTTPClient]
+        TimeoutInSeconds = 20
+    [[BalanceMonitoring.Accounts]]
+        Address = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+        Label = "owner"
+        MinBalance = 0.5
+        MaxChange = 10.0
+    [[BalanceMonitoring.Accounts]]
+        Address = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+        Token = "USDC-c76f1f"
+        Decimals = 6
+        MinBalance = 100.0
 `
 
+	alarmDeltaRatingDropOverride := 3.0
+	jailThresholdOverride := 15.0
 	expectedCfg := MainConfig{
 		General: GeneralConfigs{
-			ApplicationName: "Keys monitoring app",
+			ApplicationName:             "Keys monitoring app",
+			FailOnDuplicatedIdentities:  true,
+			StatisticsCacheTTLInSeconds: 30,
 			SystemSelfCheck: SystemSelfCheckConfig{
 				Enabled:              true,
 				DayOfWeek:            "every day",
@@ -98,43 +247,219 @@ func TestLoadConfig(t *testing.T) {
 			},
 		},
 		OutputNotifiers: OutputNotifiersConfig{
-			NumRetries:            3,
-			SecondsBetweenRetries: 10,
+			NumRetries:               3,
+			SecondsBetweenRetries:    10,
+			MaxSecondsBetweenRetries: 300,
+			RetryJitter:              0.2,
+			SendTimeoutInSeconds:     30,
+			QueueSize:                100,
+			Outbox: OutboxConfig{
+				Enabled:             true,
+				Directory:           "./db/outbox",
+				MaxAgeInSec:         86400,
+				InitialBackoffInSec: 60,
+				MaxBackoffInSec:     3600,
+				CheckIntervalInSec:  30,
+			},
 			Pushover: PushoverNotifierConfig{
 				Enabled: true,
 				URL:     "https://api.pushover.net/1/messages.json",
+				RateLimit: RateLimitConfig{
+					MessagesPerMinute:     60,
+					Burst:                 10,
+					TypeMessagesPerMinute: 0,
+					TypeBurst:             0,
+				},
 			},
 			Smtp: SmtpNotifierConfig{
 				Enabled:  true,
 				To:       "to@email.com",
 				SmtpPort: 587,
 				SmtpHost: "smtp.gmail.com",
+				RateLimit: RateLimitConfig{
+					MessagesPerMinute:     20,
+					Burst:                 5,
+					TypeMessagesPerMinute: 0,
+					TypeBurst:             0,
+				},
 			},
 			Telegram: TelegramNotifierConfig{
 				Enabled: true,
 				URL:     "https://api.telegram.org",
+				RateLimit: RateLimitConfig{
+					MessagesPerMinute:     20,
+					Burst:                 5,
+					TypeMessagesPerMinute: 1800,
+					TypeBurst:             30,
+				},
 			},
 			Slack: SlackNotifierConfig{
 				Enabled: true,
 				URL:     "https://hooks.slack.com/services",
+				RateLimit: RateLimitConfig{
+					MessagesPerMinute:     60,
+					Burst:                 5,
+					TypeMessagesPerMinute: 0,
+					TypeBurst:             0,
+				},
+				HTTPClient: HTTPClientConfig{
+					TimeoutInSeconds: 15,
+					ProxyURL:         "http://proxy.example.com:3128",
+				},
 			},
 		},
 		BLSKeysMonitoring: []BLSKeysMonitorConfig{
 			{
-				AlarmDeltaRatingDrop:     1.0,
-				Name:                     "test 1",
-				ApiURL:                   "test URL 1",
-				ExplorerURL:              "explorer URL 1",
+				AlarmDeltaRatingDrop:        1.0,
+				Name:                        "test 1",
+				ApiURL:                      "test URL 1",
+				ExplorerURL:                 "explorer URL 1",
+				PollingIntervalInSeconds:    300,
+				ListFile:                    "./config/network1.list",
+				ListReloadIntervalInSeconds: 10,
+				PemFiles:                    []string{"./node-0/config/validatorKey.pem"},
+				PemDirs:                     []string{"./keys", "./backup-keys"},
+				NetworkWideDegradedPercent:  30,
+				Overrides: []ThresholdsOverrideConfig{
+					{
+						Label:         "backup",
+						EnabledChecks: []string{"none"},
+					},
+					{
+						BLSKey:               "bls1",
+						AlarmDeltaRatingDrop: &alarmDeltaRatingDropOverride,
+						JailThreshold:        &jailThresholdOverride,
+						EnabledChecks:        []string{"ratingDrop", "imminentJail"},
+					},
+				},
+			},
+			{
+				AlarmDeltaRatingDrop:               2.0,
+				Name:                               "test 2",
+				ApiURL:                             "test URL 2",
+				FallbackApiURLs:                    []string{"test URL 2 fallback 1", "test URL 2 fallback 2"},
+				ApiRequestTimeoutInSeconds:         20,
+				ApiUnhealthyRetryIntervalInSeconds: 120,
+				BLSKeysFetchMaxConcurrentRequests:  8,
+				BLSKeysFetchRequestsPerSecond:      2.5,
+				BLSKeysRefreshIntervalInSeconds:    3600,
+				ExplorerURL:                        "explorer URL 2",
+				PollingIntervalInSeconds:           301,
+				ListFile:                           "./config/network2.list",
+				ListFormat:                         "yaml",
+				ListURL:                            "https://example.com/network2.yaml",
+				ListURLRefreshIntervalInSeconds:    600,
+				ListURLCacheFile:                   "./db/network2.yaml",
+				StatisticsSources: []StatisticsSourceConfig{
+					{
+						Name:   "observer",
+						ApiURL: "http://127.0.0.1:8080",
+					},
+					{
+						Name:   "proxy",
+						ApiURL: "http://127.0.0.1:8079",
+					},
+				},
+				StatisticsQuorum: 2,
+				Heartbeat: HeartbeatCheckConfig{
+					Enabled:            true,
+					ApiURL:             "https://gateway.example.com",
+					ExpectedAppVersion: "v1.7.13",
+					ExpectedPeerTypes:  []string{"eligible", "waiting"},
+				},
+				Auction: AuctionCheckConfig{
+					Enabled: true,
+					ApiURL:  "https://gateway.example.com",
+				},
+				Epoch: EpochConfig{
+					Enabled:                        true,
+					ApiURL:                         "https://gateway.example.com",
+					RatingDropGracePeriodInSeconds: 1800,
+					ReportEnabled:                  true,
+				},
+				PeersComparison: PeersComparisonConfig{
+					Enabled:            true,
+					WorstPercentile:    5,
+					StandardDeviations: 2.5,
+					MinGroupSize:       20,
+				},
+				HTTPClient: HTTPClientConfig{
+					TimeoutInSeconds: 60,
+					Headers: map[string]string{
+						"X-Client": "keys monitor",
+					},
+					CABundleFile:    "./config/ca.pem",
+					ClientCertFile:  "./config/client.pem",
+					ClientKeyFile:   "./config/client.key",
+					CredentialsName: "private gateway",
+				},
+			},
+		},
+		NodesMonitoring: []NodesMonitorConfig{
+			{
+				Name:                     "nodes",
+				NetworkURL:               "https://gateway.multiversx.com",
+				PollingIntervalInSeconds: 60,
+				RequestTimeoutInSeconds:  5,
+				MaxNonceLag:              10,
+				MinNumConnectedPeers:     20,
+				ExpectedAppVersion:       "v1.7.13",
+				HTTPClient: HTTPClientConfig{
+					TimeoutInSeconds: 10,
+				},
+				Nodes: []NodeConfig{
+					{
+						Name: "validator 0",
+						URL:  "http://127.0.0.1:8080",
+					},
+					{
+						Name: "validator 1",
+						URL:  "http://127.0.0.1:8081",
+					},
+				},
+			},
+		},
+		DelegationMonitoring: []DelegationMonitorConfig{
+			{
+				Name:                     "delegation",
+				ApiURL:                   "https://api.multiversx.com",
+				ExplorerURL:              "https://explorer.multiversx.com",
 				PollingIntervalInSeconds: 300,
-				ListFile:                 "./config/network1.list",
+				MinStakePerNodeInEGLD:    2500,
+				HTTPClient: HTTPClientConfig{
+					TimeoutInSeconds: 20,
+				},
+				Contracts: []DelegationContractConfig{
+					{
+						Address: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllllls27850s",
+						Label:   "provider",
+					},
+				},
 			},
+		},
+		BalanceMonitoring: []BalanceMonitorConfig{
 			{
-				AlarmDeltaRatingDrop:     2.0,
-				Name:                     "test 2",
-				ApiURL:                   "test URL 2",
-				ExplorerURL:              "explorer URL 2",
-				PollingIntervalInSeconds: 301,
-				ListFile:                 "./config/network2.list",
+				Name:                     "wallets",
+				ApiURL:                   "https://gateway.multiversx.com",
+				ExplorerURL:              "https://explorer.multiversx.com",
+				PollingIntervalInSeconds: 600,
+				HTTPClient: HTTPClientConfig{
+					TimeoutInSeconds: 20,
+				},
+				Accounts: []BalanceAccountConfig{
+					{
+						Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+						Label:      "owner",
+						MinBalance: 0.5,
+						MaxChange:  10,
+					},
+					{
+						Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+						Token:      "USDC-c76f1f",
+						Decimals:   6,
+						MinBalance: 100,
+					},
+				},
 			},
 		},
 	}
@@ -176,6 +501,20 @@ func TestLoadCredentialsConfig(t *testing.T) {
 		{ Secret = "s1"},
 		{ Secret = "s2"},
 	]
+
+[[ListURLs]]
+    Name = "test 2"
+    BearerToken = "token L1"
+
+[[HTTPClients]]
+    Name = "private gateway"
+    BearerToken = "token G1"
+    Headers = { "X-Api-Key" = "key G1" }
+
+[[HTTPClients]]
+    Name = "proxy"
+    Username = "user"
+    Password = "password"
 `
 
 	expectedCfg := CredentialsConfig{
@@ -228,6 +567,26 @@ func TestLoadCredentialsConfig(t *testing.T) {
 				},
 			},
 		},
+		ListURLs: []ListURLCredentialsConfig{
+			{
+				Name:        "test 2",
+				BearerToken: "token L1",
+			},
+		},
+		HTTPClients: []HTTPClientCredentialsConfig{
+			{
+				Name:        "private gateway",
+				BearerToken: "token G1",
+				Headers: map[string]string{
+					"X-Api-Key": "key G1",
+				},
+			},
+			{
+				Name:     "proxy",
+				Username: "user",
+				Password: "password",
+			},
+		},
 	}
 
 	cfg := CredentialsConfig{}
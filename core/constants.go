@@ -14,5 +14,21 @@ const AddressLen = 32
 // AddressHRP is the bech32 HRP used in addresses
 const AddressHRP = "erd"
 
+// RatingDropCheck is the name of the check that signals the BLS keys with a temp rating drop
+const RatingDropCheck = "ratingDrop"
+
+// ImminentJailCheck is the name of the check that signals the BLS keys with the temp rating under the jail threshold
+const ImminentJailCheck = "imminentJail"
+
+// PeersRatingCheck is the name of the check that signals the BLS keys with a temp rating drop larger than the one of
+// their peers
+const PeersRatingCheck = "peersRating"
+
+// NoChecks is the value used to disable all the checks
+const NoChecks = "none"
+
 // EveryWeekDay is the constant that encodes each week day option
 const EveryWeekDay = time.Weekday(-1)
+
+// MetachainShardID is the shard ID of the metachain
+const MetachainShardID = uint32(4294967295)
@@ -1,9 +1,22 @@
 package core
 
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+)
+
 // ValidatorStatistics represents the DTO returned by the API
 type ValidatorStatistics struct {
-	TempRating float32 `json:"tempRating"`
-	Rating     float32 `json:"rating"`
+	TempRating                    float32 `json:"tempRating"`
+	Rating                        float32 `json:"rating"`
+	ShardID                       uint32  `json:"shardId"`
+	ValidatorStatus               string  `json:"validatorStatus"`
+	NumLeaderSuccess              uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure              uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess           uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure           uint32  `json:"numValidatorFailure"`
+	NumValidatorIgnoredSignatures uint32  `json:"numValidatorIgnoredSignatures"`
 }
 
 // ValidatorStatisticsResponse represents the DTO for the validator/statistics response
@@ -13,13 +26,141 @@ type ValidatorStatisticsResponse struct {
 	Code  string                                     `json:"code"`
 }
 
-// CheckResponse defines the checking response DTO
+// NodeStatus holds the metrics of a node, as returned by its node/status REST API endpoint
+type NodeStatus struct {
+	Nonce                uint64 `json:"erd_nonce"`
+	ProbableHighestNonce uint64 `json:"erd_probable_highest_nonce"`
+	IsSyncing            uint64 `json:"erd_is_syncing"`
+	NumConnectedPeers    uint64 `json:"erd_num_connected_peers"`
+	AppVersion           string `json:"erd_app_version"`
+	ShardID              uint32 `json:"erd_shard_id"`
+	EpochNumber          uint32 `json:"erd_epoch_number"`
+}
+
+// NodeStatusResponse represents the DTO for the node/status response
+type NodeStatusResponse struct {
+	Data struct {
+		Metrics *NodeStatus `json:"metrics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// HeartbeatStatus holds the heartbeat information of a node, as returned by the node/heartbeatstatus endpoint
+type HeartbeatStatus struct {
+	PublicKey       string `json:"publicKey"`
+	IsActive        bool   `json:"isActive"`
+	PeerType        string `json:"peerType"`
+	VersionNumber   string `json:"versionNumber"`
+	NodeDisplayName string `json:"nodeDisplayName"`
+}
+
+// HeartbeatStatusResponse represents the DTO for the node/heartbeatstatus response
+type HeartbeatStatusResponse struct {
+	Data struct {
+		Heartbeats []*HeartbeatStatus `json:"heartbeats"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AuctionNode holds a BLS key from the staking auction list and its selection projection
+type AuctionNode struct {
+	BlsKey    string `json:"blsKey"`
+	Qualified bool   `json:"qualified"`
+}
+
+// AuctionListValidator holds an owner from the staking auction list, as returned by the validator/auction endpoint. The
+// amounts are expressed in the smallest denomination
+type AuctionListValidator struct {
+	Owner          string         `json:"owner"`
+	NumStakedNodes int64          `json:"numStakedNodes"`
+	TotalTopUp     string         `json:"totalTopUp"`
+	TopUpPerNode   string         `json:"topUpPerNode"`
+	QualifiedTopUp string         `json:"qualifiedTopUp"`
+	Nodes          []*AuctionNode `json:"nodes"`
+}
+
+// AuctionListResponse represents the DTO for the validator/auction response
+type AuctionListResponse struct {
+	Data struct {
+		AuctionList []*AuctionListValidator `json:"auctionList"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// DelegationContractState holds the values of a delegation contract (staking provider) that are monitored. The service
+// fee is expressed in hundredths of percent and the amounts in the smallest denomination
+type DelegationContractState struct {
+	ServiceFee        uint64
+	MaxDelegationCap  *big.Int
+	WithDelegationCap bool
+	TotalActiveStake  *big.Int
+	NumNodes          uint64
+}
+
+// AccountBalanceResponse represents the DTO for the address/{bech32}/balance response. The balance is expressed in the
+// smallest denomination
+type AccountBalanceResponse struct {
+	Data struct {
+		Balance string `json:"balance"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ESDTBalanceResponse represents the DTO for the address/{bech32}/esdt/{token} response. The balance is expressed in the
+// smallest denomination
+type ESDTBalanceResponse struct {
+	Data struct {
+		TokenData struct {
+			TokenIdentifier string `json:"tokenIdentifier"`
+			Balance         string `json:"balance"`
+		} `json:"tokenData"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// NetworkStatus holds the status of a shard, as returned by the network/status/{shard} gateway endpoint
+type NetworkStatus struct {
+	Nonce       uint64 `json:"erd_nonce"`
+	EpochNumber uint32 `json:"erd_epoch_number"`
+}
+
+// NetworkStatusResponse represents the DTO for the network/status/{shard} response
+type NetworkStatusResponse struct {
+	Data struct {
+		Status *NetworkStatus `json:"status"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// CheckResponse defines the checking response DTO. The check is the name of the ratings check that found the problem,
+// empty for the other checks
 type CheckResponse struct {
 	HexBLSKey string
 	Status    string
+	Check     string
 }
 
-// OutputMessage defines the message to be sent to an output notifier
+// ShardChange defines a monitored BLS key moved to another shard
+type ShardChange struct {
+	HexBLSKey  string
+	OldShardID uint32
+	NewShardID uint32
+}
+
+// SourcesDisagreement defines a BLS key found problematic only on some of the validator statistics sources
+type SourcesDisagreement struct {
+	HexBLSKey       string
+	DegradedSources []string
+	HealthySources  []string
+}
+
+// OutputMessage defines the message to be sent to an output notifier. The epoch is nil if it is not known
 type OutputMessage struct {
 	Type               MessageOutputType
 	IdentifierType     string
@@ -28,12 +169,77 @@ type OutputMessage struct {
 	IdentifierURL      string
 	ExecutorName       string
 	ProblemEncountered string
+	Label              string
+	Tags               map[string]string
+	Epoch              *uint32
 }
 
-// IdentitiesHolder will hold all involved identities: wallet addresses or BLS keys
+// IdentitiesHolder will hold all involved identities: wallet addresses or BLS keys. The metadata and the locations are
+// keyed by the BLS hex key or by the bech32 address, the thresholds overrides are keyed by the BLS hex key.
+// The source files are all the files read while parsing, including the included ones
 type IdentitiesHolder struct {
-	Addresses  []Address
-	BlsHexKeys []string
+	Addresses   []Address
+	BlsHexKeys  []string
+	Metadata    map[string]IdentityMetadata
+	Overrides   map[string]ThresholdsOverride
+	Locations   map[string][]IdentityLocation
+	SourceFiles []string
+}
+
+// ThresholdsOverride defines the thresholds and the enabled checks redefined for some BLS keys. The nil values and the
+// empty enabled checks keep the values defined at the upper level
+type ThresholdsOverride struct {
+	AlarmDeltaRatingDrop *float64
+	JailThreshold        *float64
+	EnabledChecks        []string
+}
+
+// IsEmpty returns true if the override does not redefine any value
+func (override ThresholdsOverride) IsEmpty() bool {
+	return override.AlarmDeltaRatingDrop == nil && override.JailThreshold == nil && len(override.EnabledChecks) == 0
+}
+
+// Merge returns the override with the values redefined by the other override replacing its own values
+func (override ThresholdsOverride) Merge(other ThresholdsOverride) ThresholdsOverride {
+	if other.AlarmDeltaRatingDrop != nil {
+		override.AlarmDeltaRatingDrop = other.AlarmDeltaRatingDrop
+	}
+	if other.JailThreshold != nil {
+		override.JailThreshold = other.JailThreshold
+	}
+	if len(other.EnabledChecks) > 0 {
+		override.EnabledChecks = other.EnabledChecks
+	}
+
+	return override
+}
+
+// IdentityLocation defines the file and the 1-based line where an identity was defined. The line is 0 if the identity
+// was not defined on a specific line (e.g. read from a PEM directory)
+type IdentityLocation struct {
+	File string
+	Line int
+}
+
+// String returns the location in the file:line format
+func (location IdentityLocation) String() string {
+	if location.Line == 0 {
+		return location.File
+	}
+
+	return fmt.Sprintf("%s:%d", location.File, location.Line)
+}
+
+// IdentityMetadata holds the optional label and tags defined for an identity
+type IdentityMetadata struct {
+	Label string
+	Tags  map[string]string
+}
+
+// OwnerBLSKeys holds the staked BLS keys fetched for an owner address
+type OwnerBLSKeys struct {
+	Owner   Address
+	BLSKeys []string
 }
 
 // Address defines the address DTO with it's 2 representations
@@ -41,3 +247,33 @@ type Address struct {
 	Hex    string
 	Bech32 string
 }
+
+// NotifierMetrics holds the delivery metrics of an output notifier
+type NotifierMetrics struct {
+	Name          string
+	QueueDepth    int
+	NumSent       uint64
+	NumFailed     uint64
+	NumDropped    uint64
+	NumRejected   uint64
+	NumMerged     uint64
+	LastRejection string
+	OutboxSize    int
+}
+
+// OutboxEntry defines a notification that could not be delivered and waits for a new sending attempt
+type OutboxEntry struct {
+	ID                   uint64
+	Caller               string
+	Messages             []OutputMessage
+	CreatedTimestamp     int64
+	NextAttemptTimestamp int64
+	NumAttempts          uint32
+}
+
+// HTTPResponse defines the response DTO returned by an HTTP call
+type HTTPResponse struct {
+	Body       []byte
+	StatusCode int
+	Header     http.Header
+}
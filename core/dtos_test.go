@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityLocation_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "./config/keys.list:12", IdentityLocation{File: "./config/keys.list", Line: 12}.String())
+	assert.Equal(t, "./keys/node.pem", IdentityLocation{File: "./keys/node.pem"}.String())
+}
+
+func TestThresholdsOverride_IsEmpty(t *testing.T) {
+	t.Parallel()
+
+	value := 1.0
+	assert.True(t, ThresholdsOverride{}.IsEmpty())
+	assert.False(t, ThresholdsOverride{AlarmDeltaRatingDrop: &value}.IsEmpty())
+	assert.False(t, ThresholdsOverride{JailThreshold: &value}.IsEmpty())
+	assert.False(t, ThresholdsOverride{EnabledChecks: []string{NoChecks}}.IsEmpty())
+}
+
+func TestThresholdsOverride_Merge(t *testing.T) {
+	t.Parallel()
+
+	delta, jailThreshold, otherDelta := 1.0, 5.0, 2.0
+	override := ThresholdsOverride{
+		AlarmDeltaRatingDrop: &delta,
+		JailThreshold:        &jailThreshold,
+	}
+	other := ThresholdsOverride{
+		AlarmDeltaRatingDrop: &otherDelta,
+		EnabledChecks:        []string{RatingDropCheck},
+	}
+
+	expected := ThresholdsOverride{
+		AlarmDeltaRatingDrop: &otherDelta,
+		JailThreshold:        &jailThreshold,
+		EnabledChecks:        []string{RatingDropCheck},
+	}
+	assert.Equal(t, expected, override.Merge(other))
+	assert.Equal(t, override, override.Merge(ThresholdsOverride{}))
+}
@@ -0,0 +1,22 @@
+package core
+
+import "time"
+
+// NotificationError is returned by the notifiers when the remote service rejected the notification. It carries the
+// information needed to decide if and when the sending should be retried
+type NotificationError struct {
+	Err        error
+	Code       int
+	Permanent  bool
+	RetryAfter time.Duration
+}
+
+// Error returns the error string
+func (err *NotificationError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the inner error
+func (err *NotificationError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationError(t *testing.T) {
+	t.Parallel()
+
+	innerErr := errors.New("inner error")
+	err := fmt.Errorf("%w in notifier", &NotificationError{
+		Err:       innerErr,
+		Code:      401,
+		Permanent: true,
+	})
+
+	assert.Equal(t, "inner error in notifier", err.Error())
+	assert.ErrorIs(t, err, innerErr)
+
+	notificationError := &NotificationError{}
+	assert.True(t, errors.As(err, &notificationError))
+	assert.Equal(t, 401, notificationError.Code)
+	assert.True(t, notificationError.Permanent)
+}
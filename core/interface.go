@@ -0,0 +1,8 @@
+package core
+
+// EndpointsProvider is able to tell which of the configured API endpoints is currently used
+type EndpointsProvider interface {
+	ActiveURL() string
+	PrimaryURL() string
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,12 @@
+package core
+
+import "fmt"
+
+// ShardName returns the human-readable name of the provided shard
+func ShardName(shardID uint32) string {
+	if shardID == MetachainShardID {
+		return "metachain"
+	}
+
+	return fmt.Sprintf("shard %d", shardID)
+}
@@ -0,0 +1,15 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "shard 0", ShardName(0))
+	assert.Equal(t, "shard 2", ShardName(2))
+	assert.Equal(t, "metachain", ShardName(MetachainShardID))
+}
@@ -0,0 +1,167 @@
+package executors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const accountIdentifierType = "Account"
+const balanceExecutorName = "balanceExecutor"
+
+// MonitoredAccount holds a monitored account balance: the bech32 address, the optional ESDT token (the EGLD balance is
+// monitored if empty), the optional label and the checker holding the account's thresholds
+type MonitoredAccount struct {
+	Address string
+	Token   string
+	Label   string
+	Checker BalanceChecker
+}
+
+type balanceExecutor struct {
+	outputNotifiersHandler OutputNotifiersHandler
+	statusHandler          StatusHandler
+	accountsFilter         BLSKeysFilter
+	balanceQuerier         BalanceQuerier
+	accounts               []MonitoredAccount
+	previousBalances       map[string]*big.Int
+	name                   string
+	explorerURL            string
+}
+
+// ArgsBalanceExecutor defines the DTO struct for the NewBalanceExecutor constructor function
+type ArgsBalanceExecutor struct {
+	OutputNotifiersHandler OutputNotifiersHandler
+	StatusHandler          StatusHandler
+	AccountsFilter         BLSKeysFilter
+	BalanceQuerier         BalanceQuerier
+	Accounts               []MonitoredAccount
+	Name                   string
+	ExplorerURL            string
+}
+
+// NewBalanceExecutor creates a new instance of type balanceExecutor
+func NewBalanceExecutor(args ArgsBalanceExecutor) (*balanceExecutor, error) {
+	if check.IfNil(args.OutputNotifiersHandler) {
+		return nil, errNilOutputNotifiersHandler
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, errNilStatusHandler
+	}
+	if check.IfNil(args.AccountsFilter) {
+		return nil, errNilBLSKeysFilter
+	}
+	if check.IfNil(args.BalanceQuerier) {
+		return nil, errNilBalanceQuerier
+	}
+	if len(args.Accounts) == 0 {
+		return nil, errNoAccounts
+	}
+	for index, account := range args.Accounts {
+		if check.IfNil(account.Checker) {
+			return nil, fmt.Errorf("%w for account %s at index %d", errNilBalanceChecker, account.Address, index)
+		}
+	}
+
+	return &balanceExecutor{
+		outputNotifiersHandler: args.OutputNotifiersHandler,
+		statusHandler:          args.StatusHandler,
+		accountsFilter:         args.AccountsFilter,
+		balanceQuerier:         args.BalanceQuerier,
+		accounts:               args.Accounts,
+		previousBalances:       make(map[string]*big.Int),
+		name:                   args.Name,
+		explorerURL:            args.ExplorerURL,
+	}, nil
+}
+
+// Execute executes one checking cycle. The balances below the thresholds are subject to the alarm snooze while the
+// large changes are reported only once, when they are detected
+func (executor *balanceExecutor) Execute(ctx context.Context) error {
+	log.Debug("executing query-check-notify cycle", "executor", executor.name)
+
+	var lastErr error
+	problemsMessages := make([]core.OutputMessage, 0)
+	changesMessages := make([]core.OutputMessage, 0)
+	for _, account := range executor.accounts {
+		balance, err := executor.balanceQuerier.GetBalance(ctx, account.Address, account.Token)
+		if err != nil {
+			log.Debug("balanceExecutor.Execute", "executor", executor.name, "address", account.Address,
+				"token", account.Token, "error calling GetBalance", err.Error())
+			executor.statusHandler.ErrorEncountered(err)
+			lastErr = err
+
+			continue
+		}
+
+		key := accountKey(account)
+		problems, changes := account.Checker.Check(executor.previousBalances[key], balance)
+		executor.previousBalances[key] = balance
+
+		if len(changes) > 0 {
+			changesMessages = append(changesMessages,
+				executor.createMessage(account, core.WarningMessageOutputType, changes))
+		}
+		if len(problems) > 0 && executor.accountsFilter.ShouldNotify(key) {
+			problemsMessages = append(problemsMessages,
+				executor.createMessage(account, core.ErrorMessageOutputType, problems))
+		}
+	}
+
+	if len(problemsMessages) > 0 {
+		executor.statusHandler.CollectKeysProblems(problemsMessages)
+	}
+
+	messages := append(problemsMessages, changesMessages...)
+	if len(messages) == 0 {
+		log.Debug("all account balances are within the thresholds", "executor", executor.name)
+		return lastErr
+	}
+
+	err := executor.outputNotifiersHandler.NotifyWithRetry(balanceExecutorName, messages...)
+	if err != nil {
+		log.Debug("balanceExecutor.Execute", "executor", executor.name, "error notifying", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+
+		return err
+	}
+
+	return lastErr
+}
+
+// accountKey returns the key used for the previous balances and the alarm snooze, as the same address can be monitored
+// for more than one token
+func accountKey(account MonitoredAccount) string {
+	if len(account.Token) == 0 {
+		return account.Address
+	}
+
+	return account.Address + "/" + account.Token
+}
+
+func (executor *balanceExecutor) createMessage(account MonitoredAccount, messageType core.MessageOutputType, descriptions []string) core.OutputMessage {
+	message := core.OutputMessage{
+		Type:               messageType,
+		IdentifierType:     accountIdentifierType,
+		Identifier:         account.Address,
+		ShortIdentifier:    shortIdentifier(account.Address),
+		IdentifierURL:      createExplorerURL(executor.explorerURL, explorerURLAccountsPathName, account.Address),
+		ExecutorName:       executor.name,
+		ProblemEncountered: strings.Join(descriptions, "; "),
+		Label:              account.Label,
+	}
+	if len(account.Label) > 0 {
+		message.ShortIdentifier = fmt.Sprintf("%s (%s)", account.Label, message.ShortIdentifier)
+	}
+
+	return message
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *balanceExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
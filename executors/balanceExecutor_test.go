@@ -0,0 +1,304 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createMockArgsBalanceExecutor() ArgsBalanceExecutor {
+	return ArgsBalanceExecutor{
+		OutputNotifiersHandler: &mock.OutputNotifiersHandlerStub{},
+		StatusHandler:          &mock.StatusHandlerStub{},
+		AccountsFilter:         &mock.BLSKeysFilterStub{},
+		BalanceQuerier:         &mock.BalanceQuerierStub{},
+		Accounts: []MonitoredAccount{
+			{
+				Address: testAccountAddress,
+				Label:   "owner",
+				Checker: &mock.BalanceCheckerStub{},
+			},
+			{
+				Address: testAccountAddress,
+				Token:   "USDC-c76f1f",
+				Checker: &mock.BalanceCheckerStub{},
+			},
+		},
+		Name:        "test",
+		ExplorerURL: "https://explorer.com",
+	}
+}
+
+func TestNewBalanceExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil output notifiers handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.OutputNotifiersHandler = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilOutputNotifiersHandler, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.StatusHandler = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil accounts filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.AccountsFilter = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilBLSKeysFilter, err)
+	})
+	t.Run("nil balance querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.BalanceQuerier = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilBalanceQuerier, err)
+	})
+	t.Run("no accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.Accounts = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNoAccounts, err)
+	})
+	t.Run("nil balance checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.Accounts[1].Checker = nil
+		executor, err := NewBalanceExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errNilBalanceChecker)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewBalanceExecutor(createMockArgsBalanceExecutor())
+		assert.NotNil(t, executor)
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceExecutor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *balanceExecutor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &balanceExecutor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestBalanceExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy balances should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewBalanceExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("should notify the problems and the changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.BalanceQuerier = &mock.BalanceQuerierStub{
+			GetBalanceHandler: func(ctx context.Context, address string, token string) (*big.Int, error) {
+				assert.Equal(t, testAccountAddress, address)
+				if len(token) == 0 {
+					return big.NewInt(1), nil
+				}
+
+				return big.NewInt(2), nil
+			},
+		}
+		previousBalances := make([]*big.Int, 0)
+		args.Accounts[0].Checker = &mock.BalanceCheckerStub{
+			CheckHandler: func(previous *big.Int, current *big.Int) ([]string, []string) {
+				previousBalances = append(previousBalances, previous)
+				assert.Equal(t, big.NewInt(1), current)
+				return []string{"low balance"}, nil
+			},
+		}
+		args.Accounts[1].Checker = &mock.BalanceCheckerStub{
+			CheckHandler: func(previous *big.Int, current *big.Int) ([]string, []string) {
+				previousBalances = append(previousBalances, previous)
+				assert.Equal(t, big.NewInt(2), current)
+				return nil, []string{"large change"}
+			},
+		}
+		var snoozeKeys []string
+		args.AccountsFilter = &mock.BLSKeysFilterStub{
+			ShouldNotifyCalled: func(blsKey string) bool {
+				snoozeKeys = append(snoozeKeys, blsKey)
+				return true
+			},
+		}
+		var collectedMessages []core.OutputMessage
+		args.StatusHandler = &mock.StatusHandlerStub{
+			CollectKeysProblemsHandler: func(messages []core.OutputMessage) {
+				collectedMessages = messages
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Equal(t, balanceExecutorName, caller)
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewBalanceExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		problemMessage := core.OutputMessage{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     accountIdentifierType,
+			Identifier:         testAccountAddress,
+			ShortIdentifier:    "owner (erd1qy...ycr6th)",
+			IdentifierURL:      "https://explorer.com/accounts/" + testAccountAddress,
+			ExecutorName:       "test",
+			ProblemEncountered: "low balance",
+			Label:              "owner",
+		}
+		changeMessage := core.OutputMessage{
+			Type:               core.WarningMessageOutputType,
+			IdentifierType:     accountIdentifierType,
+			Identifier:         testAccountAddress,
+			ShortIdentifier:    "erd1qy...ycr6th",
+			IdentifierURL:      "https://explorer.com/accounts/" + testAccountAddress,
+			ExecutorName:       "test",
+			ProblemEncountered: "large change",
+		}
+		assert.Equal(t, []core.OutputMessage{problemMessage}, collectedMessages)
+		assert.Equal(t, []core.OutputMessage{problemMessage, changeMessage}, notifiedMessages)
+		assert.Equal(t, []string{testAccountAddress}, snoozeKeys)
+
+		// the second cycle should provide the previous balances, kept separately for each token
+		err = executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{nil, nil, big.NewInt(1), big.NewInt(2)}, previousBalances)
+	})
+	t.Run("snoozed problems should not be notified", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceExecutor()
+		args.Accounts = args.Accounts[1:]
+		args.Accounts[0].Checker = &mock.BalanceCheckerStub{
+			CheckHandler: func(previous *big.Int, current *big.Int) ([]string, []string) {
+				return []string{"low balance"}, nil
+			},
+		}
+		args.AccountsFilter = &mock.BLSKeysFilterStub{
+			ShouldNotifyCalled: func(blsKey string) bool {
+				assert.Equal(t, testAccountAddress+"/USDC-c76f1f", blsKey)
+				return false
+			},
+		}
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewBalanceExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("query error should check the other accounts and return the error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBalanceExecutor()
+		args.BalanceQuerier = &mock.BalanceQuerierStub{
+			GetBalanceHandler: func(ctx context.Context, address string, token string) (*big.Int, error) {
+				if len(token) == 0 {
+					return nil, expectedErr
+				}
+
+				return big.NewInt(0), nil
+			},
+		}
+		args.Accounts[1].Checker = &mock.BalanceCheckerStub{
+			CheckHandler: func(previous *big.Int, current *big.Int) ([]string, []string) {
+				return []string{"low balance"}, nil
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewBalanceExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+		assert.Equal(t, 1, len(notifiedMessages))
+		assert.Equal(t, "low balance", notifiedMessages[0].ProblemEncountered)
+	})
+	t.Run("notify error should return the error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBalanceExecutor()
+		args.Accounts[0].Checker = &mock.BalanceCheckerStub{
+			CheckHandler: func(previous *big.Int, current *big.Int) ([]string, []string) {
+				return nil, []string{"large change"}
+			},
+		}
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				return expectedErr
+			},
+		}
+		executor, _ := NewBalanceExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+}
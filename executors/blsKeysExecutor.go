@@ -2,7 +2,10 @@ package executors
 
 import (
 	"context"
+	"fmt"
 	"net/url"
+	"strings"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
@@ -10,10 +13,16 @@ import (
 )
 
 const identifierType = "BLS key"
+const ownerIdentifierType = "Owner address"
 const explorerURLNodesPathName = "nodes"
+const explorerURLAccountsPathName = "accounts"
+const minKeysToGroupByOwner = 2
+const maxKeysListedForOwner = 5
 const numPrefixCharactersForKey = 6
 const numSuffixCharactersForKey = 6
 const blsExecutorName = "blsKeysExecutor"
+const maxDisagreementsListed = 5
+const shardChangeMessageFormat = "Shard changed: from %s to %s"
 
 var log = logger.GetOrCreate("executors")
 
@@ -24,6 +33,20 @@ type blsKeysExecutor struct {
 	validatorStatisticsQuerier ValidatorStatisticsQuerier
 	statusHandler              StatusHandler
 	blsKeysFilter              BLSKeysFilter
+	networkAnomalyDetector     NetworkAnomalyDetector
+	quorumChecker              QuorumChecker
+	heartbeatChecker           HeartbeatChecker
+	auctionChecker             AuctionChecker
+	shardChecker               ShardChecker
+	peersRatingChecker         PeersRatingChecker
+	thresholdsResolver         ThresholdsResolver
+	epochTracker               EpochTracker
+	epochReporter              EpochReporter
+	lastDisagreements          string
+	lastStatistics             map[string]*core.ValidatorStatistics
+	lastExtraBLSKeys           []string
+	mutIdentitiesMetadata      sync.RWMutex
+	identitiesMetadata         map[string]core.IdentityMetadata
 	name                       string
 	explorerURL                string
 }
@@ -36,6 +59,16 @@ type ArgsBLSKeysExecutor struct {
 	BlsKeysFetcher             BLSKeysFetcher
 	StatusHandler              StatusHandler
 	BLSKeysFilter              BLSKeysFilter
+	NetworkAnomalyDetector     NetworkAnomalyDetector
+	QuorumChecker              QuorumChecker
+	HeartbeatChecker           HeartbeatChecker
+	AuctionChecker             AuctionChecker
+	ShardChecker               ShardChecker
+	PeersRatingChecker         PeersRatingChecker
+	ThresholdsResolver         ThresholdsResolver
+	EpochTracker               EpochTracker
+	EpochReporter              EpochReporter
+	IdentitiesMetadata         map[string]core.IdentityMetadata
 	Name                       string
 	ExplorerURL                string
 }
@@ -60,6 +93,33 @@ func NewBLSKeysExecutor(args ArgsBLSKeysExecutor) (*blsKeysExecutor, error) {
 	if check.IfNil(args.BLSKeysFilter) {
 		return nil, errNilBLSKeysFilter
 	}
+	if check.IfNil(args.NetworkAnomalyDetector) {
+		return nil, errNilNetworkAnomalyDetector
+	}
+	if check.IfNil(args.QuorumChecker) {
+		return nil, errNilQuorumChecker
+	}
+	if check.IfNil(args.HeartbeatChecker) {
+		return nil, errNilHeartbeatChecker
+	}
+	if check.IfNil(args.AuctionChecker) {
+		return nil, errNilAuctionChecker
+	}
+	if check.IfNil(args.ShardChecker) {
+		return nil, errNilShardChecker
+	}
+	if check.IfNil(args.PeersRatingChecker) {
+		return nil, errNilPeersRatingChecker
+	}
+	if check.IfNil(args.ThresholdsResolver) {
+		return nil, errNilThresholdsResolver
+	}
+	if check.IfNil(args.EpochTracker) {
+		return nil, errNilEpochTracker
+	}
+	if check.IfNil(args.EpochReporter) {
+		return nil, errNilEpochReporter
+	}
 
 	return &blsKeysExecutor{
 		outputNotifiersHandler:     args.OutputNotifiersHandler,
@@ -70,12 +130,24 @@ func NewBLSKeysExecutor(args ArgsBLSKeysExecutor) (*blsKeysExecutor, error) {
 		explorerURL:                args.ExplorerURL,
 		blsKeysFetcher:             args.BlsKeysFetcher,
 		blsKeysFilter:              args.BLSKeysFilter,
+		networkAnomalyDetector:     args.NetworkAnomalyDetector,
+		quorumChecker:              args.QuorumChecker,
+		heartbeatChecker:           args.HeartbeatChecker,
+		auctionChecker:             args.AuctionChecker,
+		shardChecker:               args.ShardChecker,
+		peersRatingChecker:         args.PeersRatingChecker,
+		thresholdsResolver:         args.ThresholdsResolver,
+		epochTracker:               args.EpochTracker,
+		epochReporter:              args.EpochReporter,
+		identitiesMetadata:         args.IdentitiesMetadata,
 	}, nil
 }
 
 // Execute executes one checking cycle
 func (executor *blsKeysExecutor) Execute(ctx context.Context) error {
 	log.Debug("executing query-check-notify cycle", "executor", executor.name)
+	executor.checkEpochChange(ctx)
+
 	statistics, err := executor.validatorStatisticsQuerier.Query(ctx)
 	if err != nil {
 		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error calling query", err.Error())
@@ -84,7 +156,7 @@ func (executor *blsKeysExecutor) Execute(ctx context.Context) error {
 		return err
 	}
 
-	extraBLSKeys, err := executor.blsKeysFetcher.GetAllBLSKeys(ctx, executor.name)
+	ownersBLSKeys, err := executor.blsKeysFetcher.GetAllBLSKeys(ctx, executor.name)
 	if err != nil {
 		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error calling GetAllBLSKeys", err.Error())
 		executor.statusHandler.ErrorEncountered(err)
@@ -92,6 +164,16 @@ func (executor *blsKeysExecutor) Execute(ctx context.Context) error {
 		return err
 	}
 
+	extraBLSKeys := make([]string, 0)
+	for _, ownerBLSKeys := range ownersBLSKeys {
+		extraBLSKeys = append(extraBLSKeys, ownerBLSKeys.BLSKeys...)
+	}
+	// the BLS keys found through the owner addresses inherit the labels of these addresses
+	executor.thresholdsResolver.SetOwnersBLSKeys(ownersBLSKeys)
+	executor.lastStatistics = statistics
+	executor.lastExtraBLSKeys = extraBLSKeys
+	executor.checkShardChanges(statistics, extraBLSKeys)
+
 	problematicKeys, err := executor.ratingsChecker.Check(statistics, extraBLSKeys)
 	if err != nil {
 		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error calling check", err.Error())
@@ -100,16 +182,67 @@ func (executor *blsKeysExecutor) Execute(ctx context.Context) error {
 		return err
 	}
 
-	problematicKeys = executor.filterOutKeys(problematicKeys)
+	problematicKeys, disagreements := executor.quorumChecker.Check(ctx, problematicKeys, extraBLSKeys)
+	disagreementsMessages := executor.createDisagreementsMessages(disagreements)
+
+	// the rating drops are also compared with the ones of the peers, a drop below the absolute threshold can still be
+	// much larger than the drops of the other validators from the same shard, having the same status
+	peersRatingProblems := executor.peersRatingChecker.Check(statistics, extraBLSKeys)
+
+	// the ratings settle right after an epoch change, the rating drops are not reported during the grace period. The
+	// problems are filtered before being merged, as a merged problem keeps only the check of its first contributor
+	if executor.epochTracker.IsInGracePeriod() {
+		problematicKeys = removeRatingDrops(problematicKeys)
+		peersRatingProblems = removeRatingDrops(peersRatingProblems)
+	}
+	ratingsProblems := mergeProblems(problematicKeys, peersRatingProblems)
+
+	// the heartbeats react faster than the ratings, a failed query should not prevent reporting the ratings problems
+	heartbeatProblems, err := executor.heartbeatChecker.Check(ctx, extraBLSKeys)
+	if err != nil {
+		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error checking the heartbeats", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+	}
+
+	// the queued keys are not rated, so the auction selection is checked separately
+	auctionProblems, err := executor.auctionChecker.Check(ctx, extraBLSKeys)
+	if err != nil {
+		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error checking the staking auction", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+	}
+	otherProblems := mergeProblems(heartbeatProblems, auctionProblems)
+
+	problematicKeys = executor.filterOutKeys(mergeProblems(ratingsProblems, otherProblems))
 	if len(problematicKeys) == 0 {
 		log.Debug("all keys are performing normally", "executor", executor.name)
 
-		return nil
+		return executor.notify(disagreementsMessages)
 	}
 
-	messages := executor.createMessages(problematicKeys)
+	messages := executor.setEpoch(executor.createMessages(problematicKeys))
 	executor.statusHandler.CollectKeysProblems(messages)
-	err = executor.outputNotifiersHandler.NotifyWithRetry(blsExecutorName, messages...)
+
+	degradedPercent, isNetworkWide := executor.networkAnomalyDetector.Detect(statistics)
+	if isNetworkWide {
+		// the filter is applied once on all the problems, as it records the notified keys
+		messages = executor.createNetworkWideMessages(
+			degradedPercent,
+			retainKeys(ratingsProblems, problematicKeys),
+			retainKeys(otherProblems, problematicKeys),
+			ownersBLSKeys)
+	} else {
+		messages = executor.groupMessagesByOwner(messages, ownersBLSKeys)
+	}
+
+	return executor.notify(append(messages, disagreementsMessages...))
+}
+
+func (executor *blsKeysExecutor) notify(messages []core.OutputMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err := executor.outputNotifiersHandler.NotifyWithRetry(blsExecutorName, executor.setEpoch(messages)...)
 	if err != nil {
 		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error notifying", err.Error())
 		executor.statusHandler.ErrorEncountered(err)
@@ -118,6 +251,185 @@ func (executor *blsKeysExecutor) Execute(ctx context.Context) error {
 	return err
 }
 
+// setEpoch returns the messages with the current epoch set on the ones not having an epoch. The messages are returned
+// unchanged if the current epoch is not known
+func (executor *blsKeysExecutor) setEpoch(messages []core.OutputMessage) []core.OutputMessage {
+	epoch, isKnown := executor.epochTracker.CurrentEpoch()
+	if !isKnown {
+		return messages
+	}
+
+	result := make([]core.OutputMessage, 0, len(messages))
+	for _, message := range messages {
+		if message.Epoch == nil {
+			messageEpoch := epoch
+			message.Epoch = &messageEpoch
+		}
+
+		result = append(result, message)
+	}
+
+	return result
+}
+
+// checkEpochChange updates the current epoch and, on an epoch change, sends the report of the ended epoch. The report
+// uses the validator statistics of the previous checking cycle, the last ones known for the ended epoch. A failed
+// update or a failed report will not stop the checking cycle
+func (executor *blsKeysExecutor) checkEpochChange(ctx context.Context) {
+	previousEpoch, isEpochChanged, err := executor.epochTracker.Update(ctx)
+	if err != nil {
+		log.Debug("blsKeysExecutor.Execute", "executor", executor.name, "error updating the epoch", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+
+		return
+	}
+	if !isEpochChanged || executor.lastStatistics == nil {
+		return
+	}
+
+	reports := executor.epochReporter.Report(executor.lastStatistics, executor.lastExtraBLSKeys)
+	messages := make([]core.OutputMessage, 0, len(reports))
+	for _, report := range reports {
+		message := core.OutputMessage{
+			Type:               core.InfoMessageOutputType,
+			IdentifierType:     identifierType,
+			Identifier:         report.HexBLSKey,
+			ShortIdentifier:    shortIdentifier(report.HexBLSKey),
+			IdentifierURL:      executor.createIdentifierURL(report.HexBLSKey),
+			ExecutorName:       executor.name,
+			ProblemEncountered: report.Status,
+			Epoch:              &previousEpoch,
+		}
+		executor.applyMetadata(&message)
+
+		messages = append(messages, message)
+	}
+
+	_ = executor.notify(messages)
+}
+
+// checkShardChanges records the shards of the monitored BLS keys for the status report and sends, in one notification,
+// the BLS keys that changed their shard. A failed notification will not stop the checking cycle
+func (executor *blsKeysExecutor) checkShardChanges(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) {
+	changes, shards := executor.shardChecker.Check(statistics, extraBLSKeys)
+	executor.statusHandler.CollectKeysShards(executor.name, shards)
+
+	messages := make([]core.OutputMessage, 0, len(changes))
+	for _, change := range changes {
+		message := core.OutputMessage{
+			Type:            core.InfoMessageOutputType,
+			IdentifierType:  identifierType,
+			Identifier:      change.HexBLSKey,
+			ShortIdentifier: shortIdentifier(change.HexBLSKey),
+			IdentifierURL:   executor.createIdentifierURL(change.HexBLSKey),
+			ExecutorName:    executor.name,
+			ProblemEncountered: fmt.Sprintf(shardChangeMessageFormat,
+				core.ShardName(change.OldShardID), core.ShardName(change.NewShardID)),
+		}
+		executor.applyMetadata(&message)
+
+		messages = append(messages, message)
+	}
+
+	_ = executor.notify(messages)
+}
+
+// removeRatingDrops returns the problematic keys without the ones reported by the rating drop and the peers rating checks
+func removeRatingDrops(problematicKeys []core.CheckResponse) []core.CheckResponse {
+	result := make([]core.CheckResponse, 0, len(problematicKeys))
+	for _, key := range problematicKeys {
+		if key.Check == core.RatingDropCheck || key.Check == core.PeersRatingCheck {
+			log.Debug("rating drop not reported during the epoch change grace period", "bls key", key.HexBLSKey)
+			continue
+		}
+
+		result = append(result, key)
+	}
+
+	return result
+}
+
+// createDisagreementsMessages returns a warning listing the BLS keys found problematic only on some of the validator
+// statistics sources. The warning is sent only when the disagreements change, so a lagging source will not generate
+// the same warning on each checking cycle
+func (executor *blsKeysExecutor) createDisagreementsMessages(disagreements []core.SourcesDisagreement) []core.OutputMessage {
+	descriptions := make([]string, 0, len(disagreements))
+	for _, disagreement := range disagreements {
+		descriptions = append(descriptions, fmt.Sprintf("%s problematic on %s but not on %s",
+			shortIdentifier(disagreement.HexBLSKey),
+			strings.Join(disagreement.DegradedSources, ", "),
+			strings.Join(disagreement.HealthySources, ", ")))
+	}
+
+	allDescriptions := strings.Join(descriptions, "; ")
+	if allDescriptions == executor.lastDisagreements {
+		return nil
+	}
+	executor.lastDisagreements = allDescriptions
+	if len(descriptions) == 0 {
+		return nil
+	}
+
+	if len(descriptions) > maxDisagreementsListed {
+		numNotListed := len(descriptions) - maxDisagreementsListed
+		descriptions = append(descriptions[:maxDisagreementsListed], fmt.Sprintf("and %d more", numNotListed))
+	}
+
+	return []core.OutputMessage{
+		{
+			Type:         core.WarningMessageOutputType,
+			ExecutorName: executor.name,
+			ShortIdentifier: fmt.Sprintf("the validator statistics sources disagree on %d BLS key(s): %s",
+				len(disagreements), strings.Join(descriptions, "; ")),
+		},
+	}
+}
+
+// mergeProblems appends the additional problems to the provided ones, the problems of the same BLS key are joined in a
+// single response
+func mergeProblems(problems []core.CheckResponse, additionalProblems []core.CheckResponse) []core.CheckResponse {
+	if len(additionalProblems) == 0 {
+		return problems
+	}
+
+	indexes := make(map[string]int, len(problems))
+	result := make([]core.CheckResponse, 0, len(problems)+len(additionalProblems))
+	for _, problem := range problems {
+		indexes[problem.HexBLSKey] = len(result)
+		result = append(result, problem)
+	}
+	for _, problem := range additionalProblems {
+		index, found := indexes[problem.HexBLSKey]
+		if found {
+			result[index].Status += "; " + problem.Status
+			continue
+		}
+
+		indexes[problem.HexBLSKey] = len(result)
+		result = append(result, problem)
+	}
+
+	return result
+}
+
+// retainKeys returns the problems of the BLS keys found in the retained problems
+func retainKeys(problems []core.CheckResponse, retainedProblems []core.CheckResponse) []core.CheckResponse {
+	retainedKeys := make(map[string]struct{}, len(retainedProblems))
+	for _, problem := range retainedProblems {
+		retainedKeys[problem.HexBLSKey] = struct{}{}
+	}
+
+	result := make([]core.CheckResponse, 0, len(problems))
+	for _, problem := range problems {
+		_, found := retainedKeys[problem.HexBLSKey]
+		if found {
+			result = append(result, problem)
+		}
+	}
+
+	return result
+}
+
 func (executor *blsKeysExecutor) filterOutKeys(problematicKeys []core.CheckResponse) []core.CheckResponse {
 	result := make([]core.CheckResponse, 0, len(problematicKeys))
 	for _, key := range problematicKeys {
@@ -142,6 +454,7 @@ func (executor *blsKeysExecutor) createMessages(problematicKeys []core.CheckResp
 			ExecutorName:       executor.name,
 			ProblemEncountered: key.Status,
 		}
+		executor.applyMetadata(&message)
 
 		result = append(result, message)
 	}
@@ -149,6 +462,132 @@ func (executor *blsKeysExecutor) createMessages(problematicKeys []core.CheckResp
 	return result
 }
 
+// createNetworkWideMessage creates a single message, instead of one message for each affected key, since the problem
+// is not specific to the monitored keys
+// createNetworkWideMessages replaces the rating drops and the peers rating problems, explained by the network-wide event,
+// with one warning. The other problems of the monitored keys, such as the offline keys, are still reported per key
+func (executor *blsKeysExecutor) createNetworkWideMessages(
+	degradedPercent float64,
+	ratingsProblems []core.CheckResponse,
+	otherProblems []core.CheckResponse,
+	ownersBLSKeys []core.OwnerBLSKeys,
+) []core.OutputMessage {
+	remainingProblems := make([]core.CheckResponse, 0, len(ratingsProblems))
+	numAffectedKeys := 0
+	for _, problem := range ratingsProblems {
+		if problem.Check == core.RatingDropCheck || problem.Check == core.PeersRatingCheck {
+			numAffectedKeys++
+			continue
+		}
+
+		remainingProblems = append(remainingProblems, problem)
+	}
+	remainingProblems = mergeProblems(remainingProblems, otherProblems)
+
+	messages := make([]core.OutputMessage, 0, len(remainingProblems)+1)
+	if numAffectedKeys > 0 {
+		messages = append(messages, executor.createNetworkWideMessage(degradedPercent, numAffectedKeys))
+	}
+	remainingMessages := executor.setEpoch(executor.createMessages(remainingProblems))
+
+	return append(messages, executor.groupMessagesByOwner(remainingMessages, ownersBLSKeys)...)
+}
+
+func (executor *blsKeysExecutor) createNetworkWideMessage(degradedPercent float64, numAffectedKeys int) core.OutputMessage {
+	return core.OutputMessage{
+		Type: core.WarningMessageOutputType,
+		ShortIdentifier: fmt.Sprintf("network-wide event: %0.2f%% of all validators degraded, %d monitored key(s) affected",
+			degradedPercent, numAffectedKeys),
+		ExecutorName: executor.name,
+	}
+}
+
+// groupMessagesByOwner replaces the messages of the keys belonging to the same owner address with one message, if the
+// owner has at least minKeysToGroupByOwner problematic keys. The messages of the keys that are not fetched from an
+// owner address are kept as they are
+func (executor *blsKeysExecutor) groupMessagesByOwner(messages []core.OutputMessage, ownersBLSKeys []core.OwnerBLSKeys) []core.OutputMessage {
+	ownerIndexes := make(map[string]int)
+	for index, ownerBLSKeys := range ownersBLSKeys {
+		if len(ownerBLSKeys.Owner.Bech32) == 0 {
+			continue
+		}
+		for _, blsKey := range ownerBLSKeys.BLSKeys {
+			ownerIndexes[blsKey] = index
+		}
+	}
+
+	ownersMessages := make(map[int][]core.OutputMessage)
+	for _, message := range messages {
+		index, found := ownerIndexes[message.Identifier]
+		if found {
+			ownersMessages[index] = append(ownersMessages[index], message)
+		}
+	}
+
+	result := make([]core.OutputMessage, 0, len(messages))
+	groupedOwners := make(map[int]struct{})
+	for _, message := range messages {
+		index, found := ownerIndexes[message.Identifier]
+		if !found || len(ownersMessages[index]) < minKeysToGroupByOwner {
+			result = append(result, message)
+			continue
+		}
+
+		_, alreadyGrouped := groupedOwners[index]
+		if alreadyGrouped {
+			continue
+		}
+
+		groupedOwners[index] = struct{}{}
+		result = append(result, executor.createOwnerMessage(ownersBLSKeys[index], ownersMessages[index]))
+	}
+
+	return result
+}
+
+func (executor *blsKeysExecutor) createOwnerMessage(ownerBLSKeys core.OwnerBLSKeys, messages []core.OutputMessage) core.OutputMessage {
+	keysProblems := make([]string, 0, maxKeysListedForOwner)
+	for index, message := range messages {
+		if index == maxKeysListedForOwner {
+			keysProblems = append(keysProblems, fmt.Sprintf("and %d more", len(messages)-maxKeysListedForOwner))
+			break
+		}
+
+		keysProblems = append(keysProblems, fmt.Sprintf("%s (%s)", message.ShortIdentifier, message.ProblemEncountered))
+	}
+
+	message := core.OutputMessage{
+		Type:            core.ErrorMessageOutputType,
+		IdentifierType:  ownerIdentifierType,
+		Identifier:      ownerBLSKeys.Owner.Bech32,
+		ShortIdentifier: shortIdentifier(ownerBLSKeys.Owner.Bech32),
+		IdentifierURL:   executor.createExplorerURL(explorerURLAccountsPathName, ownerBLSKeys.Owner.Bech32),
+		ExecutorName:    executor.name,
+		ProblemEncountered: fmt.Sprintf("%d of %d staked BLS key(s) encountered problems: %s",
+			len(messages), len(ownerBLSKeys.BLSKeys), strings.Join(keysProblems, ", ")),
+	}
+	executor.applyMetadata(&message)
+
+	return message
+}
+
+// applyMetadata sets the label and the tags defined in the list file for the message's identifier. The label is also
+// prepended to the short identifier so all notifiers will display it, the tags are displayed by each notifier
+func (executor *blsKeysExecutor) applyMetadata(message *core.OutputMessage) {
+	executor.mutIdentitiesMetadata.RLock()
+	metadata, found := executor.identitiesMetadata[message.Identifier]
+	executor.mutIdentitiesMetadata.RUnlock()
+	if !found {
+		return
+	}
+
+	message.Label = metadata.Label
+	message.Tags = metadata.Tags
+	if len(metadata.Label) > 0 {
+		message.ShortIdentifier = fmt.Sprintf("%s (%s)", metadata.Label, message.ShortIdentifier)
+	}
+}
+
 func shortIdentifier(identifier string) string {
 	ellipsisString := "..."
 	minNumCharactersToTrim := numPrefixCharactersForKey + len(ellipsisString) + numSuffixCharactersForKey
@@ -160,19 +599,40 @@ func shortIdentifier(identifier string) string {
 }
 
 func (executor *blsKeysExecutor) createIdentifierURL(hexKey string) string {
-	if len(executor.explorerURL) == 0 {
+	return executor.createExplorerURL(explorerURLNodesPathName, hexKey)
+}
+
+func (executor *blsKeysExecutor) createExplorerURL(pathName string, identifier string) string {
+	return createExplorerURL(executor.explorerURL, pathName, identifier)
+}
+
+func createExplorerURL(explorerURL string, pathName string, identifier string) string {
+	if len(explorerURL) == 0 {
 		return ""
 	}
 
-	result, err := url.JoinPath(executor.explorerURL, explorerURLNodesPathName, hexKey)
+	result, err := url.JoinPath(explorerURL, pathName, identifier)
 	if err != nil {
-		log.Debug("blsKeysExecutor.createIdentifierURL",
-			"executor.explorerURL", executor.explorerURL, "hexKey", hexKey, "error", err)
+		log.Debug("createExplorerURL",
+			"explorerURL", explorerURL, "path", pathName, "identifier", identifier, "error", err)
 	}
 
 	return result
 }
 
+// SetIdentities will atomically replace the labels and tags of the monitored identities
+func (executor *blsKeysExecutor) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	executor.mutIdentitiesMetadata.Lock()
+	executor.identitiesMetadata = identities.Metadata
+	executor.mutIdentitiesMetadata.Unlock()
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (executor *blsKeysExecutor) IsInterfaceNil() bool {
 	return executor == nil
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBLSKeysExecutor(t *testing.T) {
@@ -19,6 +20,15 @@ func TestNewBLSKeysExecutor(t *testing.T) {
 		ValidatorStatisticsQuerier: &mock.ValidatorStatisticsQuerierStub{},
 		StatusHandler:              &mock.StatusHandlerStub{},
 		BlsKeysFetcher:             &mock.BLSKEysFetcherStub{},
+		NetworkAnomalyDetector:     &mock.NetworkAnomalyDetectorStub{},
+		QuorumChecker:              &mock.QuorumCheckerStub{},
+		HeartbeatChecker:           &mock.HeartbeatCheckerStub{},
+		AuctionChecker:             &mock.AuctionCheckerStub{},
+		ShardChecker:               &mock.ShardCheckerStub{},
+		PeersRatingChecker:         &mock.PeersRatingCheckerStub{},
+		ThresholdsResolver:         &mock.ThresholdsResolverStub{},
+		EpochTracker:               &mock.EpochTrackerStub{},
+		EpochReporter:              &mock.EpochReporterStub{},
 		BLSKeysFilter:              &mock.BLSKeysFilterStub{},
 	}
 
@@ -76,6 +86,87 @@ func TestNewBLSKeysExecutor(t *testing.T) {
 		assert.Nil(t, executor)
 		assert.Equal(t, errNilBLSKeysFilter, err)
 	})
+	t.Run("nil network anomaly detector should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.NetworkAnomalyDetector = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilNetworkAnomalyDetector, err)
+	})
+	t.Run("nil quorum checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.QuorumChecker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilQuorumChecker, err)
+	})
+	t.Run("nil heartbeat checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.HeartbeatChecker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilHeartbeatChecker, err)
+	})
+	t.Run("nil auction checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.AuctionChecker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilAuctionChecker, err)
+	})
+	t.Run("nil shard checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.ShardChecker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilShardChecker, err)
+	})
+	t.Run("nil peers rating checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.PeersRatingChecker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilPeersRatingChecker, err)
+	})
+	t.Run("nil thresholds resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.ThresholdsResolver = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilThresholdsResolver, err)
+	})
+	t.Run("nil epoch tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.EpochTracker = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilEpochTracker, err)
+	})
+	t.Run("nil epoch reporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := testArgs
+		localArgs.EpochReporter = nil
+		executor, err := NewBLSKeysExecutor(localArgs)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilEpochReporter, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -130,8 +221,17 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 					assert.Fail(t, "should have not called the status handler")
 				},
 			},
-			BlsKeysFetcher: &mock.BLSKEysFetcherStub{},
-			BLSKeysFilter:  &mock.BLSKeysFilterStub{},
+			BlsKeysFetcher:         &mock.BLSKEysFetcherStub{},
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 		err := executor.Execute(context.Background())
@@ -169,11 +269,20 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 				},
 			},
 			BlsKeysFetcher: &mock.BLSKEysFetcherStub{
-				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]string, error) {
+				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
 					return nil, expectedErr
 				},
 			},
-			BLSKeysFilter: &mock.BLSKeysFilterStub{},
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 		err := executor.Execute(context.Background())
@@ -210,8 +319,17 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 					assert.Fail(t, "should have not called the status handler")
 				},
 			},
-			BlsKeysFetcher: &mock.BLSKEysFetcherStub{},
-			BLSKeysFilter:  &mock.BLSKeysFilterStub{},
+			BlsKeysFetcher:         &mock.BLSKEysFetcherStub{},
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 		err := executor.Execute(context.Background())
@@ -246,8 +364,17 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 					assert.Fail(t, "should have not called the status handler")
 				},
 			},
-			BlsKeysFetcher: &mock.BLSKEysFetcherStub{},
-			BLSKeysFilter:  &mock.BLSKeysFilterStub{},
+			BlsKeysFetcher:         &mock.BLSKEysFetcherStub{},
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 		err := executor.Execute(context.Background())
@@ -301,15 +428,24 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 				},
 			},
 			BlsKeysFetcher: &mock.BLSKEysFetcherStub{
-				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]string, error) {
+				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
 					getAllBLSKeysCalled = true
 
-					return []string{"extra key"}, nil
+					return []core.OwnerBLSKeys{{BLSKeys: []string{"extra key"}}}, nil
 				},
 			},
-			BLSKeysFilter: &mock.BLSKeysFilterStub{},
-			Name:          "executor test name",
-			ExplorerURL:   "https://explorer.com",
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
+			Name:                   "executor test name",
+			ExplorerURL:            "https://explorer.com",
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 
@@ -389,12 +525,21 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 				},
 			},
 			BlsKeysFetcher: &mock.BLSKEysFetcherStub{
-				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]string, error) {
+				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
 					getAllBLSKeysCalled = true
 
-					return []string{"extra key"}, nil
+					return []core.OwnerBLSKeys{{BLSKeys: []string{"extra key"}}}, nil
 				},
 			},
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
 			BLSKeysFilter: &mock.BLSKeysFilterStub{
 				ShouldNotifyCalled: func(blsKey string) bool {
 					return false
@@ -462,15 +607,24 @@ func TestBlsKeysExecutor_Execute(t *testing.T) {
 				},
 			},
 			BlsKeysFetcher: &mock.BLSKEysFetcherStub{
-				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]string, error) {
+				GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
 					getAllBLSKeysCalled = true
 
-					return []string{"extra key"}, nil
+					return []core.OwnerBLSKeys{{BLSKeys: []string{"extra key"}}}, nil
 				},
 			},
-			BLSKeysFilter: &mock.BLSKeysFilterStub{},
-			Name:          "executor test name",
-			ExplorerURL:   "https://explorer.com",
+			NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+			QuorumChecker:          &mock.QuorumCheckerStub{},
+			HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+			AuctionChecker:         &mock.AuctionCheckerStub{},
+			ShardChecker:           &mock.ShardCheckerStub{},
+			PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+			ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+			EpochTracker:           &mock.EpochTrackerStub{},
+			EpochReporter:          &mock.EpochReporterStub{},
+			BLSKeysFilter:          &mock.BLSKeysFilterStub{},
+			Name:                   "executor test name",
+			ExplorerURL:            "https://explorer.com",
 		}
 		executor, _ := NewBLSKeysExecutor(args)
 
@@ -528,6 +682,15 @@ func TestCreateIdentifierURL(t *testing.T) {
 		ValidatorStatisticsQuerier: &mock.ValidatorStatisticsQuerierStub{},
 		StatusHandler:              &mock.StatusHandlerStub{},
 		BlsKeysFetcher:             &mock.BLSKEysFetcherStub{},
+		NetworkAnomalyDetector:     &mock.NetworkAnomalyDetectorStub{},
+		QuorumChecker:              &mock.QuorumCheckerStub{},
+		HeartbeatChecker:           &mock.HeartbeatCheckerStub{},
+		AuctionChecker:             &mock.AuctionCheckerStub{},
+		ShardChecker:               &mock.ShardCheckerStub{},
+		PeersRatingChecker:         &mock.PeersRatingCheckerStub{},
+		ThresholdsResolver:         &mock.ThresholdsResolverStub{},
+		EpochTracker:               &mock.EpochTrackerStub{},
+		EpochReporter:              &mock.EpochReporterStub{},
 		BLSKeysFilter:              &mock.BLSKeysFilterStub{},
 	}
 
@@ -562,3 +725,896 @@ func TestCreateIdentifierURL(t *testing.T) {
 		assert.Equal(t, "https://example.com/nodes/bls1", executor.createIdentifierURL("bls1"))
 	})
 }
+
+func createTestArgsBLSKeysExecutorForGrouping(
+	problematicKeys []core.CheckResponse,
+	ownersBLSKeys []core.OwnerBLSKeys,
+	outputNotifierMessages *[]core.OutputMessage,
+	statusHandlerMessages *[]core.OutputMessage,
+) ArgsBLSKeysExecutor {
+	return ArgsBLSKeysExecutor{
+		OutputNotifiersHandler: &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				*outputNotifierMessages = messages
+				return nil
+			},
+		},
+		RatingsChecker: &mock.RatingsCheckerStub{
+			CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return problematicKeys, nil
+			},
+		},
+		ValidatorStatisticsQuerier: &mock.ValidatorStatisticsQuerierStub{},
+		StatusHandler: &mock.StatusHandlerStub{
+			CollectKeysProblemsHandler: func(messages []core.OutputMessage) {
+				*statusHandlerMessages = messages
+			},
+		},
+		BlsKeysFetcher: &mock.BLSKEysFetcherStub{
+			GetAllBLSKeysHandler: func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
+				return ownersBLSKeys, nil
+			},
+		},
+		NetworkAnomalyDetector: &mock.NetworkAnomalyDetectorStub{},
+		QuorumChecker:          &mock.QuorumCheckerStub{},
+		HeartbeatChecker:       &mock.HeartbeatCheckerStub{},
+		AuctionChecker:         &mock.AuctionCheckerStub{},
+		ShardChecker:           &mock.ShardCheckerStub{},
+		PeersRatingChecker:     &mock.PeersRatingCheckerStub{},
+		ThresholdsResolver:     &mock.ThresholdsResolverStub{},
+		EpochTracker:           &mock.EpochTrackerStub{},
+		EpochReporter:          &mock.EpochReporterStub{},
+		BLSKeysFilter:          &mock.BLSKeysFilterStub{},
+		Name:                   "executor test name",
+		ExplorerURL:            "https://explorer.com",
+	}
+}
+
+func TestBlsKeysExecutor_ExecuteShouldGroupByOwner(t *testing.T) {
+	t.Parallel()
+
+	owner1 := core.Address{Hex: "hex1", Bech32: "erd1owner1owner1owner1"}
+	owner2 := core.Address{Hex: "hex2", Bech32: "erd1owner2owner2owner2"}
+	ownersBLSKeys := []core.OwnerBLSKeys{
+		{
+			Owner:   owner1,
+			BLSKeys: []string{"key10", "key11", "key12", "key13", "key14", "key15", "key16", "key17"},
+		},
+		{
+			Owner:   owner2,
+			BLSKeys: []string{"key20", "key21"},
+		},
+	}
+	problematicKeys := []core.CheckResponse{
+		{HexBLSKey: "list key", Status: "status"},
+		{HexBLSKey: "key10", Status: "s10"},
+		{HexBLSKey: "key20", Status: "s20"},
+		{HexBLSKey: "key11", Status: "s11"},
+		{HexBLSKey: "key12", Status: "s12"},
+		{HexBLSKey: "key13", Status: "s13"},
+		{HexBLSKey: "key14", Status: "s14"},
+		{HexBLSKey: "key15", Status: "s15"},
+		{HexBLSKey: "key16", Status: "s16"},
+	}
+
+	var outputNotifierMessages []core.OutputMessage
+	var statusHandlerMessages []core.OutputMessage
+	var resolverOwnersBLSKeys []core.OwnerBLSKeys
+	args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+	args.ThresholdsResolver = &mock.ThresholdsResolverStub{
+		SetOwnersBLSKeysHandler: func(providedOwnersBLSKeys []core.OwnerBLSKeys) {
+			resolverOwnersBLSKeys = providedOwnersBLSKeys
+		},
+	}
+	executor, _ := NewBLSKeysExecutor(args)
+
+	err := executor.Execute(context.Background())
+	assert.Nil(t, err)
+
+	// the thresholds resolver should receive the owners, to apply their labels to their BLS keys
+	assert.Equal(t, ownersBLSKeys, resolverOwnersBLSKeys)
+
+	// the status handler should receive all problematic keys
+	assert.Equal(t, len(problematicKeys), len(statusHandlerMessages))
+
+	expectedMessages := []core.OutputMessage{
+		{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "BLS key",
+			Identifier:         "list key",
+			ShortIdentifier:    "list key",
+			IdentifierURL:      "https://explorer.com/nodes/list%20key",
+			ExecutorName:       "executor test name",
+			ProblemEncountered: "status",
+		},
+		{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "Owner address",
+			Identifier:         "erd1owner1owner1owner1",
+			ShortIdentifier:    "erd1ow...owner1",
+			IdentifierURL:      "https://explorer.com/accounts/erd1owner1owner1owner1",
+			ExecutorName:       "executor test name",
+			ProblemEncountered: "7 of 8 staked BLS key(s) encountered problems: key10 (s10), key11 (s11), key12 (s12), key13 (s13), key14 (s14), and 2 more",
+		},
+		{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "BLS key",
+			Identifier:         "key20",
+			ShortIdentifier:    "key20",
+			IdentifierURL:      "https://explorer.com/nodes/key20",
+			ExecutorName:       "executor test name",
+			ProblemEncountered: "s20",
+		},
+	}
+	assert.Equal(t, expectedMessages, outputNotifierMessages)
+}
+
+func TestBlsKeysExecutor_ExecuteNetworkWideEvent(t *testing.T) {
+	t.Parallel()
+
+	networkAnomalyDetector := &mock.NetworkAnomalyDetectorStub{
+		DetectHandler: func(statistics map[string]*core.ValidatorStatistics) (float64, bool) {
+			return 37.5, true
+		},
+	}
+
+	t.Run("rating drops should be replaced by one warning", func(t *testing.T) {
+		t.Parallel()
+
+		problematicKeys := []core.CheckResponse{
+			{HexBLSKey: "key1", Status: "s1", Check: core.RatingDropCheck},
+			{HexBLSKey: "key2", Status: "s2", Check: core.RatingDropCheck},
+			{HexBLSKey: "key3", Status: "s3", Check: core.RatingDropCheck},
+		}
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.NetworkAnomalyDetector = networkAnomalyDetector
+		args.PeersRatingChecker = &mock.PeersRatingCheckerStub{
+			CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+				return []core.CheckResponse{{HexBLSKey: "key4", Status: "below peers", Check: core.PeersRatingCheck}}
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, 4, len(statusHandlerMessages))
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:            core.WarningMessageOutputType,
+				ShortIdentifier: "network-wide event: 37.50% of all validators degraded, 4 monitored key(s) affected",
+				ExecutorName:    "executor test name",
+			},
+		}
+		assert.Equal(t, expectedMessages, outputNotifierMessages)
+	})
+	t.Run("the other problems should still be reported per key", func(t *testing.T) {
+		t.Parallel()
+
+		owner := core.Address{Hex: "hex1", Bech32: "erd1owner1owner1owner1"}
+		ownersBLSKeys := []core.OwnerBLSKeys{
+			{
+				Owner:   owner,
+				BLSKeys: []string{"key10", "key11"},
+			},
+		}
+		problematicKeys := []core.CheckResponse{
+			{HexBLSKey: "key1", Status: "rating drop", Check: core.RatingDropCheck},
+			{HexBLSKey: "key2", Status: "imminent jail", Check: core.ImminentJailCheck},
+			{HexBLSKey: "key10", Status: "rating drop", Check: core.RatingDropCheck},
+		}
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+		args.NetworkAnomalyDetector = networkAnomalyDetector
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return []core.CheckResponse{
+					{HexBLSKey: "key1", Status: "offline"},
+					{HexBLSKey: "key10", Status: "offline"},
+					{HexBLSKey: "key11", Status: "offline"},
+				}, nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		// the status handler should receive all the problems
+		require.Equal(t, 4, len(statusHandlerMessages))
+		assert.Equal(t, "rating drop; offline", statusHandlerMessages[0].ProblemEncountered)
+
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:            core.WarningMessageOutputType,
+				ShortIdentifier: "network-wide event: 37.50% of all validators degraded, 2 monitored key(s) affected",
+				ExecutorName:    "executor test name",
+			},
+			{
+				Type:               core.ErrorMessageOutputType,
+				IdentifierType:     "BLS key",
+				Identifier:         "key2",
+				ShortIdentifier:    "key2",
+				IdentifierURL:      "https://explorer.com/nodes/key2",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "imminent jail",
+			},
+			{
+				Type:               core.ErrorMessageOutputType,
+				IdentifierType:     "BLS key",
+				Identifier:         "key1",
+				ShortIdentifier:    "key1",
+				IdentifierURL:      "https://explorer.com/nodes/key1",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "offline",
+			},
+			{
+				Type:               core.ErrorMessageOutputType,
+				IdentifierType:     "Owner address",
+				Identifier:         "erd1owner1owner1owner1",
+				ShortIdentifier:    "erd1ow...owner1",
+				IdentifierURL:      "https://explorer.com/accounts/erd1owner1owner1owner1",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "2 of 2 staked BLS key(s) encountered problems: key10 (offline), key11 (offline)",
+			},
+		}
+		assert.Equal(t, expectedMessages, outputNotifierMessages)
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteWithQuorumChecker(t *testing.T) {
+	t.Parallel()
+
+	problematicKeys := []core.CheckResponse{
+		{HexBLSKey: "key1", Status: "s1"},
+		{HexBLSKey: "key2", Status: "s2"},
+	}
+	disagreements := []core.SourcesDisagreement{
+		{
+			HexBLSKey:       "key2",
+			DegradedSources: []string{"primary"},
+			HealthySources:  []string{"observer", "proxy"},
+		},
+	}
+	expectedDisagreementsMessage := core.OutputMessage{
+		Type:            core.WarningMessageOutputType,
+		ShortIdentifier: "the validator statistics sources disagree on 1 BLS key(s): key2 problematic on primary but not on observer, proxy",
+		ExecutorName:    "executor test name",
+	}
+
+	var outputNotifierMessages []core.OutputMessage
+	var statusHandlerMessages []core.OutputMessage
+	args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+	args.QuorumChecker = &mock.QuorumCheckerStub{
+		CheckHandler: func(ctx context.Context, keys []core.CheckResponse, extraBLSKeys []string) ([]core.CheckResponse, []core.SourcesDisagreement) {
+			assert.Equal(t, problematicKeys, keys)
+			return keys[:1], disagreements
+		},
+	}
+	executor, _ := NewBLSKeysExecutor(args)
+
+	t.Run("only the confirmed keys should be reported, followed by the disagreements", func(t *testing.T) {
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, len(statusHandlerMessages))
+		assert.Equal(t, 2, len(outputNotifierMessages))
+		assert.Equal(t, "key1", outputNotifierMessages[0].Identifier)
+		assert.Equal(t, expectedDisagreementsMessage, outputNotifierMessages[1])
+	})
+	t.Run("same disagreements should not be reported again", func(t *testing.T) {
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, len(outputNotifierMessages))
+		assert.Equal(t, "key1", outputNotifierMessages[0].Identifier)
+	})
+	t.Run("changed disagreements should be reported even if no key is confirmed", func(t *testing.T) {
+		outputNotifierMessages = nil
+		args.QuorumChecker = &mock.QuorumCheckerStub{
+			CheckHandler: func(ctx context.Context, keys []core.CheckResponse, extraBLSKeys []string) ([]core.CheckResponse, []core.SourcesDisagreement) {
+				return nil, []core.SourcesDisagreement{
+					{
+						HexBLSKey:       "key1",
+						DegradedSources: []string{"primary", "proxy"},
+						HealthySources:  []string{"observer"},
+					},
+				}
+			},
+		}
+		executor.quorumChecker = args.QuorumChecker
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		expectedMessage := core.OutputMessage{
+			Type:            core.WarningMessageOutputType,
+			ShortIdentifier: "the validator statistics sources disagree on 1 BLS key(s): key1 problematic on primary, proxy but not on observer",
+			ExecutorName:    "executor test name",
+		}
+		assert.Equal(t, []core.OutputMessage{expectedMessage}, outputNotifierMessages)
+	})
+	t.Run("many disagreements should be truncated", func(t *testing.T) {
+		manyDisagreements := make([]core.SourcesDisagreement, 0)
+		for _, key := range []string{"k1", "k2", "k3", "k4", "k5", "k6", "k7"} {
+			manyDisagreements = append(manyDisagreements, core.SourcesDisagreement{
+				HexBLSKey:       key,
+				DegradedSources: []string{"primary"},
+				HealthySources:  []string{"proxy"},
+			})
+		}
+
+		messages := executor.createDisagreementsMessages(manyDisagreements)
+		assert.Equal(t, 1, len(messages))
+		assert.Equal(t, "the validator statistics sources disagree on 7 BLS key(s): k1 problematic on primary but not on proxy; "+
+			"k2 problematic on primary but not on proxy; k3 problematic on primary but not on proxy; "+
+			"k4 problematic on primary but not on proxy; k5 problematic on primary but not on proxy; and 2 more",
+			messages[0].ShortIdentifier)
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteShouldApplyLabelsAndTags(t *testing.T) {
+	t.Parallel()
+
+	owner := core.Address{Hex: "hex1", Bech32: "erd1owner1owner1owner1"}
+	ownersBLSKeys := []core.OwnerBLSKeys{
+		{
+			Owner:   owner,
+			BLSKeys: []string{"key10", "key11"},
+		},
+	}
+	problematicKeys := []core.CheckResponse{
+		{HexBLSKey: "list key", Status: "status"},
+		{HexBLSKey: "key10", Status: "s10"},
+		{HexBLSKey: "key11", Status: "s11"},
+	}
+
+	var outputNotifierMessages []core.OutputMessage
+	var statusHandlerMessages []core.OutputMessage
+	args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+	args.IdentitiesMetadata = map[string]core.IdentityMetadata{
+		"list key": {
+			Label: "node-fra-03",
+			Tags:  map[string]string{"dc": "fra"},
+		},
+		"key10": {
+			Label: "node-ams-01",
+		},
+		owner.Bech32: {
+			Label: "alice",
+			Tags:  map[string]string{"owner": "alice"},
+		},
+	}
+	executor, _ := NewBLSKeysExecutor(args)
+
+	err := executor.Execute(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, len(statusHandlerMessages))
+	assert.Equal(t, "node-ams-01 (key10)", statusHandlerMessages[1].ShortIdentifier)
+	assert.Equal(t, "key11", statusHandlerMessages[2].ShortIdentifier)
+
+	expectedMessages := []core.OutputMessage{
+		{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "BLS key",
+			Identifier:         "list key",
+			ShortIdentifier:    "node-fra-03 (list key)",
+			IdentifierURL:      "https://explorer.com/nodes/list%20key",
+			ExecutorName:       "executor test name",
+			ProblemEncountered: "status",
+			Label:              "node-fra-03",
+			Tags:               map[string]string{"dc": "fra"},
+		},
+		{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "Owner address",
+			Identifier:         "erd1owner1owner1owner1",
+			ShortIdentifier:    "alice (erd1ow...owner1)",
+			IdentifierURL:      "https://explorer.com/accounts/erd1owner1owner1owner1",
+			ExecutorName:       "executor test name",
+			ProblemEncountered: "2 of 2 staked BLS key(s) encountered problems: node-ams-01 (key10) (s10), key11 (s11)",
+			Label:              "alice",
+			Tags:               map[string]string{"owner": "alice"},
+		},
+	}
+	assert.Equal(t, expectedMessages, outputNotifierMessages)
+}
+
+func TestBlsKeysExecutor_SetIdentities(t *testing.T) {
+	t.Parallel()
+
+	problematicKeys := []core.CheckResponse{
+		{HexBLSKey: "key1", Status: "s1"},
+	}
+
+	var outputNotifierMessages []core.OutputMessage
+	var statusHandlerMessages []core.OutputMessage
+	args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+	executor, _ := NewBLSKeysExecutor(args)
+
+	err := executor.SetIdentities(nil)
+	assert.Equal(t, errNilIdentitiesHolder, err)
+
+	err = executor.SetIdentities(&core.IdentitiesHolder{
+		Metadata: map[string]core.IdentityMetadata{
+			"key1": {Label: "node-fra-03"},
+		},
+	})
+	assert.Nil(t, err)
+
+	err = executor.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(outputNotifierMessages))
+	assert.Equal(t, "node-fra-03 (key1)", outputNotifierMessages[0].ShortIdentifier)
+}
+
+func TestBlsKeysExecutor_ExecuteWithHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("heartbeat problems should be merged with the ratings problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{
+			{HexBLSKey: "bls1", Status: "rating drop"},
+			{HexBLSKey: "bls2", Status: "imminent jail"},
+		}
+		ownersBLSKeys := []core.OwnerBLSKeys{{BLSKeys: []string{"bls3"}}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				assert.Equal(t, []string{"bls3"}, extraBLSKeys)
+
+				return []core.CheckResponse{
+					{HexBLSKey: "bls2", Status: "offline"},
+					{HexBLSKey: "bls3", Status: "offline"},
+				}, nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, outputNotifierMessages, statusHandlerMessages)
+		assert.Equal(t, 3, len(outputNotifierMessages))
+		assert.Equal(t, "rating drop", outputNotifierMessages[0].ProblemEncountered)
+		assert.Equal(t, "imminent jail; offline", outputNotifierMessages[1].ProblemEncountered)
+		assert.Equal(t, "bls3", outputNotifierMessages[2].Identifier)
+		assert.Equal(t, "offline", outputNotifierMessages[2].ProblemEncountered)
+	})
+	t.Run("heartbeat check error should still report the ratings problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		expectedErr := errors.New("expected error")
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+		assert.Equal(t, 1, len(outputNotifierMessages))
+		assert.Equal(t, "rating drop", outputNotifierMessages[0].ProblemEncountered)
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteWithAuction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("auction problems should be merged with the other problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		ownersBLSKeys := []core.OwnerBLSKeys{{BLSKeys: []string{"bls3"}}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return []core.CheckResponse{{HexBLSKey: "bls3", Status: "offline"}}, nil
+			},
+		}
+		args.AuctionChecker = &mock.AuctionCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				assert.Equal(t, []string{"bls3"}, extraBLSKeys)
+
+				return []core.CheckResponse{
+					{HexBLSKey: "bls3", Status: "not qualified"},
+					{HexBLSKey: "bls4", Status: "not qualified"},
+				}, nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, outputNotifierMessages, statusHandlerMessages)
+		assert.Equal(t, 3, len(outputNotifierMessages))
+		assert.Equal(t, "rating drop", outputNotifierMessages[0].ProblemEncountered)
+		assert.Equal(t, "offline; not qualified", outputNotifierMessages[1].ProblemEncountered)
+		assert.Equal(t, "bls4", outputNotifierMessages[2].Identifier)
+		assert.Equal(t, "not qualified", outputNotifierMessages[2].ProblemEncountered)
+	})
+	t.Run("auction check error should still report the other problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		expectedErr := errors.New("expected error")
+		args.AuctionChecker = &mock.AuctionCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+		assert.Equal(t, 1, len(outputNotifierMessages))
+		assert.Equal(t, "rating drop", outputNotifierMessages[0].ProblemEncountered)
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteWithPeersRating(t *testing.T) {
+	t.Parallel()
+
+	t.Run("peers rating problems should be merged with the other problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		ownersBLSKeys := []core.OwnerBLSKeys{{BLSKeys: []string{"bls3"}}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+		statistics := map[string]*core.ValidatorStatistics{
+			"bls1": {TempRating: 90, Rating: 100},
+		}
+		args.ValidatorStatisticsQuerier = &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				return statistics, nil
+			},
+		}
+		args.PeersRatingChecker = &mock.PeersRatingCheckerStub{
+			CheckHandler: func(providedStatistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+				assert.Equal(t, statistics, providedStatistics)
+				assert.Equal(t, []string{"bls3"}, extraBLSKeys)
+
+				return []core.CheckResponse{
+					{HexBLSKey: "bls1", Status: "below peers", Check: core.PeersRatingCheck},
+					{HexBLSKey: "bls3", Status: "below peers", Check: core.PeersRatingCheck},
+				}
+			},
+		}
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return []core.CheckResponse{{HexBLSKey: "bls3", Status: "offline"}}, nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, outputNotifierMessages, statusHandlerMessages)
+		assert.Equal(t, 2, len(outputNotifierMessages))
+		assert.Equal(t, "rating drop; below peers", outputNotifierMessages[0].ProblemEncountered)
+		assert.Equal(t, "bls3", outputNotifierMessages[1].Identifier)
+		assert.Equal(t, "below peers; offline", outputNotifierMessages[1].ProblemEncountered)
+	})
+	t.Run("peers rating problems should not be reported during the epoch change grace period", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{
+			{HexBLSKey: "bls1", Status: "rating drop", Check: core.RatingDropCheck},
+			{HexBLSKey: "bls3", Status: "imminent jail", Check: core.ImminentJailCheck},
+		}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.EpochTracker = &mock.EpochTrackerStub{
+			IsInGracePeriodHandler: func() bool {
+				return true
+			},
+		}
+		args.PeersRatingChecker = &mock.PeersRatingCheckerStub{
+			CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+				return []core.CheckResponse{
+					{HexBLSKey: "bls2", Status: "below peers", Check: core.PeersRatingCheck},
+					// the imminent jail of the same key should not keep the peers rating problem
+					{HexBLSKey: "bls3", Status: "below peers", Check: core.PeersRatingCheck},
+				}
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		require.Equal(t, 1, len(outputNotifierMessages))
+		assert.Equal(t, "bls3", outputNotifierMessages[0].Identifier)
+		assert.Equal(t, "imminent jail", outputNotifierMessages[0].ProblemEncountered)
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteWithEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("messages should have the current epoch", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop", Check: core.RatingDropCheck}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.EpochTracker = &mock.EpochTrackerStub{
+			CurrentEpochHandler: func() (uint32, bool) {
+				return 37, true
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, outputNotifierMessages, statusHandlerMessages)
+		require.Equal(t, 1, len(outputNotifierMessages))
+		require.NotNil(t, outputNotifierMessages[0].Epoch)
+		assert.Equal(t, uint32(37), *outputNotifierMessages[0].Epoch)
+	})
+	t.Run("unknown epoch should not be set", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		require.Equal(t, 1, len(outputNotifierMessages))
+		assert.Nil(t, outputNotifierMessages[0].Epoch)
+	})
+	t.Run("rating drops should not be reported during the grace period", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{
+			{HexBLSKey: "bls1", Status: "rating drop", Check: core.RatingDropCheck},
+			{HexBLSKey: "bls2", Status: "imminent jail", Check: core.ImminentJailCheck},
+		}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.HeartbeatChecker = &mock.HeartbeatCheckerStub{
+			CheckHandler: func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+				return []core.CheckResponse{{HexBLSKey: "bls3", Status: "offline"}}, nil
+			},
+		}
+		args.EpochTracker = &mock.EpochTrackerStub{
+			IsInGracePeriodHandler: func() bool {
+				return true
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(outputNotifierMessages))
+		assert.Equal(t, "imminent jail", outputNotifierMessages[0].ProblemEncountered)
+		assert.Equal(t, "offline", outputNotifierMessages[1].ProblemEncountered)
+	})
+	t.Run("epoch change should report the last statistics of the ended epoch", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		args := createTestArgsBLSKeysExecutorForGrouping(nil, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.IdentitiesMetadata = map[string]core.IdentityMetadata{
+			"bls1": {Label: "validator1"},
+		}
+		numQueries := 0
+		args.ValidatorStatisticsQuerier = &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				numQueries++
+				return map[string]*core.ValidatorStatistics{
+					"bls1": {TempRating: float32(numQueries)},
+				}, nil
+			},
+		}
+		currentEpoch := uint32(5)
+		args.EpochTracker = &mock.EpochTrackerStub{
+			UpdateHandler: func(ctx context.Context) (uint32, bool, error) {
+				if currentEpoch == 5 {
+					return 0, false, nil
+				}
+
+				return 5, true, nil
+			},
+			CurrentEpochHandler: func() (uint32, bool) {
+				return currentEpoch, true
+			},
+		}
+		args.EpochReporter = &mock.EpochReporterStub{
+			ReportHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+				assert.Equal(t, float32(1), statistics["bls1"].TempRating)
+
+				return []core.CheckResponse{{HexBLSKey: "bls1", Status: "report"}}
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, outputNotifierMessages)
+
+		currentEpoch = 6
+		err = executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		expectedEpoch := uint32(5)
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:               core.InfoMessageOutputType,
+				IdentifierType:     identifierType,
+				Identifier:         "bls1",
+				ShortIdentifier:    "validator1 (bls1)",
+				IdentifierURL:      "https://explorer.com/nodes/bls1",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "report",
+				Label:              "validator1",
+				Epoch:              &expectedEpoch,
+			},
+		}
+		assert.Equal(t, expectedMessages, outputNotifierMessages)
+		assert.Empty(t, statusHandlerMessages)
+	})
+	t.Run("epoch update error should still report the problems", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+		args := createTestArgsBLSKeysExecutorForGrouping(problematicKeys, nil, &outputNotifierMessages, &statusHandlerMessages)
+		expectedErr := errors.New("expected error")
+		args.EpochTracker = &mock.EpochTrackerStub{
+			UpdateHandler: func(ctx context.Context) (uint32, bool, error) {
+				return 0, false, expectedErr
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		args.EpochReporter = &mock.EpochReporterStub{
+			ReportHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+				assert.Fail(t, "should have not called Report")
+				return nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+		assert.Equal(t, 1, len(outputNotifierMessages))
+	})
+}
+
+func TestBlsKeysExecutor_ExecuteWithShardChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shard changes should be notified in one batch", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		ownersBLSKeys := []core.OwnerBLSKeys{{BLSKeys: []string{"bls3"}}}
+		args := createTestArgsBLSKeysExecutorForGrouping(nil, ownersBLSKeys, &outputNotifierMessages, &statusHandlerMessages)
+		args.IdentitiesMetadata = map[string]core.IdentityMetadata{
+			"bls1": {Label: "validator1"},
+		}
+		args.ShardChecker = &mock.ShardCheckerStub{
+			CheckHandler: func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.ShardChange, map[string]uint32) {
+				assert.Equal(t, []string{"bls3"}, extraBLSKeys)
+
+				changes := []core.ShardChange{
+					{HexBLSKey: "bls1", OldShardID: 0, NewShardID: 1},
+					{HexBLSKey: "bls3", OldShardID: 2, NewShardID: core.MetachainShardID},
+				}
+				return changes, map[string]uint32{"bls1": 1, "bls3": core.MetachainShardID}
+			},
+		}
+		var collectedShards map[string]uint32
+		args.StatusHandler = &mock.StatusHandlerStub{
+			CollectKeysShardsHandler: func(monitorName string, shards map[string]uint32) {
+				assert.Equal(t, "executor test name", monitorName)
+				collectedShards = shards
+			},
+			CollectKeysProblemsHandler: func(messages []core.OutputMessage) {
+				assert.Fail(t, "should have not called CollectKeysProblems")
+			},
+		}
+		numNotifyCalls := 0
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				numNotifyCalls++
+				outputNotifierMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numNotifyCalls)
+		assert.Equal(t, map[string]uint32{"bls1": 1, "bls3": core.MetachainShardID}, collectedShards)
+
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:               core.InfoMessageOutputType,
+				IdentifierType:     identifierType,
+				Identifier:         "bls1",
+				ShortIdentifier:    "validator1 (bls1)",
+				IdentifierURL:      "https://explorer.com/nodes/bls1",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "Shard changed: from shard 0 to shard 1",
+				Label:              "validator1",
+			},
+			{
+				Type:               core.InfoMessageOutputType,
+				IdentifierType:     identifierType,
+				Identifier:         "bls3",
+				ShortIdentifier:    "bls3",
+				IdentifierURL:      "https://explorer.com/nodes/bls3",
+				ExecutorName:       "executor test name",
+				ProblemEncountered: "Shard changed: from shard 2 to metachain",
+			},
+		}
+		assert.Equal(t, expectedMessages, outputNotifierMessages)
+	})
+	t.Run("no shard changes should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		var outputNotifierMessages []core.OutputMessage
+		var statusHandlerMessages []core.OutputMessage
+		args := createTestArgsBLSKeysExecutorForGrouping(nil, nil, &outputNotifierMessages, &statusHandlerMessages)
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewBLSKeysExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+}
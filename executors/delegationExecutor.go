@@ -0,0 +1,153 @@
+package executors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const delegationIdentifierType = "Delegation contract"
+const delegationExecutorName = "delegationExecutor"
+
+// MonitoredContract holds a monitored delegation contract and its optional label
+type MonitoredContract struct {
+	Address core.Address
+	Label   string
+}
+
+type delegationExecutor struct {
+	outputNotifiersHandler OutputNotifiersHandler
+	statusHandler          StatusHandler
+	contractsFilter        BLSKeysFilter
+	delegationStateQuerier DelegationStateQuerier
+	delegationChecker      DelegationChecker
+	contracts              []MonitoredContract
+	previousStates         map[string]*core.DelegationContractState
+	name                   string
+	explorerURL            string
+}
+
+// ArgsDelegationExecutor defines the DTO struct for the NewDelegationExecutor constructor function
+type ArgsDelegationExecutor struct {
+	OutputNotifiersHandler OutputNotifiersHandler
+	StatusHandler          StatusHandler
+	ContractsFilter        BLSKeysFilter
+	DelegationStateQuerier DelegationStateQuerier
+	DelegationChecker      DelegationChecker
+	Contracts              []MonitoredContract
+	Name                   string
+	ExplorerURL            string
+}
+
+// NewDelegationExecutor creates a new instance of type delegationExecutor
+func NewDelegationExecutor(args ArgsDelegationExecutor) (*delegationExecutor, error) {
+	if check.IfNil(args.OutputNotifiersHandler) {
+		return nil, errNilOutputNotifiersHandler
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, errNilStatusHandler
+	}
+	if check.IfNil(args.ContractsFilter) {
+		return nil, errNilBLSKeysFilter
+	}
+	if check.IfNil(args.DelegationStateQuerier) {
+		return nil, errNilDelegationStateQuerier
+	}
+	if check.IfNil(args.DelegationChecker) {
+		return nil, errNilDelegationChecker
+	}
+	if len(args.Contracts) == 0 {
+		return nil, errNoDelegationContracts
+	}
+
+	return &delegationExecutor{
+		outputNotifiersHandler: args.OutputNotifiersHandler,
+		statusHandler:          args.StatusHandler,
+		contractsFilter:        args.ContractsFilter,
+		delegationStateQuerier: args.DelegationStateQuerier,
+		delegationChecker:      args.DelegationChecker,
+		contracts:              args.Contracts,
+		previousStates:         make(map[string]*core.DelegationContractState),
+		name:                   args.Name,
+		explorerURL:            args.ExplorerURL,
+	}, nil
+}
+
+// Execute executes one checking cycle. The problems of the contracts are subject to the alarm snooze while the changes
+// are reported only once, when they are detected
+func (executor *delegationExecutor) Execute(ctx context.Context) error {
+	log.Debug("executing query-check-notify cycle", "executor", executor.name)
+
+	var lastErr error
+	problemsMessages := make([]core.OutputMessage, 0)
+	changesMessages := make([]core.OutputMessage, 0)
+	for _, contract := range executor.contracts {
+		state, err := executor.delegationStateQuerier.GetState(ctx, contract.Address)
+		if err != nil {
+			log.Debug("delegationExecutor.Execute", "executor", executor.name, "contract", contract.Address.Bech32,
+				"error calling GetState", err.Error())
+			executor.statusHandler.ErrorEncountered(err)
+			lastErr = err
+
+			continue
+		}
+
+		problems, changes := executor.delegationChecker.Check(executor.previousStates[contract.Address.Hex], state)
+		executor.previousStates[contract.Address.Hex] = state
+
+		if len(changes) > 0 {
+			changesMessages = append(changesMessages,
+				executor.createMessage(contract, core.WarningMessageOutputType, changes))
+		}
+		if len(problems) > 0 && executor.contractsFilter.ShouldNotify(contract.Address.Bech32) {
+			problemsMessages = append(problemsMessages,
+				executor.createMessage(contract, core.ErrorMessageOutputType, problems))
+		}
+	}
+
+	if len(problemsMessages) > 0 {
+		executor.statusHandler.CollectKeysProblems(problemsMessages)
+	}
+
+	messages := append(problemsMessages, changesMessages...)
+	if len(messages) == 0 {
+		log.Debug("all delegation contracts are performing normally", "executor", executor.name)
+		return lastErr
+	}
+
+	err := executor.outputNotifiersHandler.NotifyWithRetry(delegationExecutorName, messages...)
+	if err != nil {
+		log.Debug("delegationExecutor.Execute", "executor", executor.name, "error notifying", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+
+		return err
+	}
+
+	return lastErr
+}
+
+func (executor *delegationExecutor) createMessage(contract MonitoredContract, messageType core.MessageOutputType, descriptions []string) core.OutputMessage {
+	message := core.OutputMessage{
+		Type:               messageType,
+		IdentifierType:     delegationIdentifierType,
+		Identifier:         contract.Address.Bech32,
+		ShortIdentifier:    shortIdentifier(contract.Address.Bech32),
+		IdentifierURL:      createExplorerURL(executor.explorerURL, explorerURLAccountsPathName, contract.Address.Bech32),
+		ExecutorName:       executor.name,
+		ProblemEncountered: strings.Join(descriptions, "; "),
+		Label:              contract.Label,
+	}
+	if len(contract.Label) > 0 {
+		message.ShortIdentifier = fmt.Sprintf("%s (%s)", contract.Label, message.ShortIdentifier)
+	}
+
+	return message
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *delegationExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
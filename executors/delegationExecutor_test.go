@@ -0,0 +1,305 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testContract1 = core.Address{
+	Hex:    "000000000000000000010000000000000000000000000000000000000bffffff",
+	Bech32: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllllls27850s",
+}
+
+var testContract2 = core.Address{
+	Hex:    "000000000000000000010000000000000000000000000000000000000cffffff",
+	Bech32: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqr8llllse9cj2t",
+}
+
+func createMockArgsDelegationExecutor() ArgsDelegationExecutor {
+	return ArgsDelegationExecutor{
+		OutputNotifiersHandler: &mock.OutputNotifiersHandlerStub{},
+		StatusHandler:          &mock.StatusHandlerStub{},
+		ContractsFilter:        &mock.BLSKeysFilterStub{},
+		DelegationStateQuerier: &mock.DelegationStateQuerierStub{},
+		DelegationChecker:      &mock.DelegationCheckerStub{},
+		Contracts: []MonitoredContract{
+			{
+				Address: testContract1,
+				Label:   "provider",
+			},
+			{
+				Address: testContract2,
+			},
+		},
+		Name:        "test",
+		ExplorerURL: "https://explorer.com",
+	}
+}
+
+func TestNewDelegationExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil output notifiers handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.OutputNotifiersHandler = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilOutputNotifiersHandler, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.StatusHandler = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil contracts filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.ContractsFilter = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilBLSKeysFilter, err)
+	})
+	t.Run("nil delegation state querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.DelegationStateQuerier = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilDelegationStateQuerier, err)
+	})
+	t.Run("nil delegation checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.DelegationChecker = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilDelegationChecker, err)
+	})
+	t.Run("no contracts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.Contracts = nil
+		executor, err := NewDelegationExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNoDelegationContracts, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewDelegationExecutor(createMockArgsDelegationExecutor())
+		assert.NotNil(t, executor)
+		assert.Nil(t, err)
+	})
+}
+
+func TestDelegationExecutor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *delegationExecutor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &delegationExecutor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDelegationExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy contracts should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewDelegationExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("should notify the problems and the changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.DelegationStateQuerier = &mock.DelegationStateQuerierStub{
+			GetStateHandler: func(ctx context.Context, contract core.Address) (*core.DelegationContractState, error) {
+				if contract == testContract1 {
+					return &core.DelegationContractState{NumNodes: 1}, nil
+				}
+
+				return &core.DelegationContractState{NumNodes: 2}, nil
+			},
+		}
+		previousStates := make([]*core.DelegationContractState, 0)
+		args.DelegationChecker = &mock.DelegationCheckerStub{
+			CheckHandler: func(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+				previousStates = append(previousStates, previous)
+				if current.NumNodes == 1 {
+					return []string{"problem 1", "problem 2"}, nil
+				}
+
+				return nil, []string{"change"}
+			},
+		}
+		var collectedMessages []core.OutputMessage
+		args.StatusHandler = &mock.StatusHandlerStub{
+			CollectKeysProblemsHandler: func(messages []core.OutputMessage) {
+				collectedMessages = messages
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Equal(t, delegationExecutorName, caller)
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewDelegationExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		problemMessage := core.OutputMessage{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     delegationIdentifierType,
+			Identifier:         testContract1.Bech32,
+			ShortIdentifier:    "provider (erd1qq...27850s)",
+			IdentifierURL:      "https://explorer.com/accounts/" + testContract1.Bech32,
+			ExecutorName:       "test",
+			ProblemEncountered: "problem 1; problem 2",
+			Label:              "provider",
+		}
+		changeMessage := core.OutputMessage{
+			Type:               core.WarningMessageOutputType,
+			IdentifierType:     delegationIdentifierType,
+			Identifier:         testContract2.Bech32,
+			ShortIdentifier:    "erd1qq...e9cj2t",
+			IdentifierURL:      "https://explorer.com/accounts/" + testContract2.Bech32,
+			ExecutorName:       "test",
+			ProblemEncountered: "change",
+		}
+		assert.Equal(t, []core.OutputMessage{problemMessage}, collectedMessages)
+		assert.Equal(t, []core.OutputMessage{problemMessage, changeMessage}, notifiedMessages)
+
+		// the second cycle should provide the previous states
+		err = executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(previousStates))
+		assert.Nil(t, previousStates[0])
+		assert.Nil(t, previousStates[1])
+		assert.Equal(t, uint64(1), previousStates[2].NumNodes)
+		assert.Equal(t, uint64(2), previousStates[3].NumNodes)
+	})
+	t.Run("snoozed problems should not be notified but the changes should", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDelegationExecutor()
+		args.Contracts = args.Contracts[:1]
+		args.DelegationChecker = &mock.DelegationCheckerStub{
+			CheckHandler: func(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+				return []string{"problem"}, []string{"change"}
+			},
+		}
+		args.ContractsFilter = &mock.BLSKeysFilterStub{
+			ShouldNotifyCalled: func(blsKey string) bool {
+				assert.Equal(t, testContract1.Bech32, blsKey)
+				return false
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewDelegationExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(notifiedMessages))
+		assert.Equal(t, "change", notifiedMessages[0].ProblemEncountered)
+	})
+	t.Run("query error should check the other contracts and return the error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsDelegationExecutor()
+		args.DelegationStateQuerier = &mock.DelegationStateQuerierStub{
+			GetStateHandler: func(ctx context.Context, contract core.Address) (*core.DelegationContractState, error) {
+				if contract == testContract1 {
+					return nil, expectedErr
+				}
+
+				return &core.DelegationContractState{}, nil
+			},
+		}
+		numChecks := 0
+		args.DelegationChecker = &mock.DelegationCheckerStub{
+			CheckHandler: func(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+				numChecks++
+				return []string{"problem"}, nil
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewDelegationExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+		assert.Equal(t, 1, numChecks)
+		assert.Equal(t, 1, len(notifiedMessages))
+		assert.Equal(t, testContract2.Bech32, notifiedMessages[0].Identifier)
+	})
+	t.Run("notify error should return the error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsDelegationExecutor()
+		args.DelegationChecker = &mock.DelegationCheckerStub{
+			CheckHandler: func(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+				return []string{"problem"}, nil
+			},
+		}
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				return expectedErr
+			},
+		}
+		executor, _ := NewDelegationExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+}
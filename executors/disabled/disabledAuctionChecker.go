@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+type disabledAuctionChecker struct{}
+
+// NewDisabledAuctionChecker will create a new instance of type disabledAuctionChecker
+func NewDisabledAuctionChecker() *disabledAuctionChecker {
+	return &disabledAuctionChecker{}
+}
+
+// Check returns no problematic keys
+func (disabled *disabledAuctionChecker) Check(_ context.Context, _ []string) ([]core.CheckResponse, error) {
+	return nil, nil
+}
+
+// SetIdentities does nothing
+func (disabled *disabledAuctionChecker) SetIdentities(_ *core.IdentitiesHolder) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledAuctionChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledAuctionChecker(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledAuctionChecker()
+	assert.NotNil(t, checker)
+}
+
+func TestDisabledAuctionChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledAuctionChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledAuctionChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledAuctionChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledAuctionChecker()
+	results, err := checker.Check(context.Background(), []string{"key"})
+	assert.Nil(t, results)
+	assert.Nil(t, err)
+	assert.Nil(t, checker.SetIdentities(&core.IdentitiesHolder{}))
+}
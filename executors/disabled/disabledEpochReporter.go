@@ -0,0 +1,25 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+type disabledEpochReporter struct{}
+
+// NewDisabledEpochReporter will create a new instance of type disabledEpochReporter
+func NewDisabledEpochReporter() *disabledEpochReporter {
+	return &disabledEpochReporter{}
+}
+
+// Report returns no reports
+func (disabled *disabledEpochReporter) Report(_ map[string]*core.ValidatorStatistics, _ []string) []core.CheckResponse {
+	return nil
+}
+
+// SetIdentities does nothing
+func (disabled *disabledEpochReporter) SetIdentities(_ *core.IdentitiesHolder) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledEpochReporter) IsInterfaceNil() bool {
+	return disabled == nil
+}
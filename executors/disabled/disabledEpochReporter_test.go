@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledEpochReporter(t *testing.T) {
+	t.Parallel()
+
+	reporter := NewDisabledEpochReporter()
+	assert.NotNil(t, reporter)
+}
+
+func TestDisabledEpochReporter_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledEpochReporter
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledEpochReporter{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledEpochReporter_Report(t *testing.T) {
+	t.Parallel()
+
+	reporter := NewDisabledEpochReporter()
+	statistics := map[string]*core.ValidatorStatistics{
+		"key": {},
+	}
+	assert.Nil(t, reporter.Report(statistics, []string{"key"}))
+	assert.Nil(t, reporter.SetIdentities(&core.IdentitiesHolder{}))
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import "context"
+
+type disabledEpochTracker struct{}
+
+// NewDisabledEpochTracker will create a new instance of type disabledEpochTracker
+func NewDisabledEpochTracker() *disabledEpochTracker {
+	return &disabledEpochTracker{}
+}
+
+// Update does nothing and never signals an epoch change
+func (disabled *disabledEpochTracker) Update(_ context.Context) (uint32, bool, error) {
+	return 0, false, nil
+}
+
+// CurrentEpoch returns false since the epoch is not known
+func (disabled *disabledEpochTracker) CurrentEpoch() (uint32, bool) {
+	return 0, false
+}
+
+// IsInGracePeriod returns false
+func (disabled *disabledEpochTracker) IsInGracePeriod() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledEpochTracker) IsInterfaceNil() bool {
+	return disabled == nil
+}
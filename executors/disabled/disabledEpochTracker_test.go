@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledEpochTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewDisabledEpochTracker()
+	assert.NotNil(t, tracker)
+}
+
+func TestDisabledEpochTracker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledEpochTracker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledEpochTracker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledEpochTracker_Methods(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewDisabledEpochTracker()
+	previousEpoch, isEpochChanged, err := tracker.Update(context.Background())
+	assert.Zero(t, previousEpoch)
+	assert.False(t, isEpochChanged)
+	assert.Nil(t, err)
+
+	epoch, isKnown := tracker.CurrentEpoch()
+	assert.Zero(t, epoch)
+	assert.False(t, isKnown)
+	assert.False(t, tracker.IsInGracePeriod())
+}
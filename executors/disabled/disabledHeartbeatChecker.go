@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+type disabledHeartbeatChecker struct{}
+
+// NewDisabledHeartbeatChecker will create a new instance of type disabledHeartbeatChecker
+func NewDisabledHeartbeatChecker() *disabledHeartbeatChecker {
+	return &disabledHeartbeatChecker{}
+}
+
+// Check returns no problematic keys
+func (disabled *disabledHeartbeatChecker) Check(_ context.Context, _ []string) ([]core.CheckResponse, error) {
+	return nil, nil
+}
+
+// SetIdentities does nothing
+func (disabled *disabledHeartbeatChecker) SetIdentities(_ *core.IdentitiesHolder) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledHeartbeatChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
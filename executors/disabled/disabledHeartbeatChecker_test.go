@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledHeartbeatChecker(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledHeartbeatChecker()
+	assert.NotNil(t, checker)
+}
+
+func TestDisabledHeartbeatChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledHeartbeatChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledHeartbeatChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledHeartbeatChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledHeartbeatChecker()
+	results, err := checker.Check(context.Background(), []string{"key"})
+	assert.Nil(t, results)
+	assert.Nil(t, err)
+	assert.Nil(t, checker.SetIdentities(&core.IdentitiesHolder{}))
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+type disabledNetworkAnomalyDetector struct{}
+
+// NewDisabledNetworkAnomalyDetector will create a new instance of type disabledNetworkAnomalyDetector
+func NewDisabledNetworkAnomalyDetector() *disabledNetworkAnomalyDetector {
+	return &disabledNetworkAnomalyDetector{}
+}
+
+// Detect returns 0 and false regardless of the provided statistics
+func (disabled *disabledNetworkAnomalyDetector) Detect(_ map[string]*core.ValidatorStatistics) (float64, bool) {
+	return 0, false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledNetworkAnomalyDetector) IsInterfaceNil() bool {
+	return disabled == nil
+}
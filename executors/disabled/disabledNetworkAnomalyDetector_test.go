@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledNetworkAnomalyDetector(t *testing.T) {
+	t.Parallel()
+
+	detector := NewDisabledNetworkAnomalyDetector()
+	assert.NotNil(t, detector)
+}
+
+func TestDisabledNetworkAnomalyDetector_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledNetworkAnomalyDetector
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledNetworkAnomalyDetector{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledNetworkAnomalyDetector_Detect(t *testing.T) {
+	t.Parallel()
+
+	detector := NewDisabledNetworkAnomalyDetector()
+	percent, isNetworkWide := detector.Detect(map[string]*core.ValidatorStatistics{
+		"bls": {TempRating: 0, Rating: 100},
+	})
+	assert.Zero(t, percent)
+	assert.False(t, isNetworkWide)
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import "context"
+
+type disabledNetworkNonceQuerier struct{}
+
+// NewDisabledNetworkNonceQuerier will create a new instance of type disabledNetworkNonceQuerier
+func NewDisabledNetworkNonceQuerier() *disabledNetworkNonceQuerier {
+	return &disabledNetworkNonceQuerier{}
+}
+
+// GetNetworkNonce returns 0 regardless of the provided shard
+func (disabled *disabledNetworkNonceQuerier) GetNetworkNonce(_ context.Context, _ uint32) (uint64, error) {
+	return 0, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledNetworkNonceQuerier) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledNetworkNonceQuerier(t *testing.T) {
+	t.Parallel()
+
+	querier := NewDisabledNetworkNonceQuerier()
+	assert.NotNil(t, querier)
+}
+
+func TestDisabledNetworkNonceQuerier_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledNetworkNonceQuerier
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledNetworkNonceQuerier{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledNetworkNonceQuerier_GetNetworkNonce(t *testing.T) {
+	t.Parallel()
+
+	querier := NewDisabledNetworkNonceQuerier()
+	nonce, err := querier.GetNetworkNonce(context.Background(), 1)
+	assert.Zero(t, nonce)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,41 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+type disabledOutbox struct {
+}
+
+// NewDisabledOutbox will create a new instance of type disabledOutbox
+func NewDisabledOutbox() *disabledOutbox {
+	return &disabledOutbox{}
+}
+
+// Add does nothing and returns nil
+func (disabled *disabledOutbox) Add(_ string, _ string, _ []core.OutputMessage) error {
+	return nil
+}
+
+// GetDueEntries returns nil
+func (disabled *disabledOutbox) GetDueEntries(_ string) []core.OutboxEntry {
+	return nil
+}
+
+// MarkDelivered does nothing and returns nil
+func (disabled *disabledOutbox) MarkDelivered(_ string, _ uint64) error {
+	return nil
+}
+
+// MarkFailed does nothing and returns nil
+func (disabled *disabledOutbox) MarkFailed(_ string, _ uint64) error {
+	return nil
+}
+
+// NumEntries returns 0
+func (disabled *disabledOutbox) NumEntries(_ string) int {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledOutbox) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,42 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledOutbox(t *testing.T) {
+	t.Parallel()
+
+	outbox := NewDisabledOutbox()
+	assert.NotNil(t, outbox)
+}
+
+func TestDisabledOutbox_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledOutbox
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledOutbox{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledOutbox_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should have not panicked")
+		}
+	}()
+
+	outbox := NewDisabledOutbox()
+	assert.Nil(t, outbox.Add("", "", nil))
+	assert.Nil(t, outbox.GetDueEntries(""))
+	assert.Nil(t, outbox.MarkDelivered("", 0))
+	assert.Nil(t, outbox.MarkFailed("", 0))
+	assert.Zero(t, outbox.NumEntries(""))
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+type disabledPeersRatingChecker struct{}
+
+// NewDisabledPeersRatingChecker will create a new instance of type disabledPeersRatingChecker
+func NewDisabledPeersRatingChecker() *disabledPeersRatingChecker {
+	return &disabledPeersRatingChecker{}
+}
+
+// Check returns no problems
+func (disabled *disabledPeersRatingChecker) Check(_ map[string]*core.ValidatorStatistics, _ []string) []core.CheckResponse {
+	return nil
+}
+
+// SetIdentities does nothing
+func (disabled *disabledPeersRatingChecker) SetIdentities(_ *core.IdentitiesHolder) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledPeersRatingChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
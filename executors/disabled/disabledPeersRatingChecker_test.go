@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledPeersRatingChecker(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledPeersRatingChecker()
+	assert.NotNil(t, checker)
+}
+
+func TestDisabledPeersRatingChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledPeersRatingChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledPeersRatingChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledPeersRatingChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledPeersRatingChecker()
+	statistics := map[string]*core.ValidatorStatistics{
+		"key": {TempRating: 50, Rating: 100},
+	}
+	assert.Nil(t, checker.Check(statistics, []string{"key"}))
+	assert.Nil(t, checker.SetIdentities(&core.IdentitiesHolder{}))
+}
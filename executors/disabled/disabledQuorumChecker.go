@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+type disabledQuorumChecker struct{}
+
+// NewDisabledQuorumChecker will create a new instance of type disabledQuorumChecker
+func NewDisabledQuorumChecker() *disabledQuorumChecker {
+	return &disabledQuorumChecker{}
+}
+
+// Check returns the provided problematic keys and no disagreements
+func (disabled *disabledQuorumChecker) Check(_ context.Context, problematicKeys []core.CheckResponse, _ []string) ([]core.CheckResponse, []core.SourcesDisagreement) {
+	return problematicKeys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledQuorumChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledQuorumChecker(t *testing.T) {
+	t.Parallel()
+
+	checker := NewDisabledQuorumChecker()
+	assert.NotNil(t, checker)
+}
+
+func TestDisabledQuorumChecker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *disabledQuorumChecker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &disabledQuorumChecker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDisabledQuorumChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	problematicKeys := []core.CheckResponse{{HexBLSKey: "bls1", Status: "rating drop"}}
+	checker := NewDisabledQuorumChecker()
+	keys, disagreements := checker.Check(context.Background(), problematicKeys, []string{"bls2"})
+	assert.Equal(t, problematicKeys, keys)
+	assert.Nil(t, disagreements)
+}
@@ -26,6 +26,14 @@ func (disabled *disabledStatusHandler) ErrorEncountered(_ error) {
 func (disabled *disabledStatusHandler) CollectKeysProblems(_ []core.OutputMessage) {
 }
 
+// CollectKeysShards does nothing
+func (disabled *disabledStatusHandler) CollectKeysShards(_ string, _ map[string]uint32) {
+}
+
+// AddEndpointsProvider does nothing
+func (disabled *disabledStatusHandler) AddEndpointsProvider(_ string, _ core.EndpointsProvider) {
+}
+
 // Execute returns nil
 func (disabled *disabledStatusHandler) Execute(_ context.Context) error {
 	return nil
@@ -38,7 +38,9 @@ func TestDisabledStatusHandler_MethodsShouldNotPanic(t *testing.T) {
 	handler := NewDisabledStatusHandler()
 	handler.NotifyAppStart()
 	handler.CollectKeysProblems(nil)
+	handler.CollectKeysShards("monitor", nil)
 	handler.ErrorEncountered(nil)
+	handler.AddEndpointsProvider("monitor", nil)
 	handler.SendCloseMessage()
 	assert.Nil(t, handler.Execute(context.Background()))
 }
@@ -0,0 +1,96 @@
+package executors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type epochTracker struct {
+	name                 string
+	querier              EpochQuerier
+	gracePeriodInSeconds int64
+	getCurrentTimestamp  func() int64
+	mutEpoch             sync.RWMutex
+	epoch                uint32
+	isEpochKnown         bool
+	epochChangeTimestamp int64
+	isEpochChangeSeen    bool
+}
+
+// ArgsEpochTracker is the argument DTO used for the NewEpochTracker constructor function
+type ArgsEpochTracker struct {
+	Name                 string
+	Querier              EpochQuerier
+	GracePeriodInSeconds uint64
+	GetCurrentTimestamp  func() int64
+}
+
+// NewEpochTracker creates a new instance of type epochTracker
+func NewEpochTracker(args ArgsEpochTracker) (*epochTracker, error) {
+	if check.IfNil(args.Querier) {
+		return nil, errNilEpochQuerier
+	}
+	if args.GetCurrentTimestamp == nil {
+		return nil, errNilCurrentTimestampHandler
+	}
+
+	return &epochTracker{
+		name:                 args.Name,
+		querier:              args.Querier,
+		gracePeriodInSeconds: int64(args.GracePeriodInSeconds),
+		getCurrentTimestamp:  args.GetCurrentTimestamp,
+	}, nil
+}
+
+// Update queries the current epoch. It returns the previously known epoch and true if the epoch changed since the last
+// successful update. The first queried epoch is not considered an epoch change
+func (tracker *epochTracker) Update(ctx context.Context) (uint32, bool, error) {
+	epoch, err := tracker.querier.GetEpoch(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	tracker.mutEpoch.Lock()
+	defer tracker.mutEpoch.Unlock()
+
+	previousEpoch := tracker.epoch
+	isEpochChanged := tracker.isEpochKnown && epoch != previousEpoch
+	tracker.epoch = epoch
+	tracker.isEpochKnown = true
+	if isEpochChanged {
+		tracker.epochChangeTimestamp = tracker.getCurrentTimestamp()
+		tracker.isEpochChangeSeen = true
+
+		log.Debug("epochTracker.Update: epoch changed", "tracker", tracker.name,
+			"previous epoch", previousEpoch, "epoch", epoch)
+	}
+
+	return previousEpoch, isEpochChanged, nil
+}
+
+// CurrentEpoch returns the last queried epoch and false if no epoch was queried yet
+func (tracker *epochTracker) CurrentEpoch() (uint32, bool) {
+	tracker.mutEpoch.RLock()
+	defer tracker.mutEpoch.RUnlock()
+
+	return tracker.epoch, tracker.isEpochKnown
+}
+
+// IsInGracePeriod returns true if the last epoch change was detected less than the grace period ago
+func (tracker *epochTracker) IsInGracePeriod() bool {
+	tracker.mutEpoch.RLock()
+	defer tracker.mutEpoch.RUnlock()
+
+	if !tracker.isEpochChangeSeen {
+		return false
+	}
+
+	return tracker.getCurrentTimestamp() < tracker.epochChangeTimestamp+tracker.gracePeriodInSeconds
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *epochTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
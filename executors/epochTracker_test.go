@@ -0,0 +1,137 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsEpochTracker() ArgsEpochTracker {
+	return ArgsEpochTracker{
+		Name:                 "test",
+		Querier:              &mock.EpochQuerierStub{},
+		GracePeriodInSeconds: 60,
+		GetCurrentTimestamp: func() int64 {
+			return 0
+		},
+	}
+}
+
+func TestNewEpochTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEpochTracker()
+		args.Querier = nil
+		tracker, err := NewEpochTracker(args)
+		assert.Nil(t, tracker)
+		assert.Equal(t, errNilEpochQuerier, err)
+	})
+	t.Run("nil timestamp handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEpochTracker()
+		args.GetCurrentTimestamp = nil
+		tracker, err := NewEpochTracker(args)
+		assert.Nil(t, tracker)
+		assert.Equal(t, errNilCurrentTimestampHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewEpochTracker(createMockArgsEpochTracker())
+		assert.NotNil(t, tracker)
+		assert.Nil(t, err)
+	})
+}
+
+func TestEpochTracker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *epochTracker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &epochTracker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestEpochTracker_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query error should return the error and keep the known epoch", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		queryErr := error(nil)
+		args := createMockArgsEpochTracker()
+		args.Querier = &mock.EpochQuerierStub{
+			GetEpochHandler: func(ctx context.Context) (uint32, error) {
+				return 5, queryErr
+			},
+		}
+		tracker, _ := NewEpochTracker(args)
+		_, _, _ = tracker.Update(context.Background())
+
+		queryErr = expectedErr
+		previousEpoch, isEpochChanged, err := tracker.Update(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, previousEpoch)
+		assert.False(t, isEpochChanged)
+
+		epoch, isKnown := tracker.CurrentEpoch()
+		assert.Equal(t, uint32(5), epoch)
+		assert.True(t, isKnown)
+	})
+	t.Run("should track the epoch changes and the grace period", func(t *testing.T) {
+		t.Parallel()
+
+		currentEpoch := uint32(5)
+		currentTimestamp := int64(1000)
+		args := createMockArgsEpochTracker()
+		args.Querier = &mock.EpochQuerierStub{
+			GetEpochHandler: func(ctx context.Context) (uint32, error) {
+				return currentEpoch, nil
+			},
+		}
+		args.GetCurrentTimestamp = func() int64 {
+			return currentTimestamp
+		}
+		tracker, _ := NewEpochTracker(args)
+
+		epoch, isKnown := tracker.CurrentEpoch()
+		assert.Zero(t, epoch)
+		assert.False(t, isKnown)
+
+		// the first queried epoch is not an epoch change
+		_, isEpochChanged, err := tracker.Update(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isEpochChanged)
+		assert.False(t, tracker.IsInGracePeriod())
+		epoch, isKnown = tracker.CurrentEpoch()
+		assert.Equal(t, uint32(5), epoch)
+		assert.True(t, isKnown)
+
+		_, isEpochChanged, _ = tracker.Update(context.Background())
+		assert.False(t, isEpochChanged)
+
+		currentEpoch = 6
+		previousEpoch, isEpochChanged, err := tracker.Update(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, isEpochChanged)
+		assert.Equal(t, uint32(5), previousEpoch)
+		assert.True(t, tracker.IsInGracePeriod())
+		epoch, _ = tracker.CurrentEpoch()
+		assert.Equal(t, uint32(6), epoch)
+
+		currentTimestamp = 1059
+		assert.True(t, tracker.IsInGracePeriod())
+
+		currentTimestamp = 1060
+		assert.False(t, tracker.IsInGracePeriod())
+	})
+}
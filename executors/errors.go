@@ -15,7 +15,42 @@ var (
 	errInvalidHour                   = errors.New("invalid hour")
 	errInvalidMinute                 = errors.New("invalid minute")
 	errInvalidTimeBetweenRetries     = errors.New("invalid time between retries")
-	errNotificationsSendingProblems  = errors.New("notification sending problems")
+	errInvalidMaxTimeBetweenRetries  = errors.New("invalid maximum time between retries")
+	errInvalidRetryJitter            = errors.New("invalid retry jitter")
+	errNotificationsDropped          = errors.New("notifications dropped")
+	errInvalidSendTimeout            = errors.New("invalid send timeout")
+	errInvalidQueueSize              = errors.New("invalid queue size")
+	errNotificationTimeout           = errors.New("notification sending timed out")
+	errContextClosing                = errors.New("context closing")
+	errNotificationAbandoned         = errors.New("notification abandoned while sending")
+	errNilRateLimiter                = errors.New("nil rate limiter")
+	errNilOutbox                     = errors.New("nil outbox")
+	errInvalidOutboxCheckInterval    = errors.New("invalid outbox check interval")
 	errNilCurrentTimestampHandler    = errors.New("nil current timestamp")
 	errNilBLSKeysFilter              = errors.New("nil BLS keys filter")
+	errNilNetworkAnomalyDetector     = errors.New("nil network anomaly detector")
+	errNilQuorumChecker              = errors.New("nil quorum checker")
+	errNilIdentitiesHolder           = errors.New("nil identities holder")
+	errNilIdentitiesLoader           = errors.New("nil identities loader")
+	errNilIdentitiesHandler          = errors.New("nil identities handler")
+	errEmptyListFile                 = errors.New("empty list file name")
+	errNilNodeStatusChecker          = errors.New("nil node status checker")
+	errNilHeartbeatChecker           = errors.New("nil heartbeat checker")
+	errNilAuctionChecker             = errors.New("nil auction checker")
+	errNilDelegationStateQuerier     = errors.New("nil delegation state querier")
+	errNilDelegationChecker          = errors.New("nil delegation checker")
+	errNoDelegationContracts         = errors.New("no delegation contracts to monitor")
+	errNilBalanceQuerier             = errors.New("nil balance querier")
+	errNilBalanceChecker             = errors.New("nil balance checker")
+	errNoAccounts                    = errors.New("no accounts to monitor")
+	errNilNetworkNonceQuerier        = errors.New("nil network nonce querier")
+	errNilNodeStatusQuerier          = errors.New("nil node status querier")
+	errNoNodes                       = errors.New("no nodes to monitor")
+	errInvalidRequestTimeout         = errors.New("invalid request timeout")
+	errNilEpochQuerier               = errors.New("nil epoch querier")
+	errNilEpochTracker               = errors.New("nil epoch tracker")
+	errNilEpochReporter              = errors.New("nil epoch reporter")
+	errNilShardChecker               = errors.New("nil shard checker")
+	errNilPeersRatingChecker         = errors.New("nil peers rating checker")
+	errNilThresholdsResolver         = errors.New("nil thresholds resolver")
 )
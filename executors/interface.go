@@ -2,6 +2,8 @@ package executors
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 )
@@ -18,9 +20,137 @@ type ValidatorStatisticsQuerier interface {
 	IsInterfaceNil() bool
 }
 
+// QuorumChecker is able to confirm the problematic BLS keys on additional validator statistics sources
+type QuorumChecker interface {
+	Check(ctx context.Context, problematicKeys []core.CheckResponse, extraBLSKeys []string) ([]core.CheckResponse, []core.SourcesDisagreement)
+	IsInterfaceNil() bool
+}
+
+// HeartbeatChecker is able to check the heartbeats of the monitored BLS keys
+type HeartbeatChecker interface {
+	Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error)
+	IsInterfaceNil() bool
+}
+
+// AuctionChecker is able to check the staking auction selection of the monitored BLS keys
+type AuctionChecker interface {
+	Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error)
+	IsInterfaceNil() bool
+}
+
+// PeersRatingChecker is able to compare the rating drops of the monitored BLS keys with the ones of their peers
+type PeersRatingChecker interface {
+	Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+	IsInterfaceNil() bool
+}
+
+// ThresholdsResolver is able to resolve the thresholds of the monitored BLS keys, including the ones found through the
+// owner addresses
+type ThresholdsResolver interface {
+	SetOwnersBLSKeys(ownersBLSKeys []core.OwnerBLSKeys)
+	IsInterfaceNil() bool
+}
+
+// ShardChecker is able to track the shards of the monitored BLS keys. It returns the BLS keys that changed their shard
+// and the current shard of each monitored BLS key
+type ShardChecker interface {
+	Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.ShardChange, map[string]uint32)
+	IsInterfaceNil() bool
+}
+
+// EpochQuerier is able to query the current epoch
+type EpochQuerier interface {
+	GetEpoch(ctx context.Context) (uint32, error)
+	IsInterfaceNil() bool
+}
+
+// EpochTracker is able to track the current epoch. Update returns the previous epoch and true if the epoch changed
+type EpochTracker interface {
+	Update(ctx context.Context) (uint32, bool, error)
+	CurrentEpoch() (uint32, bool)
+	IsInGracePeriod() bool
+	IsInterfaceNil() bool
+}
+
+// EpochReporter is able to create the end of epoch report of the monitored BLS keys
+type EpochReporter interface {
+	Report(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+	IsInterfaceNil() bool
+}
+
 // BLSKeysFetcher is able to get all staked BLS keys of an identity
 type BLSKeysFetcher interface {
-	GetAllBLSKeys(ctx context.Context, sender string) ([]string, error)
+	GetAllBLSKeys(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error)
+	IsInterfaceNil() bool
+}
+
+// NodeStatusQuerier is able to query the status of a node
+type NodeStatusQuerier interface {
+	GetNodeStatus(ctx context.Context) (*core.NodeStatus, error)
+	IsInterfaceNil() bool
+}
+
+// NetworkNonceQuerier is able to query the current nonce of a shard
+type NetworkNonceQuerier interface {
+	GetNetworkNonce(ctx context.Context, shardID uint32) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// NodeStatusChecker is able to check the status of a node. It returns the problems encountered
+type NodeStatusChecker interface {
+	Check(status *core.NodeStatus, networkNonce uint64) []string
+	IsInterfaceNil() bool
+}
+
+// DelegationStateQuerier is able to query the state of a delegation contract
+type DelegationStateQuerier interface {
+	GetState(ctx context.Context, contract core.Address) (*core.DelegationContractState, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationChecker is able to check the state of a delegation contract. It returns the problems of the current state
+// and the changes compared with the previous state
+type DelegationChecker interface {
+	Check(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string)
+	IsInterfaceNil() bool
+}
+
+// BalanceQuerier is able to query the balance of an account. The EGLD balance is returned if the token is empty
+type BalanceQuerier interface {
+	GetBalance(ctx context.Context, address string, token string) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// BalanceChecker is able to check the balance of an account. It returns the problems of the current balance and the
+// changes compared with the previous balance
+type BalanceChecker interface {
+	Check(previous *big.Int, current *big.Int) ([]string, []string)
+	IsInterfaceNil() bool
+}
+
+// NetworkAnomalyDetector is able to tell if the network, as a whole, is degrading. It returns the percentage of all
+// validators that are degraded and true if this is a network-wide event
+type NetworkAnomalyDetector interface {
+	Detect(statistics map[string]*core.ValidatorStatistics) (float64, bool)
+	IsInterfaceNil() bool
+}
+
+// IdentitiesParser defines the behavior of a component able to parse a file containing the monitored identities
+type IdentitiesParser interface {
+	ParseFile(filename string) (*core.IdentitiesHolder, error)
+	IsInterfaceNil() bool
+}
+
+// IdentitiesLoader defines the behavior of a component able to load the monitored identities from all the configured
+// sources
+type IdentitiesLoader interface {
+	LoadIdentities() (*core.IdentitiesHolder, error)
+	IsInterfaceNil() bool
+}
+
+// IdentitiesHandler defines the behavior of a component that can replace, at runtime, the monitored identities
+type IdentitiesHandler interface {
+	SetIdentities(identities *core.IdentitiesHolder) error
 	IsInterfaceNil() bool
 }
 
@@ -29,6 +159,8 @@ type StatusHandler interface {
 	NotifyAppStart()
 	ErrorEncountered(err error)
 	CollectKeysProblems(messages []core.OutputMessage)
+	CollectKeysShards(monitorName string, shards map[string]uint32)
+	AddEndpointsProvider(monitorName string, provider core.EndpointsProvider)
 	Execute(ctx context.Context) error
 	SendCloseMessage()
 	IsInterfaceNil() bool
@@ -43,13 +175,33 @@ type BLSKeysFilter interface {
 // OutputNotifier defines the operations supported by an output notifier instance
 type OutputNotifier interface {
 	OutputMessages(messages ...core.OutputMessage) error
+	FitsInOnePayload(messages ...core.OutputMessage) bool
 	Name() string
 	IsInterfaceNil() bool
 }
 
+// NotificationsOutbox defines the operations of a component able to persist the notifications that could not be delivered
+type NotificationsOutbox interface {
+	Add(notifierID string, caller string, messages []core.OutputMessage) error
+	GetDueEntries(notifierID string) []core.OutboxEntry
+	MarkDelivered(notifierID string, entryID uint64) error
+	MarkFailed(notifierID string, entryID uint64) error
+	NumEntries(notifierID string) int
+	IsInterfaceNil() bool
+}
+
+// RateLimiter defines the operations of a component able to limit the rate of the sent notifications
+type RateLimiter interface {
+	Reserve() time.Duration
+	Refund()
+	IsInterfaceNil() bool
+}
+
 // OutputNotifiersHandler defines the behavior of a component that is able to notify all notifiers
 type OutputNotifiersHandler interface {
 	NotifyWithRetry(caller string, messages ...core.OutputMessage) error
+	NotifyWithoutOutbox(caller string, messages ...core.OutputMessage) error
+	GetMetrics() []core.NotifierMetrics
 	IsInterfaceNil() bool
 }
 
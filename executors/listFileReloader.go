@@ -0,0 +1,343 @@
+package executors
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const listFileReloaderName = "listFileReloader"
+const maxIdentitiesListedOnReload = 10
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+}
+
+type listFileReloader struct {
+	listFiles              []string
+	watchedFiles           []string
+	loader                 IdentitiesLoader
+	identitiesHandlers     []IdentitiesHandler
+	outputNotifiersHandler OutputNotifiersHandler
+	name                   string
+	currentIdentities      map[string]struct{}
+	currentHolder          *core.IdentitiesHolder
+	filesStates            map[string]*fileState
+}
+
+// ArgsListFileReloader defines the DTO struct for the NewListFileReloader constructor function
+type ArgsListFileReloader struct {
+	ListFiles              []string
+	Loader                 IdentitiesLoader
+	IdentitiesHandlers     []IdentitiesHandler
+	OutputNotifiersHandler OutputNotifiersHandler
+	InitialIdentities      *core.IdentitiesHolder
+	Name                   string
+}
+
+// NewListFileReloader creates a new instance of type listFileReloader. On each Execute call, the list files, the files
+// included by them and the PEM files and directories are checked for changes and, if any of them changed, all the
+// identities are loaded again and, if valid, set on all provided handlers. The identities handlers are called in the
+// provided order, so the handlers that can reject the identities should be the first ones. If a handler rejects the
+// identities, the handlers already updated are set back to the previous identities so all of them keep using the same
+// list
+func NewListFileReloader(args ArgsListFileReloader) (*listFileReloader, error) {
+	if len(args.ListFiles) == 0 {
+		return nil, errEmptyListFile
+	}
+	for index, listFile := range args.ListFiles {
+		if len(listFile) == 0 {
+			return nil, fmt.Errorf("%w at index %d", errEmptyListFile, index)
+		}
+	}
+	if check.IfNil(args.Loader) {
+		return nil, errNilIdentitiesLoader
+	}
+	if check.IfNil(args.OutputNotifiersHandler) {
+		return nil, errNilOutputNotifiersHandler
+	}
+	if args.InitialIdentities == nil {
+		return nil, errNilIdentitiesHolder
+	}
+	for index, handler := range args.IdentitiesHandlers {
+		if check.IfNil(handler) {
+			return nil, fmt.Errorf("%w at index %d", errNilIdentitiesHandler, index)
+		}
+	}
+
+	reloader := &listFileReloader{
+		listFiles:              args.ListFiles,
+		loader:                 args.Loader,
+		identitiesHandlers:     args.IdentitiesHandlers,
+		outputNotifiersHandler: args.OutputNotifiersHandler,
+		name:                   args.Name,
+		currentIdentities:      createIdentitiesSet(args.InitialIdentities),
+		currentHolder:          args.InitialIdentities,
+		filesStates:            make(map[string]*fileState, len(args.ListFiles)),
+	}
+	reloader.watchedFiles = reloader.createWatchedFiles(args.InitialIdentities)
+
+	_, err := reloader.filesChanged()
+	if err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// Execute checks if the list files changed and reloads them
+func (reloader *listFileReloader) Execute(_ context.Context) error {
+	changedFiles, err := reloader.filesChanged()
+	if err != nil {
+		// the file might be missing for a short time while being replaced by an editor
+		log.Debug("listFileReloader.Execute", "monitor", reloader.name, "error", err)
+		return nil
+	}
+	if len(changedFiles) == 0 {
+		return nil
+	}
+
+	listFiles := strings.Join(changedFiles, ", ")
+	identities, err := reloader.loader.LoadIdentities()
+	if err == nil {
+		err = reloader.setIdentities(identities)
+	}
+	if err != nil {
+		log.Error("invalid list file, the previous list is kept", "monitor", reloader.name,
+			"file", listFiles, "error", err)
+		reloader.notify(core.OutputMessage{
+			Type:               core.WarningMessageOutputType,
+			ShortIdentifier:    fmt.Sprintf("list file %s of monitor %s is invalid, the previous list is kept", listFiles, reloader.name),
+			ExecutorName:       reloader.name,
+			ProblemEncountered: err.Error(),
+		})
+
+		return nil
+	}
+
+	// the included files might have changed, the states of the newly watched files are recorded
+	reloader.watchedFiles = reloader.createWatchedFiles(identities)
+	_, err = reloader.filesChanged()
+	if err != nil {
+		log.Debug("listFileReloader.Execute", "monitor", reloader.name, "error", err)
+	}
+
+	newIdentities := createIdentitiesSet(identities)
+	added := difference(newIdentities, reloader.currentIdentities)
+	removed := difference(reloader.currentIdentities, newIdentities)
+	reloader.currentIdentities = newIdentities
+	reloader.currentHolder = identities
+
+	log.Info("list file reloaded", "monitor", reloader.name, "file", listFiles,
+		"num added", len(added), "num removed", len(removed))
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	reloader.notify(core.OutputMessage{
+		Type: core.InfoMessageOutputType,
+		ShortIdentifier: fmt.Sprintf("list file %s of monitor %s reloaded: %d identities added, %d removed",
+			listFiles, reloader.name, len(added), len(removed)),
+		ExecutorName:       reloader.name,
+		ProblemEncountered: createReloadSummary(added, removed),
+	})
+
+	return nil
+}
+
+// createWatchedFiles returns the list files followed by all the other source files of the identities
+func (reloader *listFileReloader) createWatchedFiles(identities *core.IdentitiesHolder) []string {
+	watchedFiles := make([]string, 0, len(reloader.listFiles)+len(identities.SourceFiles))
+	existing := make(map[string]struct{})
+	for _, files := range [][]string{reloader.listFiles, identities.SourceFiles} {
+		for _, file := range files {
+			cleanPath := filepath.Clean(file)
+			_, found := existing[cleanPath]
+			if found {
+				continue
+			}
+
+			existing[cleanPath] = struct{}{}
+			watchedFiles = append(watchedFiles, file)
+		}
+	}
+
+	return watchedFiles
+}
+
+// filesChanged returns the watched files with the content changed since the last call. The files states are updated
+// only if all the files could be read
+func (reloader *listFileReloader) filesChanged() ([]string, error) {
+	changedFiles := make([]string, 0)
+	newStates := make(map[string]*fileState, len(reloader.watchedFiles))
+	for _, listFile := range reloader.watchedFiles {
+		state, changed, err := reloader.fileChanged(listFile)
+		if err != nil {
+			return nil, err
+		}
+
+		newStates[listFile] = state
+		if changed {
+			changedFiles = append(changedFiles, listFile)
+		}
+	}
+
+	reloader.filesStates = newStates
+
+	return changedFiles, nil
+}
+
+// fileChanged returns true if the content of the file changed since the last call. The content is checked only if
+// the modification time or the size changed. The content of a directory is always checked, as its modification time
+// does not change when one of its files is modified
+func (reloader *listFileReloader) fileChanged(listFile string) (*fileState, bool, error) {
+	info, err := os.Stat(listFile)
+	if err != nil {
+		return nil, false, err
+	}
+
+	oldState, found := reloader.filesStates[listFile]
+	if !found {
+		oldState = &fileState{}
+	}
+	if !info.IsDir() && info.ModTime().Equal(oldState.modTime) && info.Size() == oldState.size {
+		return oldState, false, nil
+	}
+
+	data, err := readContent(listFile, info)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newState := &fileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		hash:    sha256.Sum256(data),
+	}
+
+	return newState, newState.hash != oldState.hash, nil
+}
+
+// readContent returns the content of the file or, for a directory, the names, sizes and modification times of its
+// entries, so adding, removing or modifying a file in the directory is detected
+func readContent(path string, info os.FileInfo) ([]byte, error) {
+	if !info.IsDir() {
+		return os.ReadFile(path)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content := bytes.Buffer{}
+	for _, entry := range entries {
+		entryInfo, errInfo := entry.Info()
+		if errInfo != nil {
+			return nil, errInfo
+		}
+
+		_, _ = fmt.Fprintf(&content, "%s %d %d\n", entry.Name(), entryInfo.Size(), entryInfo.ModTime().UnixNano())
+	}
+
+	return content.Bytes(), nil
+}
+
+// setIdentities sets the identities on all handlers. If one of them rejects the identities, the handlers already
+// updated are rolled back to the current identities
+func (reloader *listFileReloader) setIdentities(identities *core.IdentitiesHolder) error {
+	for index, handler := range reloader.identitiesHandlers {
+		err := handler.SetIdentities(identities)
+		if err != nil {
+			reloader.rollback(reloader.identitiesHandlers[:index])
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (reloader *listFileReloader) rollback(updatedHandlers []IdentitiesHandler) {
+	for _, handler := range updatedHandlers {
+		// the current identities were already accepted by all handlers
+		err := handler.SetIdentities(reloader.currentHolder)
+		if err != nil {
+			log.Error("can not restore the previous list", "monitor", reloader.name, "handler", fmt.Sprintf("%T", handler),
+				"error", err)
+		}
+	}
+}
+
+func (reloader *listFileReloader) notify(message core.OutputMessage) {
+	err := reloader.outputNotifiersHandler.NotifyWithRetry(listFileReloaderName, message)
+	if err != nil {
+		log.Debug("listFileReloader.notify", "monitor", reloader.name, "error", err)
+	}
+}
+
+func createIdentitiesSet(identities *core.IdentitiesHolder) map[string]struct{} {
+	result := make(map[string]struct{}, len(identities.BlsHexKeys)+len(identities.Addresses))
+	for _, blsKey := range identities.BlsHexKeys {
+		result[blsKey] = struct{}{}
+	}
+	for _, address := range identities.Addresses {
+		result[address.Bech32] = struct{}{}
+	}
+
+	return result
+}
+
+func difference(set map[string]struct{}, other map[string]struct{}) []string {
+	result := make([]string, 0)
+	for identity := range set {
+		_, found := other[identity]
+		if !found {
+			result = append(result, identity)
+		}
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func createReloadSummary(added []string, removed []string) string {
+	parts := make([]string, 0, 2)
+	if len(added) > 0 {
+		parts = append(parts, "added: "+listIdentities(added))
+	}
+	if len(removed) > 0 {
+		parts = append(parts, "removed: "+listIdentities(removed))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func listIdentities(identities []string) string {
+	shortIdentities := make([]string, 0, maxIdentitiesListedOnReload+1)
+	for index, identity := range identities {
+		if index == maxIdentitiesListedOnReload {
+			shortIdentities = append(shortIdentities, fmt.Sprintf("and %d more", len(identities)-maxIdentitiesListedOnReload))
+			break
+		}
+
+		shortIdentities = append(shortIdentities, shortIdentifier(identity))
+	}
+
+	return strings.Join(shortIdentities, ", ")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *listFileReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
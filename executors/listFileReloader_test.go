@@ -0,0 +1,564 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestListFile(t *testing.T, content string) string {
+	listFile := filepath.Join(t.TempDir(), "test.list")
+	err := os.WriteFile(listFile, []byte(content), os.ModePerm)
+	assert.Nil(t, err)
+
+	return listFile
+}
+
+func changeTestListFile(t *testing.T, listFile string, content string) {
+	err := os.WriteFile(listFile, []byte(content), os.ModePerm)
+	assert.Nil(t, err)
+
+	// make sure the modification time changes even on file systems with a coarse resolution
+	newTime := time.Now().Add(time.Minute)
+	err = os.Chtimes(listFile, newTime, newTime)
+	assert.Nil(t, err)
+}
+
+func createTestArgsListFileReloader(listFile string) ArgsListFileReloader {
+	return ArgsListFileReloader{
+		ListFiles:              []string{listFile},
+		Loader:                 &mock.IdentitiesLoaderStub{},
+		IdentitiesHandlers:     []IdentitiesHandler{&mock.IdentitiesHandlerStub{}},
+		OutputNotifiersHandler: &mock.OutputNotifiersHandlerStub{},
+		InitialIdentities:      &core.IdentitiesHolder{},
+		Name:                   "test",
+	}
+}
+
+func TestNewListFileReloader(t *testing.T) {
+	t.Parallel()
+
+	listFile := createTestListFile(t, "bls1")
+
+	t.Run("no list files should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.ListFiles = nil
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.Equal(t, errEmptyListFile, err)
+	})
+	t.Run("empty list file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.ListFiles = append(args.ListFiles, "")
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.ErrorIs(t, err, errEmptyListFile)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("nil loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.Loader = nil
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.Equal(t, errNilIdentitiesLoader, err)
+	})
+	t.Run("nil output notifiers handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.OutputNotifiersHandler = nil
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.Equal(t, errNilOutputNotifiersHandler, err)
+	})
+	t.Run("nil initial identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.InitialIdentities = nil
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("nil identities handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		args.IdentitiesHandlers = append(args.IdentitiesHandlers, nil)
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.ErrorIs(t, err, errNilIdentitiesHandler)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(filepath.Join(t.TempDir(), "missing.list"))
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, reloader)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListFileReloader(listFile)
+		reloader, err := NewListFileReloader(args)
+		assert.NotNil(t, reloader)
+		assert.Nil(t, err)
+	})
+}
+
+func TestListFileReloader_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *listFileReloader
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &listFileReloader{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestListFileReloader_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged file should not reload", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "bls1")
+		args := createTestArgsListFileReloader(listFile)
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				assert.Fail(t, "should have not loaded the identities")
+				return nil, nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+
+		// same content, only the modification time changed
+		changeTestListFile(t, listFile, "bls1")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("missing file should keep the old list", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "bls1")
+		args := createTestArgsListFileReloader(listFile)
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				assert.Fail(t, "should have not loaded the identities")
+				return nil, nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		err := os.Remove(listFile)
+		assert.Nil(t, err)
+
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("changed file should reload and notify the summary", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "initial content")
+		newIdentities := &core.IdentitiesHolder{
+			BlsHexKeys: []string{"bls1", "bls3"},
+			Addresses: []core.Address{
+				{Bech32: "erd1newaddressnewaddress"},
+			},
+		}
+
+		args := createTestArgsListFileReloader(listFile)
+		args.InitialIdentities = &core.IdentitiesHolder{
+			BlsHexKeys: []string{"bls1", "bls2"},
+			Addresses: []core.Address{
+				{Bech32: "erd1oldaddressoldaddress"},
+			},
+		}
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				return newIdentities, nil
+			},
+		}
+		handlersOrder := make([]string, 0)
+		args.IdentitiesHandlers = []IdentitiesHandler{
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					assert.Equal(t, newIdentities, identities)
+					handlersOrder = append(handlersOrder, "handler1")
+					return nil
+				},
+			},
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					assert.Equal(t, newIdentities, identities)
+					handlersOrder = append(handlersOrder, "handler2")
+					return nil
+				},
+			},
+		}
+		var sentMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Equal(t, listFileReloaderName, caller)
+				sentMessages = append(sentMessages, messages...)
+				return nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, listFile, "new content")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"handler1", "handler2"}, handlersOrder)
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:               core.InfoMessageOutputType,
+				ShortIdentifier:    fmt.Sprintf("list file %s of monitor test reloaded: 2 identities added, 2 removed", listFile),
+				ExecutorName:       "test",
+				ProblemEncountered: "added: bls3, erd1ne...ddress; removed: bls2, erd1ol...ddress",
+			},
+		}
+		assert.Equal(t, expectedMessages, sentMessages)
+
+		// a new execution without changes should not notify again
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(sentMessages))
+	})
+	t.Run("changed file with the same identities should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "initial content")
+		identities := &core.IdentitiesHolder{
+			BlsHexKeys: []string{"bls1"},
+		}
+
+		args := createTestArgsListFileReloader(listFile)
+		args.InitialIdentities = identities
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				return identities, nil
+			},
+		}
+		numSetCalls := 0
+		args.IdentitiesHandlers = []IdentitiesHandler{
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					numSetCalls++
+					return nil
+				},
+			},
+		}
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not notified")
+				return nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, listFile, "bls1 new-label")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numSetCalls) // the labels might have changed
+	})
+	t.Run("invalid file should keep the old list", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "initial content")
+		expectedErr := errors.New("expected error")
+
+		args := createTestArgsListFileReloader(listFile)
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				return nil, expectedErr
+			},
+		}
+		args.IdentitiesHandlers = []IdentitiesHandler{
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					assert.Fail(t, "should have not set the identities")
+					return nil
+				},
+			},
+		}
+		var sentMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				sentMessages = append(sentMessages, messages...)
+				return nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, listFile, "invalid content")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+
+		expectedMessages := []core.OutputMessage{
+			{
+				Type:               core.WarningMessageOutputType,
+				ShortIdentifier:    fmt.Sprintf("list file %s of monitor test is invalid, the previous list is kept", listFile),
+				ExecutorName:       "test",
+				ProblemEncountered: expectedErr.Error(),
+			},
+		}
+		assert.Equal(t, expectedMessages, sentMessages)
+
+		// the same invalid content should not be notified again
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(sentMessages))
+	})
+	t.Run("any changed file should reload all identities", func(t *testing.T) {
+		t.Parallel()
+
+		listFile1 := createTestListFile(t, "content 1")
+		listFile2 := createTestListFile(t, "content 2")
+
+		args := createTestArgsListFileReloader(listFile1)
+		args.ListFiles = []string{listFile1, listFile2}
+		numLoadCalls := 0
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				numLoadCalls++
+				return &core.IdentitiesHolder{
+					BlsHexKeys: []string{fmt.Sprintf("bls%d", numLoadCalls)},
+				}, nil
+			},
+		}
+		var sentMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				sentMessages = append(sentMessages, messages...)
+				return nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, listFile2, "new content 2")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numLoadCalls)
+
+		changeTestListFile(t, listFile1, "new content 1")
+		changeTestListFile(t, listFile2, "newer content 2")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numLoadCalls)
+
+		assert.Equal(t, 2, len(sentMessages))
+		assert.Equal(t, fmt.Sprintf("list file %s of monitor test reloaded: 1 identities added, 0 removed", listFile2),
+			sentMessages[0].ShortIdentifier)
+		assert.Equal(t, fmt.Sprintf("list file %s, %s of monitor test reloaded: 1 identities added, 1 removed", listFile1, listFile2),
+			sentMessages[1].ShortIdentifier)
+	})
+	t.Run("changed included file should reload", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "include included.list")
+		includedFile := filepath.Join(filepath.Dir(listFile), "included.list")
+		newIncludedFile := filepath.Join(filepath.Dir(listFile), "newIncluded.list")
+		err := os.WriteFile(includedFile, []byte("bls1"), os.ModePerm)
+		assert.Nil(t, err)
+		err = os.WriteFile(newIncludedFile, []byte("bls2"), os.ModePerm)
+		assert.Nil(t, err)
+
+		args := createTestArgsListFileReloader(listFile)
+		args.InitialIdentities = &core.IdentitiesHolder{
+			BlsHexKeys:  []string{"bls1"},
+			SourceFiles: []string{listFile, includedFile},
+		}
+		numLoadCalls := 0
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				numLoadCalls++
+				return &core.IdentitiesHolder{
+					BlsHexKeys:  []string{"bls2"},
+					SourceFiles: []string{listFile, newIncludedFile},
+				}, nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, includedFile, "bls3")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numLoadCalls)
+
+		// the old included file is no longer watched
+		changeTestListFile(t, includedFile, "bls4")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numLoadCalls)
+
+		changeTestListFile(t, newIncludedFile, "bls5")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numLoadCalls)
+	})
+	t.Run("changed PEM directory should reload", func(t *testing.T) {
+		t.Parallel()
+
+		pemDir := t.TempDir()
+		pemFile := filepath.Join(pemDir, "node1.pem")
+		err := os.WriteFile(pemFile, []byte("key1"), os.ModePerm)
+		assert.Nil(t, err)
+
+		args := createTestArgsListFileReloader(pemDir)
+		numLoadCalls := 0
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				numLoadCalls++
+				return &core.IdentitiesHolder{}, nil
+			},
+		}
+		reloader, err := NewListFileReloader(args)
+		assert.Nil(t, err)
+
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, numLoadCalls)
+
+		changeTestListFile(t, pemFile, "key2")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numLoadCalls)
+
+		err = os.WriteFile(filepath.Join(pemDir, "node2.pem"), []byte("key3"), os.ModePerm)
+		assert.Nil(t, err)
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numLoadCalls)
+
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numLoadCalls)
+	})
+	t.Run("rejected identities should stop the reload", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "initial content")
+		expectedErr := errors.New("expected error")
+
+		args := createTestArgsListFileReloader(listFile)
+		args.IdentitiesHandlers = []IdentitiesHandler{
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					return expectedErr
+				},
+			},
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					assert.Fail(t, "should have not set the identities")
+					return nil
+				},
+			},
+		}
+		var sentMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				sentMessages = append(sentMessages, messages...)
+				return nil
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		changeTestListFile(t, listFile, "new content")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(sentMessages))
+		assert.Equal(t, core.WarningMessageOutputType, sentMessages[0].Type)
+		assert.Equal(t, expectedErr.Error(), sentMessages[0].ProblemEncountered)
+	})
+	t.Run("identities rejected by a later handler should roll back the updated handlers", func(t *testing.T) {
+		t.Parallel()
+
+		listFile := createTestListFile(t, "initial content")
+		initialIdentities := &core.IdentitiesHolder{BlsHexKeys: []string{"key1"}}
+		validIdentities := &core.IdentitiesHolder{BlsHexKeys: []string{"key2"}}
+		invalidIdentities := &core.IdentitiesHolder{BlsHexKeys: []string{"key3"}}
+		expectedErr := errors.New("expected error")
+
+		args := createTestArgsListFileReloader(listFile)
+		args.InitialIdentities = initialIdentities
+		identitiesToLoad := validIdentities
+		args.Loader = &mock.IdentitiesLoaderStub{
+			LoadIdentitiesHandler: func() (*core.IdentitiesHolder, error) {
+				return identitiesToLoad, nil
+			},
+		}
+		var firstHandlerIdentities []*core.IdentitiesHolder
+		args.IdentitiesHandlers = []IdentitiesHandler{
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					firstHandlerIdentities = append(firstHandlerIdentities, identities)
+					return nil
+				},
+			},
+			&mock.IdentitiesHandlerStub{
+				SetIdentitiesHandler: func(identities *core.IdentitiesHolder) error {
+					if identities == invalidIdentities {
+						return expectedErr
+					}
+					return nil
+				},
+			},
+		}
+		reloader, _ := NewListFileReloader(args)
+
+		// the rejected identities are replaced with the initial ones
+		identitiesToLoad = invalidIdentities
+		changeTestListFile(t, listFile, "invalid content")
+		err := reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.IdentitiesHolder{invalidIdentities, initialIdentities}, firstHandlerIdentities)
+
+		// the rejected identities are replaced with the last accepted ones
+		firstHandlerIdentities = nil
+		identitiesToLoad = validIdentities
+		changeTestListFile(t, listFile, "valid content")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+
+		identitiesToLoad = invalidIdentities
+		changeTestListFile(t, listFile, "invalid content again")
+		err = reloader.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.IdentitiesHolder{validIdentities, invalidIdentities, validIdentities}, firstHandlerIdentities)
+	})
+}
+
+func TestCreateReloadSummary(t *testing.T) {
+	t.Parallel()
+
+	identities := make([]string, 0, maxIdentitiesListedOnReload+2)
+	for i := 0; i < maxIdentitiesListedOnReload+2; i++ {
+		identities = append(identities, fmt.Sprintf("bls%d", i))
+	}
+
+	assert.Equal(t, "", createReloadSummary(nil, nil))
+	assert.Equal(t, "removed: bls0", createReloadSummary(nil, identities[:1]))
+	assert.Equal(t, "added: bls0, bls1, bls2, bls3, bls4, bls5, bls6, bls7, bls8, bls9, and 2 more",
+		createReloadSummary(identities, nil))
+}
@@ -0,0 +1,171 @@
+package executors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const nodeIdentifierType = "Node"
+const nodesExecutorName = "nodesExecutor"
+
+// MonitoredNode holds the name of a monitored node and the component able to query its status
+type MonitoredNode struct {
+	Name    string
+	Querier NodeStatusQuerier
+}
+
+type nodesExecutor struct {
+	outputNotifiersHandler OutputNotifiersHandler
+	statusHandler          StatusHandler
+	nodesFilter            BLSKeysFilter
+	nodeStatusChecker      NodeStatusChecker
+	networkNonceQuerier    NetworkNonceQuerier
+	nodes                  []MonitoredNode
+	requestTimeout         time.Duration
+	name                   string
+}
+
+// ArgsNodesExecutor defines the DTO struct for the NewNodesExecutor constructor function
+type ArgsNodesExecutor struct {
+	OutputNotifiersHandler OutputNotifiersHandler
+	StatusHandler          StatusHandler
+	NodesFilter            BLSKeysFilter
+	NodeStatusChecker      NodeStatusChecker
+	NetworkNonceQuerier    NetworkNonceQuerier
+	Nodes                  []MonitoredNode
+	RequestTimeout         time.Duration
+	Name                   string
+}
+
+// NewNodesExecutor creates a new instance of type nodesExecutor
+func NewNodesExecutor(args ArgsNodesExecutor) (*nodesExecutor, error) {
+	if check.IfNil(args.OutputNotifiersHandler) {
+		return nil, errNilOutputNotifiersHandler
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, errNilStatusHandler
+	}
+	if check.IfNil(args.NodesFilter) {
+		return nil, errNilBLSKeysFilter
+	}
+	if check.IfNil(args.NodeStatusChecker) {
+		return nil, errNilNodeStatusChecker
+	}
+	if check.IfNil(args.NetworkNonceQuerier) {
+		return nil, errNilNetworkNonceQuerier
+	}
+	if len(args.Nodes) == 0 {
+		return nil, errNoNodes
+	}
+	for index, node := range args.Nodes {
+		if check.IfNil(node.Querier) {
+			return nil, fmt.Errorf("%w for node %s at index %d", errNilNodeStatusQuerier, node.Name, index)
+		}
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, errInvalidRequestTimeout
+	}
+
+	return &nodesExecutor{
+		outputNotifiersHandler: args.OutputNotifiersHandler,
+		statusHandler:          args.StatusHandler,
+		nodesFilter:            args.NodesFilter,
+		nodeStatusChecker:      args.NodeStatusChecker,
+		networkNonceQuerier:    args.NetworkNonceQuerier,
+		nodes:                  args.Nodes,
+		requestTimeout:         args.RequestTimeout,
+		name:                   args.Name,
+	}, nil
+}
+
+// Execute executes one checking cycle
+func (executor *nodesExecutor) Execute(ctx context.Context) error {
+	log.Debug("executing query-check-notify cycle", "executor", executor.name)
+
+	// the network nonce is queried only once for each shard during a cycle
+	networkNonces := make(map[uint32]uint64)
+	messages := make([]core.OutputMessage, 0)
+	for _, node := range executor.nodes {
+		problems, epoch := executor.checkNode(ctx, node, networkNonces)
+		if len(problems) == 0 || !executor.nodesFilter.ShouldNotify(node.Name) {
+			continue
+		}
+
+		messages = append(messages, core.OutputMessage{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     nodeIdentifierType,
+			Identifier:         node.Name,
+			ShortIdentifier:    node.Name,
+			ExecutorName:       executor.name,
+			ProblemEncountered: strings.Join(problems, "; "),
+			Epoch:              epoch,
+		})
+	}
+
+	if len(messages) == 0 {
+		log.Debug("all nodes are performing normally", "executor", executor.name)
+		return nil
+	}
+
+	executor.statusHandler.CollectKeysProblems(messages)
+
+	err := executor.outputNotifiersHandler.NotifyWithRetry(nodesExecutorName, messages...)
+	if err != nil {
+		log.Debug("nodesExecutor.Execute", "executor", executor.name, "error notifying", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+	}
+
+	return err
+}
+
+// checkNode returns the problems of the node and the epoch reported by the node, nil if the node can not be queried
+func (executor *nodesExecutor) checkNode(ctx context.Context, node MonitoredNode, networkNonces map[uint32]uint64) ([]string, *uint32) {
+	requestCtx, cancel := context.WithTimeout(ctx, executor.requestTimeout)
+	status, err := node.Querier.GetNodeStatus(requestCtx)
+	cancel()
+	if err != nil {
+		log.Debug("nodesExecutor.checkNode", "executor", executor.name, "node", node.Name, "error", err.Error())
+		return []string{fmt.Sprintf("Node status can not be queried: %s", err.Error())}, nil
+	}
+
+	networkNonce, found := networkNonces[status.ShardID]
+	if !found {
+		networkNonce = executor.getNetworkNonce(ctx, status.ShardID)
+		networkNonces[status.ShardID] = networkNonce
+	}
+
+	// the node's probable highest nonce is used when the network nonce could not be fetched or is behind it
+	referenceNonce := status.ProbableHighestNonce
+	if networkNonce > referenceNonce {
+		referenceNonce = networkNonce
+	}
+
+	epoch := status.EpochNumber
+
+	return executor.nodeStatusChecker.Check(status, referenceNonce), &epoch
+}
+
+func (executor *nodesExecutor) getNetworkNonce(ctx context.Context, shardID uint32) uint64 {
+	requestCtx, cancel := context.WithTimeout(ctx, executor.requestTimeout)
+	defer cancel()
+
+	nonce, err := executor.networkNonceQuerier.GetNetworkNonce(requestCtx, shardID)
+	if err != nil {
+		log.Debug("nodesExecutor.getNetworkNonce", "executor", executor.name, "shard", shardID, "error", err.Error())
+		executor.statusHandler.ErrorEncountered(err)
+
+		return 0
+	}
+
+	return nonce
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *nodesExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
@@ -0,0 +1,341 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsNodesExecutor() ArgsNodesExecutor {
+	return ArgsNodesExecutor{
+		OutputNotifiersHandler: &mock.OutputNotifiersHandlerStub{},
+		StatusHandler:          &mock.StatusHandlerStub{},
+		NodesFilter:            &mock.BLSKeysFilterStub{},
+		NodeStatusChecker:      &mock.NodeStatusCheckerStub{},
+		NetworkNonceQuerier:    &mock.NetworkNonceQuerierStub{},
+		Nodes: []MonitoredNode{
+			{
+				Name:    "node-0",
+				Querier: &mock.NodeStatusQuerierStub{},
+			},
+		},
+		RequestTimeout: time.Second,
+		Name:           "test",
+	}
+}
+
+func TestNewNodesExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil output notifiers handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.OutputNotifiersHandler = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilOutputNotifiersHandler, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.StatusHandler = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil nodes filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.NodesFilter = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilBLSKeysFilter, err)
+	})
+	t.Run("nil node status checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.NodeStatusChecker = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilNodeStatusChecker, err)
+	})
+	t.Run("nil network nonce querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.NetworkNonceQuerier = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilNetworkNonceQuerier, err)
+	})
+	t.Run("no nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.Nodes = nil
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNoNodes, err)
+	})
+	t.Run("nil node status querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.Nodes = append(args.Nodes, MonitoredNode{Name: "node-1"})
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errNilNodeStatusQuerier)
+		assert.Contains(t, err.Error(), "for node node-1 at index 1")
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.RequestTimeout = 0
+		executor, err := NewNodesExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errInvalidRequestTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewNodesExecutor(createMockArgsNodesExecutor())
+		assert.NotNil(t, executor)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodesExecutor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *nodesExecutor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &nodesExecutor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestNodesExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all nodes performing normally should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("should notify the problematic nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.Nodes = []MonitoredNode{
+			{
+				Name: "node-0",
+				Querier: &mock.NodeStatusQuerierStub{
+					GetNodeStatusHandler: func(ctx context.Context) (*core.NodeStatus, error) {
+						return &core.NodeStatus{Nonce: 90, ProbableHighestNonce: 100, ShardID: 1, EpochNumber: 37}, nil
+					},
+				},
+			},
+			{
+				Name: "node-1",
+				Querier: &mock.NodeStatusQuerierStub{
+					GetNodeStatusHandler: func(ctx context.Context) (*core.NodeStatus, error) {
+						return &core.NodeStatus{Nonce: 100, ProbableHighestNonce: 100, ShardID: 1}, nil
+					},
+				},
+			},
+			{
+				Name: "node-2",
+				Querier: &mock.NodeStatusQuerierStub{
+					GetNodeStatusHandler: func(ctx context.Context) (*core.NodeStatus, error) {
+						return nil, errors.New("connection refused")
+					},
+				},
+			},
+		}
+		numNetworkNonceCalls := 0
+		args.NetworkNonceQuerier = &mock.NetworkNonceQuerierStub{
+			GetNetworkNonceHandler: func(ctx context.Context, shardID uint32) (uint64, error) {
+				numNetworkNonceCalls++
+				assert.Equal(t, uint32(1), shardID)
+				return 110, nil
+			},
+		}
+		args.NodeStatusChecker = &mock.NodeStatusCheckerStub{
+			CheckHandler: func(status *core.NodeStatus, networkNonce uint64) []string {
+				assert.Equal(t, uint64(110), networkNonce)
+				if status.Nonce == 90 {
+					return []string{"problem 1", "problem 2"}
+				}
+
+				return nil
+			},
+		}
+		var collectedMessages []core.OutputMessage
+		args.StatusHandler = &mock.StatusHandlerStub{
+			CollectKeysProblemsHandler: func(messages []core.OutputMessage) {
+				collectedMessages = messages
+			},
+		}
+		var notifiedMessages []core.OutputMessage
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Equal(t, nodesExecutorName, caller)
+				notifiedMessages = messages
+				return nil
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numNetworkNonceCalls)
+		assert.Equal(t, notifiedMessages, collectedMessages)
+		assert.Equal(t, 2, len(notifiedMessages))
+		expectedEpoch := uint32(37)
+		assert.Equal(t, core.OutputMessage{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     nodeIdentifierType,
+			Identifier:         "node-0",
+			ShortIdentifier:    "node-0",
+			ExecutorName:       "test",
+			ProblemEncountered: "problem 1; problem 2",
+			Epoch:              &expectedEpoch,
+		}, notifiedMessages[0])
+		assert.Equal(t, "node-2", notifiedMessages[1].Identifier)
+		assert.Nil(t, notifiedMessages[1].Epoch)
+		assert.True(t, strings.HasPrefix(notifiedMessages[1].ProblemEncountered, "Node status can not be queried"))
+	})
+	t.Run("network nonce query error should use the probable highest nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.Nodes[0].Querier = &mock.NodeStatusQuerierStub{
+			GetNodeStatusHandler: func(ctx context.Context) (*core.NodeStatus, error) {
+				return &core.NodeStatus{Nonce: 90, ProbableHighestNonce: 100}, nil
+			},
+		}
+		expectedErr := errors.New("expected error")
+		args.NetworkNonceQuerier = &mock.NetworkNonceQuerierStub{
+			GetNetworkNonceHandler: func(ctx context.Context, shardID uint32) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		checked := false
+		args.NodeStatusChecker = &mock.NodeStatusCheckerStub{
+			CheckHandler: func(status *core.NodeStatus, networkNonce uint64) []string {
+				checked = true
+				assert.Equal(t, uint64(100), networkNonce)
+				return nil
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, checked)
+		assert.Equal(t, expectedErr, encounteredErr)
+	})
+	t.Run("healthy nodes should not use the snooze filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.NodesFilter = &mock.BLSKeysFilterStub{
+			ShouldNotifyCalled: func(blsKey string) bool {
+				assert.Fail(t, "should have not called ShouldNotify")
+				return true
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("snoozed problematic nodes should be checked but not notified", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		numQueries := 0
+		args.Nodes[0].Querier = &mock.NodeStatusQuerierStub{
+			GetNodeStatusHandler: func(ctx context.Context) (*core.NodeStatus, error) {
+				numQueries++
+				return &core.NodeStatus{}, nil
+			},
+		}
+		args.NodeStatusChecker = &mock.NodeStatusCheckerStub{
+			CheckHandler: func(status *core.NodeStatus, networkNonce uint64) []string {
+				return []string{"problem"}
+			},
+		}
+		args.NodesFilter = &mock.BLSKeysFilterStub{
+			ShouldNotifyCalled: func(blsKey string) bool {
+				assert.Equal(t, "node-0", blsKey)
+				return false
+			},
+		}
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not called NotifyWithRetry")
+				return nil
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numQueries)
+	})
+	t.Run("notify error should return the error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesExecutor()
+		args.NodeStatusChecker = &mock.NodeStatusCheckerStub{
+			CheckHandler: func(status *core.NodeStatus, networkNonce uint64) []string {
+				return []string{"problem"}
+			},
+		}
+		expectedErr := errors.New("expected error")
+		args.OutputNotifiersHandler = &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				return expectedErr
+			},
+		}
+		var encounteredErr error
+		args.StatusHandler = &mock.StatusHandlerStub{
+			ErrorEncounteredHandler: func(err error) {
+				encounteredErr = err
+			},
+		}
+		executor, _ := NewNodesExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, expectedErr, encounteredErr)
+	})
+}
@@ -0,0 +1,572 @@
+package executors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const callersSeparator = ", "
+
+type notificationTask struct {
+	caller   string
+	messages []core.OutputMessage
+	// skipOutbox is set for the messages that are not relevant after an application restart (e.g. the close message)
+	skipOutbox bool
+}
+
+// merge returns a new task holding the messages of both tasks
+func (task notificationTask) merge(other notificationTask) notificationTask {
+	caller := task.caller
+	if !contains(strings.Split(caller, callersSeparator), other.caller) {
+		caller += callersSeparator + other.caller
+	}
+
+	messages := make([]core.OutputMessage, 0, len(task.messages)+len(other.messages))
+	messages = append(messages, task.messages...)
+	messages = append(messages, other.messages...)
+
+	return notificationTask{
+		caller:     caller,
+		messages:   messages,
+		skipOutbox: task.skipOutbox,
+	}
+}
+
+type notifierWorker struct {
+	notifier            OutputNotifier
+	rateLimiters        []RateLimiter
+	name                string
+	id                  string
+	queue               chan notificationTask
+	numRetries          uint32
+	retryPolicy         *retryPolicy
+	sendTimeout         time.Duration
+	outbox              NotificationsOutbox
+	outboxCheckInterval time.Duration
+	numSent             uint64
+	numFailed           uint64
+	numDropped          uint64
+	numRejected         uint64
+	numMerged           uint64
+	mutLateSend         sync.RWMutex
+	lateSendDone        chan struct{}
+	mutLastRejection    sync.RWMutex
+	lastRejection       string
+	cancel              func()
+	loopClosed          chan struct{}
+}
+
+type argsNotifierWorker struct {
+	notifier              OutputNotifier
+	rateLimiters          []RateLimiter
+	id                    string
+	queueSize             uint32
+	numRetries            uint32
+	timeBetweenRetries    time.Duration
+	maxTimeBetweenRetries time.Duration
+	retryJitter           float64
+	sendTimeout           time.Duration
+	outbox                NotificationsOutbox
+	outboxCheckInterval   time.Duration
+}
+
+// newNotifierWorker creates a worker that owns the queue of one output notifier and starts its processing loop
+func newNotifierWorker(args argsNotifierWorker) *notifierWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	worker := &notifierWorker{
+		notifier:            args.notifier,
+		rateLimiters:        args.rateLimiters,
+		name:                uniqueNotifierName(args.notifier),
+		id:                  args.id,
+		queue:               make(chan notificationTask, args.queueSize),
+		numRetries:          args.numRetries,
+		retryPolicy:         newRetryPolicy(args.timeBetweenRetries, args.maxTimeBetweenRetries, args.retryJitter),
+		sendTimeout:         args.sendTimeout,
+		outbox:              args.outbox,
+		outboxCheckInterval: args.outboxCheckInterval,
+		cancel:              cancel,
+		loopClosed:          make(chan struct{}),
+	}
+
+	go worker.processLoop(ctx)
+
+	return worker
+}
+
+// enqueue will add the task in the worker's queue without blocking. Returns false if the queue is full and the task
+// was dropped from the queue. A dropped task is moved in the outbox
+func (worker *notifierWorker) enqueue(task notificationTask) bool {
+	select {
+	case worker.queue <- task:
+		return true
+	default:
+		atomic.AddUint64(&worker.numDropped, 1)
+		log.Warn("notification queue is full, dropping messages",
+			"notifier", worker.name, "executor", task.caller, "num messages", len(task.messages))
+		worker.storeInOutbox(task)
+		return false
+	}
+}
+
+func (worker *notifierWorker) processLoop(ctx context.Context) {
+	defer close(worker.loopClosed)
+
+	outboxTicker := time.NewTicker(worker.outboxCheckInterval)
+	defer outboxTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			worker.moveQueueInOutbox()
+			return
+		case task := <-worker.queue:
+			worker.process(ctx, task)
+		case <-outboxTicker.C:
+			worker.resendFromOutbox(ctx)
+		}
+	}
+}
+
+// moveQueueInOutbox will save the pending tasks so they can be sent after the application restarts
+func (worker *notifierWorker) moveQueueInOutbox() {
+	log.Debug("notifierWorker.processLoop: closing", "notifier", worker.name, "num pending tasks", len(worker.queue))
+
+	for {
+		select {
+		case task := <-worker.queue:
+			worker.storeInOutbox(task)
+		default:
+			return
+		}
+	}
+}
+
+// process sends the task, merged with the tasks arriving while waiting for the rate limiters. A task that can not be
+// merged without exceeding the notifier's payload limit is sent afterward, as a separate message
+func (worker *notifierWorker) process(ctx context.Context, task notificationTask) {
+	pendingTask := &task
+	for pendingTask != nil {
+		mergedTask, nextTask, ok := worker.waitForRateLimiters(ctx, *pendingTask)
+		if !ok {
+			worker.storeInOutbox(mergedTask)
+			if nextTask != nil {
+				worker.storeInOutbox(*nextTask)
+			}
+			return
+		}
+
+		worker.deliverInPayloads(ctx, mergedTask)
+		pendingTask = nextTask
+	}
+}
+
+// waitForRateLimiters blocks until the rate limiters allow a new message to be sent. In the meantime, the tasks
+// arriving in the queue are merged in the pending task so they will be sent as a single message instead of being
+// dropped. The merging stops at the first task that would make the message exceed the notifier's payload limit, this
+// task being returned separately. Returns false if the worker was closed while waiting
+func (worker *notifierWorker) waitForRateLimiters(
+	ctx context.Context,
+	task notificationTask,
+) (notificationTask, *notificationTask, bool) {
+	var nextTask *notificationTask
+	queue := worker.queue
+	for {
+		waitTime := worker.reserveToken()
+		if waitTime == 0 {
+			return task, nextTask, true
+		}
+
+		log.Debug("notifierWorker: rate limit reached, waiting",
+			"notifier", worker.name, "wait time", waitTime, "num pending messages", len(task.messages))
+
+		timer := time.NewTimer(waitTime)
+		waiting := true
+		for waiting {
+			select {
+			case <-timer.C:
+				waiting = false
+			case queuedTask := <-queue:
+				mergedTask := task.merge(queuedTask)
+				canMerge := task.skipOutbox == queuedTask.skipOutbox
+				if !canMerge || !worker.notifier.FitsInOnePayload(mergedTask.messages...) {
+					// the queue is no longer read, the tasks remain there until the pending ones are sent
+					nextTask = &queuedTask
+					queue = nil
+					continue
+				}
+
+				atomic.AddUint64(&worker.numMerged, 1)
+				task = mergedTask
+			case <-ctx.Done():
+				timer.Stop()
+				return task, nextTask, false
+			}
+		}
+	}
+}
+
+// deliverInPayloads sends the task as one message or, if it exceeds the notifier's payload limit, as several messages.
+// The first message uses the token already reserved, the next ones wait for the rate limiters
+func (worker *notifierWorker) deliverInPayloads(ctx context.Context, task notificationTask) {
+	payloads := worker.splitInPayloads(task)
+	for index, payload := range payloads {
+		if index > 0 && !worker.waitForToken(ctx) {
+			for _, remaining := range payloads[index:] {
+				worker.storeInOutbox(remaining)
+			}
+			return
+		}
+
+		worker.deliver(ctx, payload)
+	}
+}
+
+// splitInPayloads splits the task's messages in consecutive tasks, each one fitting in the notifier's payload limit.
+// A message exceeding the limit on its own is placed in a separate task, the notifier truncating its text
+func (worker *notifierWorker) splitInPayloads(task notificationTask) []notificationTask {
+	if worker.notifier.FitsInOnePayload(task.messages...) {
+		return []notificationTask{task}
+	}
+
+	payloads := make([]notificationTask, 0)
+	current := make([]core.OutputMessage, 0, len(task.messages))
+	for _, message := range task.messages {
+		candidate := append(append(make([]core.OutputMessage, 0, len(current)+1), current...), message)
+		if len(current) > 0 && !worker.notifier.FitsInOnePayload(candidate...) {
+			payloads = append(payloads, notificationTask{caller: task.caller, messages: current, skipOutbox: task.skipOutbox})
+			candidate = []core.OutputMessage{message}
+		}
+
+		current = candidate
+	}
+	payloads = append(payloads, notificationTask{caller: task.caller, messages: current, skipOutbox: task.skipOutbox})
+
+	log.Debug("notifierWorker: messages split in several payloads",
+		"notifier", worker.name, "executor", task.caller, "num messages", len(task.messages), "num payloads", len(payloads))
+
+	return payloads
+}
+
+// reserveToken will try to get a token from all rate limiters. If one of them does not have a token available,
+// the tokens already taken are given back and the maximum wait time is returned
+func (worker *notifierWorker) reserveToken() time.Duration {
+	for index, rateLimiter := range worker.rateLimiters {
+		waitTime := rateLimiter.Reserve()
+		if waitTime == 0 {
+			continue
+		}
+
+		for _, reserved := range worker.rateLimiters[:index] {
+			reserved.Refund()
+		}
+
+		return waitTime
+	}
+
+	return 0
+}
+
+// waitForToken blocks, without merging other tasks, until the rate limiters allow a new message to be sent
+func (worker *notifierWorker) waitForToken(ctx context.Context) bool {
+	for {
+		waitTime := worker.reserveToken()
+		if waitTime == 0 {
+			return true
+		}
+		if !worker.waitBetweenRetries(ctx, waitTime) {
+			return false
+		}
+	}
+}
+
+func (worker *notifierWorker) resendFromOutbox(ctx context.Context) {
+	if worker.isLateSendInProgress() {
+		// the entries will be resent on the next check, after the late notification completes
+		return
+	}
+
+	for _, entry := range worker.outbox.GetDueEntries(worker.id) {
+		if ctx.Err() != nil {
+			return
+		}
+		if !worker.notifier.FitsInOnePayload(entry.Messages...) {
+			worker.splitOutboxEntry(entry)
+			continue
+		}
+		if worker.reserveToken() > 0 {
+			// the remaining entries will be resent on the next check
+			return
+		}
+
+		outboxEntry := entry
+		err := worker.sendWithTimeout(ctx, entry.Messages, func(lateErr error) {
+			worker.handleOutboxResendResult(outboxEntry, lateErr)
+		})
+		if errors.Is(err, errNotificationTimeout) {
+			// the entry is kept in the outbox until the late result is known
+			return
+		}
+
+		worker.handleOutboxResendResult(entry, err)
+	}
+}
+
+// splitOutboxEntry replaces an entry exceeding the notifier's payload limit (e.g. a queue overflow that was merged)
+// with entries that can be sent
+func (worker *notifierWorker) splitOutboxEntry(entry core.OutboxEntry) {
+	payloads := worker.splitInPayloads(notificationTask{caller: entry.Caller, messages: entry.Messages})
+	for _, payload := range payloads {
+		err := worker.outbox.Add(worker.id, payload.caller, payload.messages)
+		if err != nil {
+			log.Error("can not split the outbox entry", "notifier", worker.name, "executor", entry.Caller, "error", err)
+			log.LogIfError(worker.outbox.MarkFailed(worker.id, entry.ID))
+			return
+		}
+	}
+
+	log.LogIfError(worker.outbox.MarkDelivered(worker.id, entry.ID))
+}
+
+func (worker *notifierWorker) handleOutboxResendResult(entry core.OutboxEntry, err error) {
+	if errors.Is(err, errNotificationAbandoned) {
+		// the notifier may have received the messages, resending them after the restart could deliver them twice
+		log.Debug("notifierWorker: resend abandoned while closing, removing the entry from the outbox",
+			"notifier", worker.name, "executor", entry.Caller, "num messages", len(entry.Messages))
+		log.LogIfError(worker.outbox.MarkDelivered(worker.id, entry.ID))
+		return
+	}
+	if isPermanentError(err) {
+		// resending will not fix a misconfiguration, the entry is removed from the outbox
+		worker.recordRejection(entry.Caller, len(entry.Messages), err)
+		log.LogIfError(worker.outbox.MarkDelivered(worker.id, entry.ID))
+		return
+	}
+	if err != nil {
+		log.Debug("notifierWorker: resending from outbox failed",
+			"notifier", worker.name, "executor", entry.Caller, "num attempts", entry.NumAttempts+1, "error", err)
+		log.LogIfError(worker.outbox.MarkFailed(worker.id, entry.ID))
+		return
+	}
+
+	log.Debug("notifierWorker: resent from outbox",
+		"notifier", worker.name, "executor", entry.Caller, "num messages", len(entry.Messages))
+	atomic.AddUint64(&worker.numSent, 1)
+	log.LogIfError(worker.outbox.MarkDelivered(worker.id, entry.ID))
+}
+
+func (worker *notifierWorker) storeInOutbox(task notificationTask) {
+	if task.skipOutbox {
+		log.Debug("notifierWorker: messages not stored in the outbox",
+			"notifier", worker.name, "executor", task.caller, "num messages", len(task.messages))
+		return
+	}
+
+	err := worker.outbox.Add(worker.id, task.caller, task.messages)
+	if err != nil {
+		log.Error("can not store the messages in the outbox",
+			"notifier", worker.name, "executor", task.caller, "error", err)
+	}
+}
+
+func (worker *notifierWorker) deliver(ctx context.Context, task notificationTask) {
+	onLateResult := func(lateErr error) {
+		worker.handleDeliveryResult(task, lateErr)
+	}
+
+	err := worker.sendWithTimeout(ctx, task.messages, onLateResult)
+	for retry := uint32(0); err != nil && retry < worker.numRetries; retry++ {
+		if errors.Is(err, errNotificationTimeout) || errors.Is(err, errNotificationAbandoned) {
+			break
+		}
+
+		delay, shouldRetry := worker.retryPolicy.nextDelay(retry, err)
+		if !shouldRetry {
+			break
+		}
+
+		log.Error("error sending notification",
+			"notifier", worker.name, "executor", task.caller, "retry no.", retry, "next attempt in", delay, "error", err)
+
+		if !worker.waitBetweenRetries(ctx, delay) {
+			break
+		}
+		if !worker.waitForToken(ctx) {
+			break
+		}
+
+		err = worker.sendWithTimeout(ctx, task.messages, onLateResult)
+	}
+
+	if errors.Is(err, errNotificationTimeout) {
+		// the notifier is still sending, the late result decides if the messages are moved in the outbox so a late
+		// successful send will not be delivered a second time from the outbox
+		return
+	}
+
+	worker.handleDeliveryResult(task, err)
+}
+
+func (worker *notifierWorker) handleDeliveryResult(task notificationTask, err error) {
+	if errors.Is(err, errNotificationAbandoned) {
+		// the notifier may have received the messages, storing them in the outbox could deliver them twice
+		log.Warn("notification abandoned while closing, the messages are not stored in the outbox",
+			"notifier", worker.name, "executor", task.caller, "num messages", len(task.messages))
+		return
+	}
+	if isPermanentError(err) {
+		worker.recordRejection(task.caller, len(task.messages), err)
+		return
+	}
+	if err != nil {
+		atomic.AddUint64(&worker.numFailed, 1)
+		log.Error("notification delivery failed, moving the messages in the outbox",
+			"notifier", worker.name, "executor", task.caller, "num messages", len(task.messages), "error", err)
+		worker.storeInOutbox(task)
+		return
+	}
+
+	atomic.AddUint64(&worker.numSent, 1)
+}
+
+// recordRejection is called when the remote service permanently rejected the notification. This usually means the
+// notifier is misconfigured so the messages are not retried nor stored in the outbox
+func (worker *notifierWorker) recordRejection(caller string, numMessages int, err error) {
+	atomic.AddUint64(&worker.numRejected, 1)
+	log.Error("notification rejected, please check the notifier configuration",
+		"notifier", worker.name, "executor", caller, "num messages", numMessages, "error", err)
+
+	worker.mutLastRejection.Lock()
+	worker.lastRejection = err.Error()
+	worker.mutLastRejection.Unlock()
+}
+
+func (worker *notifierWorker) waitBetweenRetries(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// sendWithTimeout calls the notifier on a separate go routine so a hanging notifier can not block the worker
+// for more than the configured timeout. A send that times out is not abandoned: its result is passed to onLateResult
+// when it completes and, until then, the next send waits so the go routines will not pile up against a slow endpoint.
+// A send still running when the worker is closed returns errNotificationAbandoned
+func (worker *notifierWorker) sendWithTimeout(
+	ctx context.Context,
+	messages []core.OutputMessage,
+	onLateResult func(err error),
+) error {
+	if !worker.waitForLateSend(ctx) || ctx.Err() != nil {
+		return errContextClosing
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- worker.notifier.OutputMessages(messages...)
+	}()
+
+	timer := time.NewTimer(worker.sendTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-timer.C:
+		lateSendDone := make(chan struct{})
+		worker.mutLateSend.Lock()
+		worker.lateSendDone = lateSendDone
+		worker.mutLateSend.Unlock()
+
+		go func() {
+			err := <-errChan
+			log.Debug("notifierWorker: late notification completed", "notifier", worker.name, "error", err)
+			onLateResult(err)
+			close(lateSendDone)
+		}()
+
+		return fmt.Errorf("%w after %v", errNotificationTimeout, worker.sendTimeout)
+	case <-ctx.Done():
+		return errNotificationAbandoned
+	}
+}
+
+// waitForLateSend blocks until the send that timed out completes. Returns false if the worker was closed while waiting
+func (worker *notifierWorker) waitForLateSend(ctx context.Context) bool {
+	worker.mutLateSend.RLock()
+	lateSendDone := worker.lateSendDone
+	worker.mutLateSend.RUnlock()
+
+	if lateSendDone == nil {
+		return true
+	}
+
+	select {
+	case <-lateSendDone:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (worker *notifierWorker) isLateSendInProgress() bool {
+	worker.mutLateSend.RLock()
+	lateSendDone := worker.lateSendDone
+	worker.mutLateSend.RUnlock()
+
+	if lateSendDone == nil {
+		return false
+	}
+
+	select {
+	case <-lateSendDone:
+		return false
+	default:
+		return true
+	}
+}
+
+func (worker *notifierWorker) metrics() core.NotifierMetrics {
+	worker.mutLastRejection.RLock()
+	lastRejection := worker.lastRejection
+	worker.mutLastRejection.RUnlock()
+
+	return core.NotifierMetrics{
+		Name:          worker.name,
+		QueueDepth:    len(worker.queue),
+		NumSent:       atomic.LoadUint64(&worker.numSent),
+		NumFailed:     atomic.LoadUint64(&worker.numFailed),
+		NumDropped:    atomic.LoadUint64(&worker.numDropped),
+		NumRejected:   atomic.LoadUint64(&worker.numRejected),
+		NumMerged:     atomic.LoadUint64(&worker.numMerged),
+		LastRejection: lastRejection,
+		OutboxSize:    worker.outbox.NumEntries(worker.id),
+	}
+}
+
+func (worker *notifierWorker) close() {
+	worker.cancel()
+	<-worker.loopClosed
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
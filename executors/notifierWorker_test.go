@@ -0,0 +1,840 @@
+package executors
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors/disabled"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestArgsNotifierWorker(notifier OutputNotifier) argsNotifierWorker {
+	return argsNotifierWorker{
+		notifier:              notifier,
+		id:                    "0-notifier",
+		queueSize:             10,
+		numRetries:            0,
+		timeBetweenRetries:    minTimeBetweenRetries,
+		maxTimeBetweenRetries: minTimeBetweenRetries,
+		sendTimeout:           time.Second,
+		outbox:                disabled.NewDisabledOutbox(),
+		outboxCheckInterval:   time.Second,
+	}
+}
+
+func TestNotifierWorker_SendTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("late successful send should not be stored in the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				atomic.AddUint32(&numCalls, 1)
+				time.Sleep(time.Millisecond * 500)
+				return nil
+			},
+		}
+
+		numStored := uint32(0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.sendTimeout = time.Millisecond * 100
+		args.numRetries = 1
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				atomic.AddUint32(&numStored, 1)
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{}}}))
+		time.Sleep(time.Millisecond * 300)
+
+		// the send that timed out is not retried while it is still running
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+		assert.Zero(t, worker.metrics().NumSent)
+		assert.Zero(t, worker.metrics().NumFailed)
+
+		time.Sleep(time.Millisecond * 400)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+		metrics := worker.metrics()
+		assert.Equal(t, uint64(1), metrics.NumSent)
+		assert.Zero(t, metrics.NumFailed)
+		assert.Zero(t, atomic.LoadUint32(&numStored))
+
+		worker.close()
+	})
+	t.Run("late failed send should be stored in the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				time.Sleep(time.Millisecond * 300)
+				return errors.New("expected error")
+			},
+		}
+
+		mut := sync.Mutex{}
+		storedMessages := make([]core.OutputMessage, 0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.sendTimeout = time.Millisecond * 100
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				mut.Lock()
+				storedMessages = append(storedMessages, messages...)
+				mut.Unlock()
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		testMessages := []core.OutputMessage{{Identifier: "id1"}}
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: testMessages}))
+		time.Sleep(time.Millisecond * 500)
+
+		mut.Lock()
+		assert.Equal(t, testMessages, storedMessages)
+		mut.Unlock()
+		assert.Equal(t, uint64(1), worker.metrics().NumFailed)
+
+		worker.close()
+	})
+	t.Run("should wait for the late send before starting a new send", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				if atomic.AddUint32(&numCalls, 1) == 1 {
+					time.Sleep(time.Millisecond * 500)
+				}
+				return nil
+			},
+		}
+
+		numStored := uint32(0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.sendTimeout = time.Millisecond * 100
+		args.numRetries = 3
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				atomic.AddUint32(&numStored, 1)
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id1"}}}))
+		time.Sleep(time.Millisecond * 200)
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id2"}}}))
+		time.Sleep(time.Millisecond * 100)
+
+		// the second send did not start and did not consume its retries
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+
+		time.Sleep(time.Millisecond * 400)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+		metrics := worker.metrics()
+		assert.Equal(t, uint64(2), metrics.NumSent)
+		assert.Zero(t, metrics.NumFailed)
+		assert.Zero(t, atomic.LoadUint32(&numStored))
+
+		worker.close()
+	})
+	t.Run("send in progress when closing should not be stored in the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				time.Sleep(time.Millisecond * 300)
+				return nil
+			},
+		}
+
+		numStored := uint32(0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				atomic.AddUint32(&numStored, 1)
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id1"}}}))
+		time.Sleep(time.Millisecond * 100)
+		worker.close()
+
+		assert.Zero(t, atomic.LoadUint32(&numStored))
+		assert.Zero(t, worker.metrics().NumFailed)
+	})
+}
+
+func TestNotifierWorker_SkipOutbox(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			return errors.New("expected error")
+		},
+	}
+
+	mut := sync.Mutex{}
+	storedMessages := make([]core.OutputMessage, 0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.outbox = &mock.NotificationsOutboxStub{
+		AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+			mut.Lock()
+			storedMessages = append(storedMessages, messages...)
+			mut.Unlock()
+			return nil
+		},
+	}
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id1"}}, skipOutbox: true}))
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id2"}}}))
+	time.Sleep(time.Millisecond * 200)
+
+	mut.Lock()
+	assert.Equal(t, []core.OutputMessage{{Identifier: "id2"}}, storedMessages)
+	mut.Unlock()
+	assert.Equal(t, uint64(2), worker.metrics().NumFailed)
+
+	worker.close()
+}
+
+func TestNotifierWorker_RecoversAfterRetry(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			if atomic.AddUint32(&numCalls, 1) < 3 {
+				return errors.New("expected error")
+			}
+			return nil
+		},
+	}
+
+	args := createTestArgsNotifierWorker(notifier)
+	args.numRetries = 3
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{}}}))
+	time.Sleep(time.Millisecond * 200)
+
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	metrics := worker.metrics()
+	assert.Equal(t, uint64(1), metrics.NumSent)
+	assert.Zero(t, metrics.NumFailed)
+
+	worker.close()
+}
+
+func TestNotifierWorker_CloseShouldStopWaitingRetries(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			return errors.New("expected error")
+		},
+	}
+
+	args := createTestArgsNotifierWorker(notifier)
+	args.numRetries = 10
+	args.timeBetweenRetries = time.Minute
+	args.maxTimeBetweenRetries = time.Minute
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{}}}))
+	time.Sleep(time.Millisecond * 100)
+
+	start := time.Now()
+	worker.close()
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, uint64(1), worker.metrics().NumFailed)
+}
+
+func TestNotifierWorker_Outbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed delivery should be stored in the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				return errors.New("expected error")
+			},
+		}
+
+		mut := sync.Mutex{}
+		storedMessages := make([]core.OutputMessage, 0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				assert.Equal(t, "0-notifier", notifierID)
+				assert.Equal(t, "test", caller)
+
+				mut.Lock()
+				storedMessages = append(storedMessages, messages...)
+				mut.Unlock()
+
+				return nil
+			},
+			NumEntriesHandler: func(notifierID string) int {
+				return 37
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		testMessages := []core.OutputMessage{{Identifier: "id1"}, {Identifier: "id2"}}
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: testMessages}))
+		time.Sleep(time.Millisecond * 100)
+		worker.close()
+
+		mut.Lock()
+		assert.Equal(t, testMessages, storedMessages)
+		mut.Unlock()
+		assert.Equal(t, 37, worker.metrics().OutboxSize)
+	})
+	t.Run("closing should move the pending tasks in the outbox", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				time.Sleep(time.Second * 5)
+				return nil
+			},
+		}
+
+		numStored := uint32(0)
+		args := createTestArgsNotifierWorker(notifier)
+		args.sendTimeout = time.Second * 10
+		args.outbox = &mock.NotificationsOutboxStub{
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				atomic.AddUint32(&numStored, 1)
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+
+		task := notificationTask{caller: "test", messages: []core.OutputMessage{{}}}
+		assert.True(t, worker.enqueue(task))
+		time.Sleep(time.Millisecond * 100) // first task is being sent
+		assert.True(t, worker.enqueue(task))
+		assert.True(t, worker.enqueue(task))
+		worker.close()
+
+		// the task that was being sent is not saved, the notifier may have received it
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numStored))
+	})
+	t.Run("should resend the due entries", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				if atomic.AddUint32(&numCalls, 1) == 1 {
+					return errors.New("expected error")
+				}
+				return nil
+			},
+		}
+
+		mut := sync.Mutex{}
+		failed := make([]uint64, 0)
+		delivered := make([]uint64, 0)
+		entries := []core.OutboxEntry{{ID: 1}, {ID: 2}}
+		args := createTestArgsNotifierWorker(notifier)
+		args.outboxCheckInterval = time.Millisecond * 50
+		args.outbox = &mock.NotificationsOutboxStub{
+			GetDueEntriesHandler: func(notifierID string) []core.OutboxEntry {
+				mut.Lock()
+				defer mut.Unlock()
+
+				result := entries
+				entries = nil
+				return result
+			},
+			MarkFailedHandler: func(notifierID string, entryID uint64) error {
+				mut.Lock()
+				failed = append(failed, entryID)
+				mut.Unlock()
+				return nil
+			},
+			MarkDeliveredHandler: func(notifierID string, entryID uint64) error {
+				mut.Lock()
+				delivered = append(delivered, entryID)
+				mut.Unlock()
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+		time.Sleep(time.Millisecond * 200)
+		worker.close()
+
+		mut.Lock()
+		assert.Equal(t, []uint64{1}, failed)
+		assert.Equal(t, []uint64{2}, delivered)
+		mut.Unlock()
+		assert.Equal(t, uint64(1), worker.metrics().NumSent)
+	})
+	t.Run("late resend should mark the entry when it completes", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				time.Sleep(time.Millisecond * 300)
+				return nil
+			},
+		}
+
+		mut := sync.Mutex{}
+		failed := make([]uint64, 0)
+		delivered := make([]uint64, 0)
+		entries := []core.OutboxEntry{{ID: 1}, {ID: 2}}
+		args := createTestArgsNotifierWorker(notifier)
+		args.sendTimeout = time.Millisecond * 100
+		args.outboxCheckInterval = time.Millisecond * 50
+		args.outbox = &mock.NotificationsOutboxStub{
+			GetDueEntriesHandler: func(notifierID string) []core.OutboxEntry {
+				mut.Lock()
+				defer mut.Unlock()
+
+				result := entries
+				entries = nil
+				return result
+			},
+			MarkFailedHandler: func(notifierID string, entryID uint64) error {
+				mut.Lock()
+				failed = append(failed, entryID)
+				mut.Unlock()
+				return nil
+			},
+			MarkDeliveredHandler: func(notifierID string, entryID uint64) error {
+				mut.Lock()
+				delivered = append(delivered, entryID)
+				mut.Unlock()
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+		time.Sleep(time.Millisecond * 450)
+		worker.close()
+
+		mut.Lock()
+		// the second entry was not resent while the first one was late
+		assert.Empty(t, failed)
+		assert.Equal(t, []uint64{1}, delivered)
+		mut.Unlock()
+		assert.Equal(t, uint64(1), worker.metrics().NumSent)
+	})
+}
+
+func TestNotifierWorker_PermanentErrorShouldNotRetry(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			atomic.AddUint32(&numCalls, 1)
+			return &core.NotificationError{
+				Err:       errors.New("unauthorized"),
+				Code:      401,
+				Permanent: true,
+			}
+		},
+	}
+
+	numStored := uint32(0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.numRetries = 5
+	args.outbox = &mock.NotificationsOutboxStub{
+		AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+			atomic.AddUint32(&numStored, 1)
+			return nil
+		},
+	}
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{}}}))
+	time.Sleep(time.Millisecond * 200)
+	worker.close()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	assert.Zero(t, atomic.LoadUint32(&numStored))
+	metrics := worker.metrics()
+	assert.Equal(t, uint64(1), metrics.NumRejected)
+	assert.Equal(t, "unauthorized", metrics.LastRejection)
+	assert.Zero(t, metrics.NumFailed)
+	assert.Zero(t, metrics.NumSent)
+}
+
+func TestNotifierWorker_ShouldHonorRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	callTimes := make([]time.Time, 0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			mut.Lock()
+			defer mut.Unlock()
+
+			callTimes = append(callTimes, time.Now())
+			if len(callTimes) == 1 {
+				return &core.NotificationError{
+					Err:        errors.New("too many requests"),
+					Code:       429,
+					RetryAfter: time.Millisecond * 300,
+				}
+			}
+			return nil
+		},
+	}
+
+	args := createTestArgsNotifierWorker(notifier)
+	args.numRetries = 1
+	args.maxTimeBetweenRetries = time.Second
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{}}}))
+	time.Sleep(time.Millisecond * 600)
+	worker.close()
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, 2, len(callTimes))
+	assert.GreaterOrEqual(t, callTimes[1].Sub(callTimes[0]), time.Millisecond*300)
+	assert.Equal(t, uint64(1), worker.metrics().NumSent)
+}
+
+func TestNotifierWorker_PermanentErrorShouldRemoveTheOutboxEntry(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			return &core.NotificationError{
+				Err:       errors.New("chat not found"),
+				Code:      400,
+				Permanent: true,
+			}
+		},
+	}
+
+	mut := sync.Mutex{}
+	entries := []core.OutboxEntry{{ID: 1}}
+	delivered := make([]uint64, 0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.outboxCheckInterval = time.Millisecond * 50
+	args.outbox = &mock.NotificationsOutboxStub{
+		GetDueEntriesHandler: func(notifierID string) []core.OutboxEntry {
+			mut.Lock()
+			defer mut.Unlock()
+
+			result := entries
+			entries = nil
+			return result
+		},
+		MarkFailedHandler: func(notifierID string, entryID uint64) error {
+			assert.Fail(t, "should have not called MarkFailed")
+			return nil
+		},
+		MarkDeliveredHandler: func(notifierID string, entryID uint64) error {
+			mut.Lock()
+			delivered = append(delivered, entryID)
+			mut.Unlock()
+			return nil
+		},
+	}
+	worker := newNotifierWorker(args)
+	time.Sleep(time.Millisecond * 200)
+	worker.close()
+
+	mut.Lock()
+	assert.Equal(t, []uint64{1}, delivered)
+	mut.Unlock()
+	assert.Equal(t, uint64(1), worker.metrics().NumRejected)
+	assert.Zero(t, worker.metrics().NumSent)
+}
+
+func TestNotifierWorker_RateLimitedTasksShouldBeMerged(t *testing.T) {
+	t.Parallel()
+
+	collector := newMessagesCollector()
+	numSendings := uint32(0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			atomic.AddUint32(&numSendings, 1)
+			collector.add("notifier", messages)
+			return nil
+		},
+	}
+
+	numReserveCalls := uint32(0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.rateLimiters = []RateLimiter{
+		&mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				if atomic.AddUint32(&numReserveCalls, 1) == 2 {
+					return time.Millisecond * 200
+				}
+				return 0
+			},
+		},
+	}
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id1"}}}))
+	time.Sleep(time.Millisecond * 50) // first task is sent, the second will wait for the rate limiter
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id2"}}}))
+	time.Sleep(time.Millisecond * 50)
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor2", messages: []core.OutputMessage{{Identifier: "id3"}}}))
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id4"}}}))
+	time.Sleep(time.Millisecond * 400)
+	worker.close()
+
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numSendings))
+	expectedMessages := []core.OutputMessage{{Identifier: "id1"}, {Identifier: "id2"}, {Identifier: "id3"}, {Identifier: "id4"}}
+	assert.Equal(t, expectedMessages, collector.get("notifier"))
+	metrics := worker.metrics()
+	assert.Equal(t, uint64(2), metrics.NumMerged)
+	assert.Equal(t, uint64(2), metrics.NumSent)
+	assert.Zero(t, metrics.NumDropped)
+}
+
+func TestNotifierWorker_MergedTasksShouldNotExceedThePayloadLimit(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	sentPayloads := make([][]core.OutputMessage, 0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			mut.Lock()
+			sentPayloads = append(sentPayloads, messages)
+			mut.Unlock()
+			return nil
+		},
+		FitsInOnePayloadHandler: func(messages ...core.OutputMessage) bool {
+			return len(messages) <= 2
+		},
+	}
+
+	numReserveCalls := uint32(0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.rateLimiters = []RateLimiter{
+		&mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				if atomic.AddUint32(&numReserveCalls, 1) == 2 {
+					return time.Millisecond * 200
+				}
+				return 0
+			},
+		},
+	}
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id1"}}}))
+	time.Sleep(time.Millisecond * 50) // first task is sent, the second will wait for the rate limiter
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id2"}}}))
+	time.Sleep(time.Millisecond * 50)
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id3"}}}))
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id4"}}}))
+	assert.True(t, worker.enqueue(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id5"}}}))
+	time.Sleep(time.Millisecond * 400)
+	worker.close()
+
+	// id4 would exceed the payload limit so it is sent separately, after the merged task, and the rate limiter does not
+	// block the following tasks anymore
+	expectedPayloads := [][]core.OutputMessage{
+		{{Identifier: "id1"}},
+		{{Identifier: "id2"}, {Identifier: "id3"}},
+		{{Identifier: "id4"}},
+		{{Identifier: "id5"}},
+	}
+	mut.Lock()
+	assert.Equal(t, expectedPayloads, sentPayloads)
+	mut.Unlock()
+	metrics := worker.metrics()
+	assert.Equal(t, uint64(1), metrics.NumMerged)
+	assert.Equal(t, uint64(4), metrics.NumSent)
+}
+
+func TestNotifierWorker_LargeTaskShouldBeSplit(t *testing.T) {
+	t.Parallel()
+
+	fitsInOnePayload := func(messages ...core.OutputMessage) bool {
+		return len(messages) <= 2
+	}
+	testMessages := []core.OutputMessage{
+		{Identifier: "id1"}, {Identifier: "id2"}, {Identifier: "id3"}, {Identifier: "id4"}, {Identifier: "id5"},
+	}
+	expectedPayloads := [][]core.OutputMessage{
+		{{Identifier: "id1"}, {Identifier: "id2"}},
+		{{Identifier: "id3"}, {Identifier: "id4"}},
+		{{Identifier: "id5"}},
+	}
+
+	t.Run("queued task should be sent in several payloads", func(t *testing.T) {
+		t.Parallel()
+
+		mut := sync.Mutex{}
+		sentPayloads := make([][]core.OutputMessage, 0)
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				mut.Lock()
+				sentPayloads = append(sentPayloads, messages)
+				mut.Unlock()
+				return nil
+			},
+			FitsInOnePayloadHandler: fitsInOnePayload,
+		}
+		worker := newNotifierWorker(createTestArgsNotifierWorker(notifier))
+
+		assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: testMessages}))
+		time.Sleep(time.Millisecond * 100)
+		worker.close()
+
+		mut.Lock()
+		assert.Equal(t, expectedPayloads, sentPayloads)
+		mut.Unlock()
+		assert.Equal(t, uint64(3), worker.metrics().NumSent)
+	})
+	t.Run("outbox entry should be replaced with entries that can be sent", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not sent the large entry")
+				return nil
+			},
+			FitsInOnePayloadHandler: fitsInOnePayload,
+		}
+
+		mut := sync.Mutex{}
+		addedPayloads := make([][]core.OutputMessage, 0)
+		delivered := make([]uint64, 0)
+		entries := []core.OutboxEntry{{ID: 1, Caller: "test", Messages: testMessages}}
+		args := createTestArgsNotifierWorker(notifier)
+		args.outboxCheckInterval = time.Millisecond * 50
+		args.outbox = &mock.NotificationsOutboxStub{
+			GetDueEntriesHandler: func(notifierID string) []core.OutboxEntry {
+				mut.Lock()
+				defer mut.Unlock()
+
+				result := entries
+				entries = nil
+				return result
+			},
+			AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+				assert.Equal(t, "test", caller)
+
+				mut.Lock()
+				addedPayloads = append(addedPayloads, messages)
+				mut.Unlock()
+				return nil
+			},
+			MarkDeliveredHandler: func(notifierID string, entryID uint64) error {
+				mut.Lock()
+				delivered = append(delivered, entryID)
+				mut.Unlock()
+				return nil
+			},
+		}
+		worker := newNotifierWorker(args)
+		time.Sleep(time.Millisecond * 150)
+		worker.close()
+
+		mut.Lock()
+		assert.Equal(t, expectedPayloads, addedPayloads)
+		assert.Equal(t, []uint64{1}, delivered)
+		mut.Unlock()
+	})
+}
+
+func TestNotifierWorker_ReserveTokenShouldRefundOnPartialReservation(t *testing.T) {
+	t.Parallel()
+
+	numRefunds := uint32(0)
+	args := createTestArgsNotifierWorker(&mock.OutputNotifierStub{})
+	args.rateLimiters = []RateLimiter{
+		&mock.RateLimiterStub{
+			RefundHandler: func() {
+				atomic.AddUint32(&numRefunds, 1)
+			},
+		},
+		&mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				return time.Second
+			},
+			RefundHandler: func() {
+				assert.Fail(t, "should have not refunded the limiter without a reserved token")
+			},
+		},
+	}
+	worker := newNotifierWorker(args)
+	defer worker.close()
+
+	assert.Equal(t, time.Second, worker.reserveToken())
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numRefunds))
+}
+
+func TestNotifierWorker_CloseWhileRateLimitedShouldStoreInOutbox(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			assert.Fail(t, "should have not sent the messages")
+			return nil
+		},
+	}
+
+	mut := sync.Mutex{}
+	storedMessages := make([]core.OutputMessage, 0)
+	args := createTestArgsNotifierWorker(notifier)
+	args.rateLimiters = []RateLimiter{
+		&mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				return time.Minute
+			},
+		},
+	}
+	args.outbox = &mock.NotificationsOutboxStub{
+		AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+			mut.Lock()
+			storedMessages = append(storedMessages, messages...)
+			mut.Unlock()
+			return nil
+		},
+	}
+	worker := newNotifierWorker(args)
+
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id1"}}}))
+	time.Sleep(time.Millisecond * 50)
+	assert.True(t, worker.enqueue(notificationTask{caller: "test", messages: []core.OutputMessage{{Identifier: "id2"}}}))
+	time.Sleep(time.Millisecond * 50)
+	worker.close()
+
+	mut.Lock()
+	assert.Equal(t, []core.OutputMessage{{Identifier: "id1"}, {Identifier: "id2"}}, storedMessages)
+	mut.Unlock()
+}
+
+func TestNotificationTask_Merge(t *testing.T) {
+	t.Parallel()
+
+	task := notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id1"}}}
+	merged := task.merge(notificationTask{caller: "executor1", messages: []core.OutputMessage{{Identifier: "id2"}}})
+	merged = merged.merge(notificationTask{caller: "executor", messages: []core.OutputMessage{{Identifier: "id3"}}})
+
+	assert.Equal(t, "executor1, executor", merged.caller)
+	assert.Equal(t, []core.OutputMessage{{Identifier: "id1"}, {Identifier: "id2"}, {Identifier: "id3"}}, merged.messages)
+	assert.Equal(t, []core.OutputMessage{{Identifier: "id1"}}, task.messages)
+}
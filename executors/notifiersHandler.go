@@ -9,94 +9,154 @@ import (
 )
 
 const minTimeBetweenRetries = time.Millisecond * 10
+const minSendTimeout = time.Millisecond * 10
+const minQueueSize = 1
+const minOutboxCheckInterval = time.Millisecond * 10
 
 type notifiersHandler struct {
-	notifiers          []OutputNotifier
-	numRetries         uint32
-	timeBetweenRetries time.Duration
+	workers []*notifierWorker
 }
 
 // ArgsNotifiersHandler defines the DTO struct for the NewNotifiersHandler constructor function
 type ArgsNotifiersHandler struct {
-	Notifiers          []OutputNotifier
-	NumRetries         uint32
-	TimeBetweenRetries time.Duration
+	Notifiers             []OutputNotifier
+	RateLimiters          map[OutputNotifier][]RateLimiter
+	NumRetries            uint32
+	TimeBetweenRetries    time.Duration
+	MaxTimeBetweenRetries time.Duration
+	RetryJitter           float64
+	SendTimeout           time.Duration
+	QueueSize             uint32
+	Outbox                NotificationsOutbox
+	OutboxCheckInterval   time.Duration
 }
 
-// NewNotifiersHandler creates a new instance of type notifiersHandler
+// NewNotifiersHandler creates a new instance of type notifiersHandler. Each notifier will receive its own worker
+// with a dedicated queue, so a slow or failing notifier will not delay the others
 func NewNotifiersHandler(args ArgsNotifiersHandler) (*notifiersHandler, error) {
 	for index, notifier := range args.Notifiers {
 		if check.IfNil(notifier) {
 			return nil, fmt.Errorf("%w at index %d", errNilOutputNotifier, index)
 		}
 	}
+	for _, notifier := range args.Notifiers {
+		for index, rateLimiter := range args.RateLimiters[notifier] {
+			if check.IfNil(rateLimiter) {
+				return nil, fmt.Errorf("%w at index %d for notifier %s", errNilRateLimiter, index, notifier.Name())
+			}
+		}
+	}
 	if args.TimeBetweenRetries < minTimeBetweenRetries {
 		return nil, fmt.Errorf("%w provided: %v, minimum: %v", errInvalidTimeBetweenRetries, args.TimeBetweenRetries, minTimeBetweenRetries)
 	}
+	if args.MaxTimeBetweenRetries < args.TimeBetweenRetries {
+		return nil, fmt.Errorf("%w provided: %v, time between retries: %v", errInvalidMaxTimeBetweenRetries, args.MaxTimeBetweenRetries, args.TimeBetweenRetries)
+	}
+	if args.RetryJitter < 0 || args.RetryJitter > 1 {
+		return nil, fmt.Errorf("%w provided: %v, interval: [0, 1]", errInvalidRetryJitter, args.RetryJitter)
+	}
+	if args.SendTimeout < minSendTimeout {
+		return nil, fmt.Errorf("%w provided: %v, minimum: %v", errInvalidSendTimeout, args.SendTimeout, minSendTimeout)
+	}
+	if args.QueueSize < minQueueSize {
+		return nil, fmt.Errorf("%w provided: %d, minimum: %d", errInvalidQueueSize, args.QueueSize, minQueueSize)
+	}
+	if check.IfNil(args.Outbox) {
+		return nil, errNilOutbox
+	}
+	if args.OutboxCheckInterval < minOutboxCheckInterval {
+		return nil, fmt.Errorf("%w provided: %v, minimum: %v", errInvalidOutboxCheckInterval, args.OutboxCheckInterval, minOutboxCheckInterval)
+	}
 
-	return &notifiersHandler{
-		notifiers:          args.Notifiers,
-		numRetries:         args.NumRetries,
-		timeBetweenRetries: args.TimeBetweenRetries,
-	}, nil
+	handler := &notifiersHandler{
+		workers: make([]*notifierWorker, 0, len(args.Notifiers)),
+	}
+	for index, notifier := range args.Notifiers {
+		argsWorker := argsNotifierWorker{
+			notifier:              notifier,
+			rateLimiters:          args.RateLimiters[notifier],
+			id:                    notifierID(index, notifier),
+			queueSize:             args.QueueSize,
+			numRetries:            args.NumRetries,
+			timeBetweenRetries:    args.TimeBetweenRetries,
+			maxTimeBetweenRetries: args.MaxTimeBetweenRetries,
+			retryJitter:           args.RetryJitter,
+			sendTimeout:           args.SendTimeout,
+			outbox:                args.Outbox,
+			outboxCheckInterval:   args.OutboxCheckInterval,
+		}
+		handler.workers = append(handler.workers, newNotifierWorker(argsWorker))
+	}
+
+	return handler, nil
 }
 
-// NotifyWithRetry will try to send the messages to the inner list of notifiers. If one or more notifiers errored, it will retry
-// for the maximum number of retries provided. There is a delay between the retries, to maximize the chances of recovery
+// NotifyWithRetry will enqueue the messages on each notifier's worker and return immediately. The sending and the
+// retries are done asynchronously, independently for each notifier. It errors only if one or more queues were full
+// and the messages were dropped
 func (handler *notifiersHandler) NotifyWithRetry(caller string, messages ...core.OutputMessage) error {
 	log.Debug("notifiersHandler.NotifyWithRetry",
-		"executor", caller, "num messages", len(messages), "num retries", handler.numRetries,
-		"num notifiers", len(handler.notifiers))
+		"executor", caller, "num messages", len(messages), "num notifiers", len(handler.workers))
+
+	task := notificationTask{
+		caller:   caller,
+		messages: messages,
+	}
 
-	notifiersThatErrored := make(map[string]OutputNotifier)
-	handler.handleFirstSend(notifiersThatErrored, messages)
-	return handler.handleRetries(notifiersThatErrored, messages)
+	return handler.enqueue(task)
 }
 
-func (handler *notifiersHandler) handleFirstSend(notifiersThatErrored map[string]OutputNotifier, messages []core.OutputMessage) {
-	if len(messages) == 0 {
-		return
-	}
+// NotifyWithoutOutbox works as NotifyWithRetry but the messages are not stored in the outbox if they can not be
+// delivered. It is used for the messages that are not relevant after an application restart (e.g. the close message)
+func (handler *notifiersHandler) NotifyWithoutOutbox(caller string, messages ...core.OutputMessage) error {
+	log.Debug("notifiersHandler.NotifyWithoutOutbox",
+		"executor", caller, "num messages", len(messages), "num notifiers", len(handler.workers))
 
-	for _, notifier := range handler.notifiers {
-		err := notifier.OutputMessages(messages...)
-		if err != nil {
-			uniqueNotifierNameStr := uniqueNotifierName(notifier)
-			log.Error("error sending notification", "notifier", uniqueNotifierNameStr, "error", err)
-			notifiersThatErrored[uniqueNotifierNameStr] = notifier
-		}
+	task := notificationTask{
+		caller:     caller,
+		messages:   messages,
+		skipOutbox: true,
 	}
+
+	return handler.enqueue(task)
 }
 
-func (handler *notifiersHandler) handleRetries(notifiersThatErrored map[string]OutputNotifier, messages []core.OutputMessage) error {
-	if handler.numRetries == 0 && len(notifiersThatErrored) == 0 {
-		log.Debug("notifiersHandler.NotifyWithRetry no errors found")
+func (handler *notifiersHandler) enqueue(task notificationTask) error {
+	if len(task.messages) == 0 {
 		return nil
 	}
 
-	for i := uint32(0); i < handler.numRetries; i++ {
-		time.Sleep(handler.timeBetweenRetries)
-		handler.notifyInRetryMode(i, notifiersThatErrored, messages)
-
-		if len(notifiersThatErrored) == 0 {
-			return nil
+	numDropped := 0
+	for _, worker := range handler.workers {
+		if !worker.enqueue(task) {
+			numDropped++
 		}
 	}
 
-	return fmt.Errorf("%w: num notifiers with problems: %d", errNotificationsSendingProblems, len(notifiersThatErrored))
+	if numDropped > 0 {
+		return fmt.Errorf("%w: num notifiers with full queues: %d", errNotificationsDropped, numDropped)
+	}
+
+	return nil
 }
 
-func (handler *notifiersHandler) notifyInRetryMode(retryNumber uint32, notifiersThatErrored map[string]OutputNotifier, messages []core.OutputMessage) {
-	for identifier, notifier := range notifiersThatErrored {
-		err := notifier.OutputMessages(messages...)
-		if err == nil {
-			delete(notifiersThatErrored, identifier)
-			continue
-		}
+// GetMetrics returns the delivery metrics for each notifier
+func (handler *notifiersHandler) GetMetrics() []core.NotifierMetrics {
+	metrics := make([]core.NotifierMetrics, 0, len(handler.workers))
+	for _, worker := range handler.workers {
+		metrics = append(metrics, worker.metrics())
+	}
+
+	return metrics
+}
 
-		log.Error("error sending notification",
-			"notifier", identifier, "retry no.", retryNumber, "error", err)
+// Close stops all workers. Messages still in queues are moved in the outbox
+func (handler *notifiersHandler) Close() error {
+	for _, worker := range handler.workers {
+		worker.close()
 	}
+
+	return nil
 }
 
 // this function will prevent the abnormal operation of this component when we have, by mistake, 2 or more notifiers that return the same name
@@ -104,6 +164,12 @@ func uniqueNotifierName(notifier OutputNotifier) string {
 	return fmt.Sprintf("%s: %p", notifier.Name(), notifier)
 }
 
+// notifierID returns an identifier that does not change between application restarts, as long as the notifiers
+// configuration does not change. It is used to match the notifier with its outbox entries
+func notifierID(index int, notifier OutputNotifier) string {
+	return fmt.Sprintf("%d-%s", index, notifier.Name())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (handler *notifiersHandler) IsInterfaceNil() bool {
 	return handler == nil
@@ -3,50 +3,177 @@ package executors
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors/disabled"
 	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestNewNotifiersHandler(t *testing.T) {
-	t.Parallel()
+type messagesCollector struct {
+	mut    sync.RWMutex
+	result map[string][]core.OutputMessage
+}
 
-	testArgs := ArgsNotifiersHandler{
-		Notifiers:          nil,
-		NumRetries:         0,
-		TimeBetweenRetries: minTimeBetweenRetries,
+func newMessagesCollector() *messagesCollector {
+	return &messagesCollector{
+		result: make(map[string][]core.OutputMessage),
 	}
+}
+
+func (collector *messagesCollector) add(name string, messages []core.OutputMessage) {
+	collector.mut.Lock()
+	collector.result[name] = append(collector.result[name], messages...)
+	collector.mut.Unlock()
+}
+
+func (collector *messagesCollector) get(name string) []core.OutputMessage {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	return collector.result[name]
+}
+
+func (collector *messagesCollector) len() int {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	return len(collector.result)
+}
+
+func createTestArgsNotifiersHandler() ArgsNotifiersHandler {
+	return ArgsNotifiersHandler{
+		Notifiers:             nil,
+		NumRetries:            0,
+		TimeBetweenRetries:    minTimeBetweenRetries,
+		MaxTimeBetweenRetries: minTimeBetweenRetries,
+		RetryJitter:           0.1,
+		SendTimeout:           time.Second,
+		QueueSize:             10,
+		Outbox:                disabled.NewDisabledOutbox(),
+		OutboxCheckInterval:   time.Second,
+	}
+}
+
+func TestNewNotifiersHandler(t *testing.T) {
+	t.Parallel()
 
 	t.Run("nil notifier should error", func(t *testing.T) {
 		t.Parallel()
 
-		localArgs := testArgs
+		localArgs := createTestArgsNotifiersHandler()
 		localArgs.Notifiers = []OutputNotifier{&mock.OutputNotifierStub{}, nil}
 		handler, err := NewNotifiersHandler(localArgs)
 		assert.Nil(t, handler)
 		assert.ErrorIs(t, err, errNilOutputNotifier)
 		assert.Contains(t, err.Error(), "at index 1")
 	})
+	t.Run("nil rate limiter should error", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &mock.OutputNotifierStub{
+			NameHandler: func() string {
+				return "notifier"
+			},
+		}
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.Notifiers = []OutputNotifier{notifier}
+		localArgs.RateLimiters = map[OutputNotifier][]RateLimiter{
+			notifier: {&mock.RateLimiterStub{}, nil},
+		}
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errNilRateLimiter)
+		assert.Contains(t, err.Error(), "at index 1 for notifier notifier")
+	})
 	t.Run("invalid time between retries should error", func(t *testing.T) {
 		t.Parallel()
 
-		localArgs := testArgs
+		localArgs := createTestArgsNotifiersHandler()
 		localArgs.TimeBetweenRetries = minTimeBetweenRetries - time.Nanosecond
 		handler, err := NewNotifiersHandler(localArgs)
 		assert.Nil(t, handler)
 		assert.ErrorIs(t, err, errInvalidTimeBetweenRetries)
 		assert.Contains(t, err.Error(), "9.999999ms, minimum: 10ms")
 	})
+	t.Run("invalid max time between retries should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.MaxTimeBetweenRetries = localArgs.TimeBetweenRetries - time.Nanosecond
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidMaxTimeBetweenRetries)
+		assert.Contains(t, err.Error(), "9.999999ms, time between retries: 10ms")
+	})
+	t.Run("invalid retry jitter should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.RetryJitter = -0.1
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidRetryJitter)
+
+		localArgs.RetryJitter = 1.1
+		handler, err = NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidRetryJitter)
+		assert.Contains(t, err.Error(), "provided: 1.1, interval: [0, 1]")
+	})
+	t.Run("invalid send timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.SendTimeout = minSendTimeout - time.Nanosecond
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidSendTimeout)
+		assert.Contains(t, err.Error(), "9.999999ms, minimum: 10ms")
+	})
+	t.Run("invalid queue size should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.QueueSize = 0
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidQueueSize)
+		assert.Contains(t, err.Error(), "provided: 0, minimum: 1")
+	})
+	t.Run("nil outbox should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.Outbox = nil
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.Equal(t, errNilOutbox, err)
+	})
+	t.Run("invalid outbox check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.OutboxCheckInterval = minOutboxCheckInterval - time.Nanosecond
+		handler, err := NewNotifiersHandler(localArgs)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidOutboxCheckInterval)
+		assert.Contains(t, err.Error(), "9.999999ms, minimum: 10ms")
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		localArgs := testArgs
+		localArgs := createTestArgsNotifiersHandler()
+		localArgs.Notifiers = []OutputNotifier{&mock.OutputNotifierStub{}, &mock.OutputNotifierStub{}}
 		handler, err := NewNotifiersHandler(localArgs)
 		assert.NotNil(t, handler)
 		assert.Nil(t, err)
+		assert.Equal(t, 2, len(handler.workers))
+
+		_ = handler.Close()
 	})
 }
 
@@ -91,8 +218,11 @@ func TestNotifiersHandler_NotifyWithRetry(t *testing.T) {
 		},
 	}
 	expectedErr := errors.New("expected error")
+	timeToProcess := time.Millisecond * 200
 
 	t.Run("no notifiers should not panic", func(t *testing.T) {
+		t.Parallel()
+
 		defer func() {
 			r := recover()
 			if r != nil {
@@ -100,12 +230,7 @@ func TestNotifiersHandler_NotifyWithRetry(t *testing.T) {
 			}
 		}()
 
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          nil,
-			NumRetries:         0,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
+		handler, _ := NewNotifiersHandler(createTestArgsNotifiersHandler())
 
 		err := handler.NotifyWithRetry("test")
 		assert.Nil(t, err)
@@ -113,246 +238,187 @@ func TestNotifiersHandler_NotifyWithRetry(t *testing.T) {
 		assert.Nil(t, err)
 		err = handler.NotifyWithRetry("test", testMessages...)
 		assert.Nil(t, err)
+
+		_ = handler.Close()
 	})
 	t.Run("should not notify if no messages are to be sent", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
+		t.Parallel()
 
-		notifiers := []OutputNotifier{
+		collector := newMessagesCollector()
+		args := createTestArgsNotifiersHandler()
+		args.Notifiers = []OutputNotifier{
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
-
+					collector.add("notifier1", messages)
 					return nil
 				},
 			},
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
-
+					collector.add("notifier2", messages)
 					return nil
 				},
 			},
 		}
-
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         0,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
+		handler, _ := NewNotifiersHandler(args)
 
 		err := handler.NotifyWithRetry("test")
 		assert.Nil(t, err)
 		err = handler.NotifyWithRetry("test", make([]core.OutputMessage, 0)...)
 		assert.Nil(t, err)
 
-		assert.Equal(t, 0, len(resultMap))
+		time.Sleep(timeToProcess)
+		_ = handler.Close()
+		assert.Equal(t, 0, collector.len())
 	})
 	t.Run("should work if no errors were found", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
+		t.Parallel()
 
-		notifiers := []OutputNotifier{
+		collector := newMessagesCollector()
+		args := createTestArgsNotifiersHandler()
+		args.Notifiers = []OutputNotifier{
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
-
+					collector.add("notifier1", messages)
 					return nil
 				},
 			},
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
-
+					collector.add("notifier2", messages)
 					return nil
 				},
 			},
 		}
-
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         0,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
+		handler, _ := NewNotifiersHandler(args)
 		err := handler.NotifyWithRetry("test", testMessages...)
 		assert.Nil(t, err)
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, testMessages, resultMap["notifier1"])
-		assert.Equal(t, testMessages, resultMap["notifier2"])
-	})
-	t.Run("should work if errors were found but with 0 retries", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
-
-		notifiers := []OutputNotifier{
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
 
-					return expectedErr
-				},
-			},
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
-
-					return nil
-				},
-			},
+		time.Sleep(timeToProcess)
+		_ = handler.Close()
+		assert.Equal(t, 2, collector.len())
+		assert.Equal(t, testMessages, collector.get("notifier1"))
+		assert.Equal(t, testMessages, collector.get("notifier2"))
+
+		metrics := handler.GetMetrics()
+		assert.Equal(t, 2, len(metrics))
+		for _, m := range metrics {
+			assert.Equal(t, uint64(1), m.NumSent)
+			assert.Zero(t, m.NumFailed)
+			assert.Zero(t, m.NumDropped)
 		}
-
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         0,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
-		err := handler.NotifyWithRetry("test", testMessages...)
-		assert.ErrorIs(t, err, errNotificationsSendingProblems)
-		assert.Contains(t, err.Error(), "num notifiers with problems: 1")
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, testMessages, resultMap["notifier1"])
-		assert.Equal(t, testMessages, resultMap["notifier2"])
 	})
-	t.Run("should work with retries if errors were found", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
+	t.Run("should retry only the notifier that errored", func(t *testing.T) {
+		t.Parallel()
 
-		notifiers := []OutputNotifier{
+		collector := newMessagesCollector()
+		args := createTestArgsNotifiersHandler()
+		args.NumRetries = 2
+		args.Notifiers = []OutputNotifier{
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
-
+					collector.add("notifier1", messages)
 					return expectedErr
 				},
 			},
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
-
+					collector.add("notifier2", messages)
 					return nil
 				},
 			},
 		}
-
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         1,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
+		handler, _ := NewNotifiersHandler(args)
 		err := handler.NotifyWithRetry("test", testMessages...)
-		assert.ErrorIs(t, err, errNotificationsSendingProblems)
-		assert.Contains(t, err.Error(), "num notifiers with problems: 1")
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, append(testMessages, testMessages...), resultMap["notifier1"])
-		assert.Equal(t, testMessages, resultMap["notifier2"])
-	})
-	t.Run("should work with retries if errors were found on all notifiers", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
-
-		notifiers := []OutputNotifier{
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
-
-					return expectedErr
-				},
-			},
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
+		assert.Nil(t, err)
 
-					return expectedErr
-				},
-			},
-		}
+		time.Sleep(timeToProcess)
+		_ = handler.Close()
+		assert.Equal(t, append(testMessages, append(testMessages, testMessages...)...), collector.get("notifier1"))
+		assert.Equal(t, testMessages, collector.get("notifier2"))
 
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         2,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
-		err := handler.NotifyWithRetry("test", testMessages...)
-		assert.ErrorIs(t, err, errNotificationsSendingProblems)
-		assert.Contains(t, err.Error(), "num notifiers with problems: 2")
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, append(testMessages, append(testMessages, testMessages...)...), resultMap["notifier1"])
-		assert.Equal(t, append(testMessages, append(testMessages, testMessages...)...), resultMap["notifier2"])
+		metrics := handler.GetMetrics()
+		assert.Equal(t, uint64(1), metrics[0].NumFailed)
+		assert.Zero(t, metrics[0].NumSent)
+		assert.Equal(t, uint64(1), metrics[1].NumSent)
+		assert.Zero(t, metrics[1].NumFailed)
 	})
-	t.Run("should work with retries & notifier recovers after first try", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
+	t.Run("a blocked notifier should not delay the caller nor the other notifiers", func(t *testing.T) {
+		t.Parallel()
 
-		numErrors := 0
-		notifiers := []OutputNotifier{
+		collector := newMessagesCollector()
+		args := createTestArgsNotifiersHandler()
+		args.QueueSize = 1
+		args.Notifiers = []OutputNotifier{
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
-
-					numErrors++
-					if numErrors == 2 {
-						return nil
-					}
-
-					return expectedErr
+					time.Sleep(time.Second * 5)
+					return nil
 				},
 			},
 			&mock.OutputNotifierStub{
 				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
-
+					collector.add("notifier2", messages)
 					return nil
 				},
 			},
 		}
+		handler, _ := NewNotifiersHandler(args)
 
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         2,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
+		start := time.Now()
 		err := handler.NotifyWithRetry("test", testMessages...)
 		assert.Nil(t, err)
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, append(testMessages, testMessages...), resultMap["notifier1"])
-		assert.Equal(t, testMessages, resultMap["notifier2"])
-	})
-	t.Run("should work with retries & notifier recovers after second try", func(t *testing.T) {
-		resultMap := make(map[string][]core.OutputMessage)
+		time.Sleep(timeToProcess) // the first notifier is now blocked in sending
+		err = handler.NotifyWithRetry("test", testMessages...)
+		assert.Nil(t, err) // the first notifier's queue now holds one task
+		err = handler.NotifyWithRetry("test", testMessages...)
+		assert.ErrorIs(t, err, errNotificationsDropped)
+		assert.Contains(t, err.Error(), "num notifiers with full queues: 1")
+		assert.Less(t, time.Since(start), time.Second)
 
-		numErrors := 0
-		notifiers := []OutputNotifier{
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier1"] = append(resultMap["notifier1"], messages...)
+		time.Sleep(timeToProcess)
+		assert.Equal(t, append(testMessages, append(testMessages, testMessages...)...), collector.get("notifier2"))
 
-					numErrors++
-					if numErrors == 3 {
-						return nil
-					}
+		metrics := handler.GetMetrics()
+		assert.Equal(t, 1, metrics[0].QueueDepth)
+		assert.Equal(t, uint64(1), metrics[0].NumDropped)
+		assert.Equal(t, uint64(3), metrics[1].NumSent)
 
-					return expectedErr
-				},
-			},
-			&mock.OutputNotifierStub{
-				OutputMessagesHandler: func(messages ...core.OutputMessage) error {
-					resultMap["notifier2"] = append(resultMap["notifier2"], messages...)
+		_ = handler.Close()
+	})
+}
 
-					return nil
-				},
-			},
-		}
+func TestNotifiersHandler_NotifyWithoutOutbox(t *testing.T) {
+	t.Parallel()
 
-		testArgs := ArgsNotifiersHandler{
-			Notifiers:          notifiers,
-			NumRetries:         2,
-			TimeBetweenRetries: minTimeBetweenRetries,
-		}
-		handler, _ := NewNotifiersHandler(testArgs)
-		err := handler.NotifyWithRetry("test", testMessages...)
-		assert.Nil(t, err)
-		assert.Equal(t, 2, len(resultMap))
-		assert.Equal(t, append(testMessages, append(testMessages, testMessages...)...), resultMap["notifier1"])
-		assert.Equal(t, testMessages, resultMap["notifier2"])
-	})
+	testMessages := []core.OutputMessage{{Identifier: "id1"}}
+
+	collector := newMessagesCollector()
+	args := createTestArgsNotifiersHandler()
+	args.Notifiers = []OutputNotifier{
+		&mock.OutputNotifierStub{
+			OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+				collector.add("notifier1", messages)
+				return errors.New("expected error")
+			},
+		},
+	}
+	args.Outbox = &mock.NotificationsOutboxStub{
+		AddHandler: func(notifierID string, caller string, messages []core.OutputMessage) error {
+			collector.add("outbox", messages)
+			return nil
+		},
+	}
+	handler, _ := NewNotifiersHandler(args)
+
+	err := handler.NotifyWithoutOutbox("test")
+	assert.Nil(t, err)
+	err = handler.NotifyWithoutOutbox("test", testMessages...)
+	assert.Nil(t, err)
+
+	time.Sleep(time.Millisecond * 200)
+	_ = handler.Close()
+	assert.Equal(t, testMessages, collector.get("notifier1"))
+	assert.Empty(t, collector.get("outbox"))
+	assert.Equal(t, uint64(1), handler.GetMetrics()[0].NumFailed)
 }
@@ -0,0 +1,68 @@
+package executors
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// retryPolicy decides if a failed notification should be retried and how much to wait before the next attempt.
+// Transient failures are retried with an exponential backoff and jitter, the delay requested by the remote service
+// (Retry-After or similar) takes precedence, capped to the maximum delay, while permanent failures (bad credentials, wrong chat ID...) are not retried
+type retryPolicy struct {
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	jitter       float64
+	randFloat    func() float64
+}
+
+func newRetryPolicy(initialDelay time.Duration, maxDelay time.Duration, jitter float64) *retryPolicy {
+	return &retryPolicy{
+		initialDelay: initialDelay,
+		maxDelay:     maxDelay,
+		jitter:       jitter,
+		randFloat:    rand.Float64,
+	}
+}
+
+// nextDelay returns the time to wait before the retry with the provided number. The second value is false if the
+// error is permanent and the sending should not be retried
+func (policy *retryPolicy) nextDelay(retry uint32, err error) (time.Duration, bool) {
+	notificationError := &core.NotificationError{}
+	if errors.As(err, &notificationError) {
+		if notificationError.Permanent {
+			return 0, false
+		}
+		if notificationError.RetryAfter > policy.maxDelay {
+			// a long delay would block the notifier's queue, the messages will be resent from the outbox
+			return policy.maxDelay, true
+		}
+		if notificationError.RetryAfter > 0 {
+			return notificationError.RetryAfter, true
+		}
+	}
+
+	delay := policy.initialDelay
+	for i := uint32(0); i < retry && delay < policy.maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > policy.maxDelay {
+		delay = policy.maxDelay
+	}
+
+	// the jitter spreads the retries of the notifiers that failed at the same time
+	delta := (policy.randFloat()*2 - 1) * policy.jitter * float64(delay)
+	delay += time.Duration(delta)
+	if delay < minTimeBetweenRetries {
+		delay = minTimeBetweenRetries
+	}
+
+	return delay, true
+}
+
+func isPermanentError(err error) bool {
+	notificationError := &core.NotificationError{}
+	return errors.As(err, &notificationError) && notificationError.Permanent
+}
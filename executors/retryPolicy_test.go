@@ -0,0 +1,99 @@
+package executors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_NextDelay(t *testing.T) {
+	t.Parallel()
+
+	genericErr := errors.New("connection reset")
+	t.Run("exponential backoff without jitter", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(time.Second, time.Second*10, 0)
+
+		expectedDelays := []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 10, time.Second * 10}
+		for retry, expectedDelay := range expectedDelays {
+			delay, shouldRetry := policy.nextDelay(uint32(retry), genericErr)
+			assert.True(t, shouldRetry)
+			assert.Equal(t, expectedDelay, delay)
+		}
+
+		delay, _ := policy.nextDelay(10000, genericErr)
+		assert.Equal(t, time.Second*10, delay)
+	})
+	t.Run("jitter should be applied in both directions", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(time.Second, time.Second*10, 0.5)
+		policy.randFloat = func() float64 {
+			return 0
+		}
+		delay, _ := policy.nextDelay(1, genericErr)
+		assert.Equal(t, time.Second, delay)
+
+		policy.randFloat = func() float64 {
+			return 1
+		}
+		delay, _ = policy.nextDelay(1, genericErr)
+		assert.Equal(t, time.Second*3, delay)
+	})
+	t.Run("delay should not go below the minimum", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(minTimeBetweenRetries, minTimeBetweenRetries, 1)
+		policy.randFloat = func() float64 {
+			return 0
+		}
+		delay, _ := policy.nextDelay(0, genericErr)
+		assert.Equal(t, minTimeBetweenRetries, delay)
+	})
+	t.Run("retry after should take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(time.Second, time.Second*60, 0.5)
+		err := fmt.Errorf("wrapped: %w", &core.NotificationError{
+			Err:        genericErr,
+			Code:       429,
+			RetryAfter: time.Second * 42,
+		})
+		delay, shouldRetry := policy.nextDelay(0, err)
+		assert.True(t, shouldRetry)
+		assert.Equal(t, time.Second*42, delay)
+	})
+	t.Run("retry after should be capped to the maximum delay", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(time.Second, time.Second*10, 0.5)
+		err := &core.NotificationError{
+			Err:        genericErr,
+			Code:       429,
+			RetryAfter: time.Hour,
+		}
+		delay, shouldRetry := policy.nextDelay(0, err)
+		assert.True(t, shouldRetry)
+		assert.Equal(t, time.Second*10, delay)
+	})
+	t.Run("permanent errors should not be retried", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newRetryPolicy(time.Second, time.Second*10, 0.5)
+		err := &core.NotificationError{
+			Err:       genericErr,
+			Code:      403,
+			Permanent: true,
+		}
+		_, shouldRetry := policy.nextDelay(0, err)
+		assert.False(t, shouldRetry)
+		assert.True(t, isPermanentError(err))
+		assert.False(t, isPermanentError(genericErr))
+		assert.False(t, isPermanentError(nil))
+	})
+}
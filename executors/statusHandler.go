@@ -3,6 +3,8 @@ package executors
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,11 +16,27 @@ import (
 const statusHandlerName = "statusHandler"
 
 type statusHandler struct {
-	name             string
-	mut              sync.Mutex
-	numErrors        uint32
-	problematicKeys  map[string]struct{}
-	notifiersHandler OutputNotifiersHandler
+	name                  string
+	mut                   sync.Mutex
+	numErrors             uint32
+	problematicKeys       map[string]struct{}
+	notifiersHandler      OutputNotifiersHandler
+	lastNotifiersFailures notifiersFailures
+	lastNumRejected       map[string]uint64
+	endpointsProviders    []monitorEndpointsProvider
+	monitorsNames         []string
+	monitorsKeysShards    map[string]map[string]uint32
+}
+
+type monitorEndpointsProvider struct {
+	monitorName string
+	provider    core.EndpointsProvider
+}
+
+type notifiersFailures struct {
+	numFailed  uint64
+	numDropped uint64
+	outboxSize int
 }
 
 // NewStatusHandler creates a new instance of type statusHandler
@@ -28,9 +46,11 @@ func NewStatusHandler(name string, notifiersHandler OutputNotifiersHandler) (*st
 	}
 
 	handler := &statusHandler{
-		notifiersHandler: notifiersHandler,
-		name:             name,
-		problematicKeys:  make(map[string]struct{}),
+		notifiersHandler:   notifiersHandler,
+		name:               name,
+		problematicKeys:    make(map[string]struct{}),
+		lastNumRejected:    make(map[string]uint64),
+		monitorsKeysShards: make(map[string]map[string]uint32),
 	}
 
 	return handler, nil
@@ -70,6 +90,33 @@ func (handler *statusHandler) CollectKeysProblems(messages []core.OutputMessage)
 	handler.mut.Unlock()
 }
 
+// CollectKeysShards will record the current shard of each BLS key monitored by a monitor, so the periodic report will
+// include the number of monitored keys in each shard. The shards are kept between reports and replaced on each call
+func (handler *statusHandler) CollectKeysShards(monitorName string, shards map[string]uint32) {
+	handler.mut.Lock()
+	_, found := handler.monitorsKeysShards[monitorName]
+	if !found {
+		handler.monitorsNames = append(handler.monitorsNames, monitorName)
+	}
+	handler.monitorsKeysShards[monitorName] = shards
+	handler.mut.Unlock()
+}
+
+// AddEndpointsProvider will add the provider of the API endpoints used by a monitor, so the periodic report will
+// include the active API endpoint
+func (handler *statusHandler) AddEndpointsProvider(monitorName string, provider core.EndpointsProvider) {
+	if check.IfNil(provider) {
+		return
+	}
+
+	handler.mut.Lock()
+	handler.endpointsProviders = append(handler.endpointsProviders, monitorEndpointsProvider{
+		monitorName: monitorName,
+		provider:    provider,
+	})
+	handler.mut.Unlock()
+}
+
 // Execute will push the notification messages and will clear the internal state of the status handler
 func (handler *statusHandler) Execute(_ context.Context) error {
 	handler.mut.Lock()
@@ -84,6 +131,17 @@ func (handler *statusHandler) Execute(_ context.Context) error {
 		handler.createProblematicKeysMessage(numProblematicKeys),
 	}
 
+	failures := handler.computeNotifiersFailures()
+	if failures.numFailed > 0 || failures.numDropped > 0 {
+		messages = append(messages, handler.createNotifiersFailuresMessage(failures))
+	}
+	if failures.outboxSize > 0 {
+		messages = append(messages, handler.createOutboxMessage(failures.outboxSize))
+	}
+	messages = append(messages, handler.createMisconfiguredNotifiersMessages()...)
+	messages = append(messages, handler.createEndpointsMessages()...)
+	messages = append(messages, handler.createKeysShardsMessages()...)
+
 	return handler.notifiersHandler.NotifyWithRetry(statusHandlerName, messages...)
 }
 
@@ -123,6 +181,143 @@ func (handler *statusHandler) createProblematicKeysMessage(numProblematicKeys in
 	return msg
 }
 
+// computeNotifiersFailures returns the number of failed and dropped notifications since the last report and the
+// current outbox size
+func (handler *statusHandler) computeNotifiersFailures() notifiersFailures {
+	current := notifiersFailures{}
+	outboxSize := 0
+	for _, metrics := range handler.notifiersHandler.GetMetrics() {
+		current.numFailed += metrics.NumFailed
+		current.numDropped += metrics.NumDropped
+		outboxSize += metrics.OutboxSize
+	}
+
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	delta := notifiersFailures{
+		numFailed:  current.numFailed - handler.lastNotifiersFailures.numFailed,
+		numDropped: current.numDropped - handler.lastNotifiersFailures.numDropped,
+		outboxSize: outboxSize,
+	}
+	handler.lastNotifiersFailures = current
+
+	return delta
+}
+
+func (handler *statusHandler) createNotifiersFailuresMessage(failures notifiersFailures) core.OutputMessage {
+	return core.OutputMessage{
+		Type:         core.WarningMessageOutputType,
+		ExecutorName: handler.name,
+		ShortIdentifier: fmt.Sprintf("%d notification(s) could not be delivered and %d were dropped because of full queues",
+			failures.numFailed, failures.numDropped),
+	}
+}
+
+func (handler *statusHandler) createOutboxMessage(outboxSize int) core.OutputMessage {
+	return core.OutputMessage{
+		Type:            core.WarningMessageOutputType,
+		ExecutorName:    handler.name,
+		ShortIdentifier: fmt.Sprintf("%d undelivered notification(s) are waiting in the outbox", outboxSize),
+	}
+}
+
+// createMisconfiguredNotifiersMessages returns one message for each notifier that had notifications permanently
+// rejected since the last report. These are not transient failures, the notifier configuration should be checked
+func (handler *statusHandler) createMisconfiguredNotifiersMessages() []core.OutputMessage {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	messages := make([]core.OutputMessage, 0)
+	for _, metrics := range handler.notifiersHandler.GetMetrics() {
+		numRejected := metrics.NumRejected - handler.lastNumRejected[metrics.Name]
+		handler.lastNumRejected[metrics.Name] = metrics.NumRejected
+		if numRejected == 0 {
+			continue
+		}
+
+		messages = append(messages, core.OutputMessage{
+			Type:         core.ErrorMessageOutputType,
+			ExecutorName: handler.name,
+			ShortIdentifier: fmt.Sprintf("notifier %s seems misconfigured, %d notification(s) were rejected, last error: %s",
+				metrics.Name, numRejected, metrics.LastRejection),
+		})
+	}
+
+	return messages
+}
+
+// createEndpointsMessages returns one message for each monitor that has an API endpoints provider. A warning is
+// generated if the monitor does not use its primary API endpoint
+func (handler *statusHandler) createEndpointsMessages() []core.OutputMessage {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	messages := make([]core.OutputMessage, 0, len(handler.endpointsProviders))
+	for _, endpointsProvider := range handler.endpointsProviders {
+		activeURL := endpointsProvider.provider.ActiveURL()
+		primaryURL := endpointsProvider.provider.PrimaryURL()
+		if activeURL == primaryURL {
+			messages = append(messages, core.OutputMessage{
+				Type:           core.InfoMessageOutputType,
+				ExecutorName:   handler.name,
+				IdentifierType: fmt.Sprintf("Monitor %s uses the API endpoint %s", endpointsProvider.monitorName, activeURL),
+			})
+			continue
+		}
+
+		messages = append(messages, core.OutputMessage{
+			Type:         core.WarningMessageOutputType,
+			ExecutorName: handler.name,
+			ShortIdentifier: fmt.Sprintf("monitor %s failed over to the API endpoint %s, the primary API endpoint %s is unavailable",
+				endpointsProvider.monitorName, activeURL, primaryURL),
+		})
+	}
+
+	return messages
+}
+
+// createKeysShardsMessages returns one message for each monitor that recorded the shards of its BLS keys, listing the
+// number of monitored keys in each shard
+func (handler *statusHandler) createKeysShardsMessages() []core.OutputMessage {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	messages := make([]core.OutputMessage, 0, len(handler.monitorsNames))
+	for _, monitorName := range handler.monitorsNames {
+		shards := handler.monitorsKeysShards[monitorName]
+		if len(shards) == 0 {
+			continue
+		}
+
+		numKeysInShards := make(map[uint32]int)
+		for _, shardID := range shards {
+			numKeysInShards[shardID]++
+		}
+		shardIDs := make([]uint32, 0, len(numKeysInShards))
+		for shardID := range numKeysInShards {
+			shardIDs = append(shardIDs, shardID)
+		}
+		sort.Slice(shardIDs, func(i, j int) bool {
+			return shardIDs[i] < shardIDs[j]
+		})
+
+		descriptions := make([]string, 0, len(shardIDs))
+		for _, shardID := range shardIDs {
+			descriptions = append(descriptions, fmt.Sprintf("%s: %d", core.ShardName(shardID), numKeysInShards[shardID]))
+		}
+
+		messages = append(messages, core.OutputMessage{
+			Type:         core.InfoMessageOutputType,
+			ExecutorName: handler.name,
+			IdentifierType: fmt.Sprintf("Monitor %s keys per shard: %s", monitorName,
+				strings.Join(descriptions, ", ")),
+		})
+	}
+
+	return messages
+}
+
 func (handler *statusHandler) createCloseMessage() core.OutputMessage {
 	msg := core.OutputMessage{
 		Type:            core.WarningMessageOutputType,
@@ -133,12 +328,13 @@ func (handler *statusHandler) createCloseMessage() core.OutputMessage {
 	return msg
 }
 
-// SendCloseMessage will send to all notifiers a close message
+// SendCloseMessage will send to all notifiers a close message. The message is not stored in the outbox, so a message
+// not delivered before the application closes will not be sent after the next start
 func (handler *statusHandler) SendCloseMessage() {
 	closeMessage := handler.createCloseMessage()
 
 	// nothing to do with the error here, the app is closing anyway, the error will be logged
-	_ = handler.notifiersHandler.NotifyWithRetry(statusHandlerName, closeMessage)
+	_ = handler.notifiersHandler.NotifyWithoutOutbox(statusHandlerName, closeMessage)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
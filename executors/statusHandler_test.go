@@ -187,12 +187,258 @@ func TestStatusHandler_Execute(t *testing.T) {
 	})
 }
 
+func TestStatusHandler_ExecuteWithNotifiersFailures(t *testing.T) {
+	t.Parallel()
+
+	sentMessages := make([]core.OutputMessage, 0)
+	metrics := []core.NotifierMetrics{
+		{
+			Name:       "notifier1",
+			NumFailed:  2,
+			NumDropped: 1,
+		},
+		{
+			Name:      "notifier2",
+			NumFailed: 1,
+		},
+	}
+	outputNotifiersHandler := &mock.OutputNotifiersHandlerStub{
+		NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+			sentMessages = append(sentMessages, messages...)
+
+			return nil
+		},
+		GetMetricsHandler: func() []core.NotifierMetrics {
+			return metrics
+		},
+	}
+
+	handler, _ := NewStatusHandler("app", outputNotifiersHandler)
+	err := handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage := core.OutputMessage{
+		Type:            core.WarningMessageOutputType,
+		ShortIdentifier: "3 notification(s) could not be delivered and 1 were dropped because of full queues",
+		ExecutorName:    "app",
+	}
+	assert.Equal(t, 3, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[2])
+
+	// only the new failures should be reported
+	sentMessages = make([]core.OutputMessage, 0)
+	metrics[1].NumFailed = 2
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage.ShortIdentifier = "1 notification(s) could not be delivered and 0 were dropped because of full queues"
+	assert.Equal(t, 3, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[2])
+
+	// no new failures, no message
+	sentMessages = make([]core.OutputMessage, 0)
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(sentMessages))
+
+	// outbox size is reported
+	sentMessages = make([]core.OutputMessage, 0)
+	metrics[0].OutboxSize = 2
+	metrics[1].OutboxSize = 3
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage.ShortIdentifier = "5 undelivered notification(s) are waiting in the outbox"
+	assert.Equal(t, 3, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[2])
+}
+
+func TestStatusHandler_ExecuteWithMisconfiguredNotifiers(t *testing.T) {
+	t.Parallel()
+
+	sentMessages := make([]core.OutputMessage, 0)
+	metrics := []core.NotifierMetrics{
+		{
+			Name:          "notifier1",
+			NumRejected:   2,
+			LastRejection: "HTTP return code is not OK, but 401",
+		},
+		{
+			Name: "notifier2",
+		},
+	}
+	outputNotifiersHandler := &mock.OutputNotifiersHandlerStub{
+		NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+			sentMessages = append(sentMessages, messages...)
+
+			return nil
+		},
+		GetMetricsHandler: func() []core.NotifierMetrics {
+			return metrics
+		},
+	}
+
+	handler, _ := NewStatusHandler("app", outputNotifiersHandler)
+	err := handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage := core.OutputMessage{
+		Type:            core.ErrorMessageOutputType,
+		ShortIdentifier: "notifier notifier1 seems misconfigured, 2 notification(s) were rejected, last error: HTTP return code is not OK, but 401",
+		ExecutorName:    "app",
+	}
+	assert.Equal(t, 3, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[2])
+
+	// only the new rejections should be reported
+	sentMessages = make([]core.OutputMessage, 0)
+	metrics[1].NumRejected = 1
+	metrics[1].LastRejection = "chat not found"
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage.ShortIdentifier = "notifier notifier2 seems misconfigured, 1 notification(s) were rejected, last error: chat not found"
+	assert.Equal(t, 3, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[2])
+
+	// no new rejections, no message
+	sentMessages = make([]core.OutputMessage, 0)
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(sentMessages))
+}
+
+func TestStatusHandler_ExecuteWithEndpointsProviders(t *testing.T) {
+	t.Parallel()
+
+	sentMessages := make([]core.OutputMessage, 0)
+	outputNotifiersHandler := &mock.OutputNotifiersHandlerStub{
+		NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+			sentMessages = append(sentMessages, messages...)
+
+			return nil
+		},
+	}
+
+	activeURL := "https://api2.example.com"
+	handler, _ := NewStatusHandler("app", outputNotifiersHandler)
+	handler.AddEndpointsProvider("mainnet", &mock.EndpointsProviderStub{
+		ActiveURLHandler: func() string {
+			return "https://api.example.com"
+		},
+		PrimaryURLHandler: func() string {
+			return "https://api.example.com"
+		},
+	})
+	handler.AddEndpointsProvider("nil provider", nil)
+	handler.AddEndpointsProvider("testnet", &mock.EndpointsProviderStub{
+		ActiveURLHandler: func() string {
+			return activeURL
+		},
+		PrimaryURLHandler: func() string {
+			return "https://api1.example.com"
+		},
+	})
+
+	err := handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessages := []core.OutputMessage{
+		{
+			Type:           core.InfoMessageOutputType,
+			IdentifierType: "Monitor mainnet uses the API endpoint https://api.example.com",
+			ExecutorName:   "app",
+		},
+		{
+			Type:            core.WarningMessageOutputType,
+			ShortIdentifier: "monitor testnet failed over to the API endpoint https://api2.example.com, the primary API endpoint https://api1.example.com is unavailable",
+			ExecutorName:    "app",
+		},
+	}
+	assert.Equal(t, 4, len(sentMessages))
+	assert.Equal(t, expectedMessages, sentMessages[2:])
+
+	// the primary endpoint recovered
+	sentMessages = make([]core.OutputMessage, 0)
+	activeURL = "https://api1.example.com"
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessage := core.OutputMessage{
+		Type:           core.InfoMessageOutputType,
+		IdentifierType: "Monitor testnet uses the API endpoint https://api1.example.com",
+		ExecutorName:   "app",
+	}
+	assert.Equal(t, 4, len(sentMessages))
+	assert.Equal(t, expectedMessage, sentMessages[3])
+}
+
+func TestStatusHandler_ExecuteWithKeysShards(t *testing.T) {
+	t.Parallel()
+
+	sentMessages := make([]core.OutputMessage, 0)
+	outputNotifiersHandler := &mock.OutputNotifiersHandlerStub{
+		NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+			sentMessages = append(sentMessages, messages...)
+
+			return nil
+		},
+	}
+
+	handler, _ := NewStatusHandler("app", outputNotifiersHandler)
+	handler.CollectKeysShards("mainnet", map[string]uint32{
+		"key1": 1,
+		"key2": core.MetachainShardID,
+		"key3": 0,
+		"key4": 1,
+	})
+	handler.CollectKeysShards("no keys", nil)
+	handler.CollectKeysShards("testnet", map[string]uint32{
+		"key5": 2,
+	})
+
+	err := handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessages := []core.OutputMessage{
+		{
+			Type:           core.InfoMessageOutputType,
+			IdentifierType: "Monitor mainnet keys per shard: shard 0: 1, shard 1: 2, metachain: 1",
+			ExecutorName:   "app",
+		},
+		{
+			Type:           core.InfoMessageOutputType,
+			IdentifierType: "Monitor testnet keys per shard: shard 2: 1",
+			ExecutorName:   "app",
+		},
+	}
+	assert.Equal(t, 4, len(sentMessages))
+	assert.Equal(t, expectedMessages, sentMessages[2:])
+
+	// the shards are kept between reports and replaced on each collection
+	sentMessages = make([]core.OutputMessage, 0)
+	handler.CollectKeysShards("mainnet", map[string]uint32{
+		"key1": 0,
+	})
+	err = handler.Execute(context.Background())
+	assert.Nil(t, err)
+
+	expectedMessages[0].IdentifierType = "Monitor mainnet keys per shard: shard 0: 1"
+	assert.Equal(t, 4, len(sentMessages))
+	assert.Equal(t, expectedMessages, sentMessages[2:])
+}
+
 func TestStatusHandler_SendCloseMessage(t *testing.T) {
 	t.Parallel()
 
 	sentMessages := make([]core.OutputMessage, 0)
 	outputNotifiersHandler := &mock.OutputNotifiersHandlerStub{
 		NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+			assert.Fail(t, "the close message should not be stored in the outbox")
+
+			return nil
+		},
+		NotifyWithoutOutboxHandler: func(caller string, messages ...core.OutputMessage) error {
 			sentMessages = append(sentMessages, messages...)
 
 			return nil
@@ -200,7 +446,6 @@ func TestStatusHandler_SendCloseMessage(t *testing.T) {
 	}
 
 	notifier, _ := NewStatusHandler("app", outputNotifiersHandler)
-	sentMessages = make([]core.OutputMessage, 0) // reset the constructor sent messages
 
 	notifier.SendCloseMessage()
 
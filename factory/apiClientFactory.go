@@ -0,0 +1,60 @@
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	httpSDK "github.com/multiversx/mx-sdk-go/core/http"
+)
+
+const (
+	defaultApiRequestTimeout         = time.Second * 30
+	defaultApiUnhealthyRetryInterval = time.Minute
+)
+
+// NewAPIClient creates the HTTP client that queries the ApiURL of a monitor and fails over to the FallbackApiURLs. The
+// requests are sent using the provided HTTP client or, if nil, using the default HTTP client
+func NewAPIClient(cfg config.BLSKeysMonitorConfig, httpClient *http.Client) (APIClient, error) {
+	client, err := createFailoverHTTPClient(cfg, httpClient, apiURLs(cfg), cfg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the API URLs of monitor %s", err, cfg.Name)
+	}
+
+	return client, nil
+}
+
+func apiURLs(cfg config.BLSKeysMonitorConfig) []string {
+	return append([]string{cfg.ApiURL}, cfg.FallbackApiURLs...)
+}
+
+func createFailoverHTTPClient(cfg config.BLSKeysMonitorConfig, httpClient *http.Client, urls []string, name string) (APIClient, error) {
+	endpoints := make([]clients.Endpoint, 0, len(urls))
+	for _, url := range urls {
+		endpoints = append(endpoints, clients.Endpoint{
+			URL:    url,
+			Client: httpSDK.NewHttpClientWrapper(httpClient, url),
+		})
+	}
+
+	requestTimeout := defaultApiRequestTimeout
+	if cfg.ApiRequestTimeoutInSeconds > 0 {
+		requestTimeout = time.Duration(cfg.ApiRequestTimeoutInSeconds) * time.Second
+	}
+	unhealthyRetryInterval := defaultApiUnhealthyRetryInterval
+	if cfg.ApiUnhealthyRetryIntervalInSeconds > 0 {
+		unhealthyRetryInterval = time.Duration(cfg.ApiUnhealthyRetryIntervalInSeconds) * time.Second
+	}
+
+	args := clients.ArgsFailoverHTTPClient{
+		Endpoints:              endpoints,
+		RequestTimeout:         requestTimeout,
+		UnhealthyRetryInterval: unhealthyRetryInterval,
+		TimeFunc:               time.Now,
+		Name:                   name,
+	}
+
+	return clients.NewFailoverHTTPClient(args)
+}
@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty API URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewAPIClient(config.BLSKeysMonitorConfig{
+			Name:            "test",
+			FallbackApiURLs: []string{"https://api.example.com"},
+		}, nil)
+		assert.Nil(t, client)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for the API URLs of monitor test")
+	})
+	t.Run("should fail over to the fallback API URL", func(t *testing.T) {
+		t.Parallel()
+
+		primaryServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusBadGateway)
+		}))
+		defer primaryServer.Close()
+
+		fallbackServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			_, _ = rw.Write([]byte("response"))
+		}))
+		defer fallbackServer.Close()
+
+		client, err := NewAPIClient(config.BLSKeysMonitorConfig{
+			Name:            "test",
+			ApiURL:          primaryServer.URL,
+			FallbackApiURLs: []string{fallbackServer.URL},
+		}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, primaryServer.URL, client.PrimaryURL())
+		assert.Equal(t, primaryServer.URL, client.ActiveURL())
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "response", string(body))
+		assert.Equal(t, fallbackServer.URL, client.ActiveURL())
+	})
+	t.Run("should use the provided HTTP client", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "key", req.Header.Get("X-Api-Key"))
+			_, _ = rw.Write([]byte("response"))
+		}))
+		defer testServer.Close()
+
+		httpClient, _ := NewHTTPClient(config.HTTPClientConfig{Headers: map[string]string{"X-Api-Key": "key"}}, config.CredentialsConfig{})
+		client, err := NewAPIClient(config.BLSKeysMonitorConfig{ApiURL: testServer.URL}, httpClient)
+		assert.Nil(t, err)
+
+		body, statusCode, err := client.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "response", string(body))
+	})
+}
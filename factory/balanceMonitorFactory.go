@@ -0,0 +1,153 @@
+package factory
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/checkers"
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/monitor"
+)
+
+const (
+	egldTicker   = "EGLD"
+	egldDecimals = 18
+)
+
+// NewBalanceMonitor will create an account balances monitor, querying the configured accounts through the address
+// endpoints of the API
+func NewBalanceMonitor(
+	cfg config.BalanceMonitorConfig,
+	snoozeConfig config.AlarmSnoozeConfig,
+	credentials config.CredentialsConfig,
+	notifiersHandler OutputNotifiersHandler,
+	statusHandler executors.StatusHandler,
+) (Monitor, error) {
+	accounts, err := createMonitoredAccounts(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := NewHTTPClient(cfg.HTTPClient, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the HTTP client of monitor %s", err, cfg.Name)
+	}
+
+	balanceQuerier, err := interactors.NewAccountBalanceInteractor(clients.NewHTTPClientWrapper(httpClient, cfg.ApiURL))
+	if err != nil {
+		return nil, err
+	}
+
+	accountsFilter, err := NewBLSKeysFilter(snoozeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	argsExecutor := executors.ArgsBalanceExecutor{
+		OutputNotifiersHandler: notifiersHandler,
+		StatusHandler:          statusHandler,
+		AccountsFilter:         accountsFilter,
+		BalanceQuerier:         balanceQuerier,
+		Accounts:               accounts,
+		Name:                   cfg.Name,
+		ExplorerURL:            cfg.ExplorerURL,
+	}
+	executor, err := executors.NewBalanceExecutor(argsExecutor)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return monitor.NewBLSKeysMonitor(
+		executor,
+		time.Duration(cfg.PollingIntervalInSeconds)*time.Second,
+		cfg.Name)
+}
+
+func createMonitoredAccounts(cfg config.BalanceMonitorConfig) ([]executors.MonitoredAccount, error) {
+	accounts := make([]executors.MonitoredAccount, 0, len(cfg.Accounts))
+	existing := make(map[string]struct{})
+	for index, accountConfig := range cfg.Accounts {
+		_, err := addressPubkeyConverter.Decode(accountConfig.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s at index %d for monitor %s", errInvalidAccountAddress,
+				err.Error(), index, cfg.Name)
+		}
+		key := accountConfig.Address + "/" + accountConfig.Token
+		_, found := existing[key]
+		if found {
+			return nil, fmt.Errorf("%w %s, token %s for monitor %s", errDuplicatedAccount,
+				accountConfig.Address, accountConfig.Token, cfg.Name)
+		}
+		existing[key] = struct{}{}
+
+		checker, err := createBalanceChecker(accountConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%w at index %d for monitor %s", err, index, cfg.Name)
+		}
+
+		accounts = append(accounts, executors.MonitoredAccount{
+			Address: accountConfig.Address,
+			Token:   accountConfig.Token,
+			Label:   accountConfig.Label,
+			Checker: checker,
+		})
+	}
+
+	return accounts, nil
+}
+
+func createBalanceChecker(accountConfig config.BalanceAccountConfig) (executors.BalanceChecker, error) {
+	ticker := accountConfig.Token
+	decimals := accountConfig.Decimals
+	if len(ticker) == 0 {
+		ticker = egldTicker
+		decimals = egldDecimals
+	}
+
+	minBalance, err := convertToDenomination(accountConfig.MinBalance, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the minimum balance", err)
+	}
+	maxChange, err := convertToDenomination(accountConfig.MaxChange, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the maximum change", err)
+	}
+
+	return checkers.NewBalanceChecker(checkers.ArgsBalanceChecker{
+		MinBalance: minBalance,
+		MaxChange:  maxChange,
+		Decimals:   decimals,
+		Ticker:     ticker,
+	})
+}
+
+// convertToDenomination converts the amount expressed in tokens to the smallest denomination. The conversion is done on
+// the decimal representation of the value, so 0.1 EGLD is exactly 10^17, the extra decimals being truncated
+func convertToDenomination(value float64, decimals uint32) (*big.Int, error) {
+	if value < 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidAmount, value)
+	}
+
+	parts := strings.SplitN(strconv.FormatFloat(value, 'f', -1, 64), ".", 2)
+	fractional := ""
+	if len(parts) == 2 {
+		fractional = parts[1]
+	}
+	if len(fractional) > int(decimals) {
+		fractional = fractional[:decimals]
+	}
+	fractional += strings.Repeat("0", int(decimals)-len(fractional))
+
+	result, ok := big.NewInt(0).SetString(parts[0]+fractional, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", errInvalidAmount, value)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package factory
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestBalanceMonitorConfig() config.BalanceMonitorConfig {
+	return config.BalanceMonitorConfig{
+		Name:                     "test",
+		ApiURL:                   "http://127.0.0.1:8080",
+		PollingIntervalInSeconds: 1,
+		Accounts: []config.BalanceAccountConfig{
+			{
+				Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+				Label:      "owner",
+				MinBalance: 0.5,
+				MaxChange:  10,
+			},
+			{
+				Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+				Token:      "USDC-c76f1f",
+				Decimals:   6,
+				MinBalance: 100,
+			},
+		},
+	}
+}
+
+func TestNewBalanceMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid account address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.Accounts[1].Address = "invalid"
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errInvalidAccountAddress)
+		assert.Contains(t, err.Error(), "at index 1")
+		assert.Nil(t, monitor)
+	})
+	t.Run("duplicated account should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.Accounts[1].Token = ""
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errDuplicatedAccount)
+		assert.Nil(t, monitor)
+	})
+	t.Run("negative threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.Accounts[1].MaxChange = -1
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errInvalidAmount)
+		assert.Contains(t, err.Error(), "maximum change at index 1")
+		assert.Nil(t, monitor)
+	})
+	t.Run("no accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.Accounts = nil
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid HTTP client config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.HTTPClient.CredentialsName = "missing"
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errHTTPClientCredentialsNotFound)
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		cfg.PollingIntervalInSeconds = 0
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Nil(t, monitor)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestBalanceMonitorConfig()
+		monitor, err := NewBalanceMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, monitor)
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+}
+
+func TestConvertToDenomination(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative value should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := convertToDenomination(-0.1, 18)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errInvalidAmount)
+	})
+	t.Run("should convert", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			value    float64
+			decimals uint32
+			expected string
+		}{
+			{value: 0, decimals: 18, expected: "0"},
+			{value: 0.1, decimals: 18, expected: "100000000000000000"},
+			{value: 2500, decimals: 18, expected: "2500000000000000000000"},
+			{value: 1.25, decimals: 6, expected: "1250000"},
+			{value: 1.2345678, decimals: 2, expected: "123"},
+			{value: 15, decimals: 0, expected: "15"},
+		}
+		for _, testCase := range testCases {
+			expected, _ := big.NewInt(0).SetString(testCase.expected, 10)
+			result, err := convertToDenomination(testCase.value, testCase.decimals)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, result, "value %v, decimals %d", testCase.value, testCase.decimals)
+		}
+	})
+}
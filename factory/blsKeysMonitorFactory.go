@@ -1,44 +1,124 @@
 package factory
 
 import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-keys-monitor-go/checkers"
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
 	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors/disabled"
 	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
 	"github.com/multiversx/mx-chain-keys-monitor-go/monitor"
 	"github.com/multiversx/mx-chain-keys-monitor-go/parsers"
-	"github.com/multiversx/mx-sdk-go/core/http"
 )
 
-const timeBetweenBLSKeysFetch = time.Second
+const (
+	initialListFetchTimeout                  = time.Minute
+	primarySourceName                        = "ApiURL"
+	defaultBLSKeysFetchMaxConcurrentRequests = 4
+	defaultBLSKeysFetchRequestsPerSecond     = 1.0
+)
+
+// BLSKeysMonitorIdentities holds the identities loaded for a BLS keys monitor, before the monitor is created, so the
+// identities of all monitors can be checked together
+type BLSKeysMonitorIdentities struct {
+	Config         config.BLSKeysMonitorConfig
+	Loader         IdentitiesLoader
+	Identities     *core.IdentitiesHolder
+	ListURLFetcher executors.Executor
+}
+
+// LoadBLSKeysMonitorIdentities downloads the list of the monitor, if the list URL is set, and loads the identities from
+// all the configured sources
+func LoadBLSKeysMonitorIdentities(cfg config.BLSKeysMonitorConfig, credentials config.CredentialsConfig) (*BLSKeysMonitorIdentities, error) {
+	listURLFetcher, err := createListURLFetcher(cfg, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	loader, err := NewIdentitiesLoader(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := loader.LoadIdentities()
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return &BLSKeysMonitorIdentities{
+		Config:         cfg,
+		Loader:         loader,
+		Identities:     identities,
+		ListURLFetcher: listURLFetcher,
+	}, nil
+}
 
-// NewBLSKeysMonitor will create a BLS keys monitor based on the configs & other internal components
+// NewBLSKeysMonitor will create a BLS keys monitor based on the loaded identities, the configs & other internal components
 func NewBLSKeysMonitor(
-	cfg config.BLSKeysMonitorConfig,
+	monitorIdentities *BLSKeysMonitorIdentities,
 	snoozeConfig config.AlarmSnoozeConfig,
+	credentials config.CredentialsConfig,
 	notifiersHandler OutputNotifiersHandler,
 	statusHandler executors.StatusHandler,
+	statisticsCache StatisticsCache,
 ) (Monitor, error) {
-	parser := parsers.NewListParser()
-	intentitiesHolder, err := parser.ParseFile(cfg.ListFile)
+	if monitorIdentities == nil {
+		return nil, errNilMonitorIdentities
+	}
+
+	cfg := monitorIdentities.Config
+	listURLFetcher := monitorIdentities.ListURLFetcher
+	loader := monitorIdentities.Loader
+	identitiesHolder := monitorIdentities.Identities
+
+	// the same thresholds resolver is used by all the checkers of the monitor
+	thresholdsResolver, err := NewThresholdsResolver(cfg, identitiesHolder)
 	if err != nil {
 		return nil, err
 	}
 
-	ratingsChecker, err := checkers.NewBLSRatingsChecker(intentitiesHolder.BlsHexKeys, cfg.Name, cfg.AlarmDeltaRatingDrop)
+	argsRatingsChecker := checkers.ArgsBLSRatingsChecker{
+		Name:               cfg.Name,
+		Identities:         identitiesHolder,
+		ThresholdsResolver: thresholdsResolver,
+	}
+	ratingsChecker, err := checkers.NewBLSRatingsChecker(argsRatingsChecker)
 	if err != nil {
 		return nil, err
 	}
 
-	httpWrapper := http.NewHttpClientWrapper(nil, cfg.ApiURL)
-	interactor, err := interactors.NewValidatorStatisticsInteractor(httpWrapper)
+	httpClient, err := NewHTTPClient(cfg.HTTPClient, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the HTTP client of monitor %s", err, cfg.Name)
+	}
+
+	apiClient, err := NewAPIClient(cfg, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
-	fetcher, err := interactors.NewBLSKeysFetcher(httpWrapper, intentitiesHolder.Addresses, timeBetweenBLSKeysFetch)
+	interactor, err := interactors.NewValidatorStatisticsInteractor(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// the monitors configured with the same API URLs and HTTP client will share the validator statistics, queried with
+	// the API client of the first one
+	statisticsQuerier, statisticsEndpointsProvider, err := statisticsCache.Querier(
+		createStatisticsCacheKey(apiURLs(cfg), cfg), interactor, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	fetcher, err := NewBLSKeysFetcher(cfg, apiClient, identitiesHolder.Addresses)
 	if err != nil {
 		return nil, err
 	}
@@ -48,13 +128,67 @@ func NewBLSKeysMonitor(
 		return nil, err
 	}
 
+	networkAnomalyDetector, err := NewNetworkAnomalyDetector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	quorumChecker, err := NewQuorumChecker(cfg, httpClient, ratingsChecker, statisticsCache)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatChecker, err := NewHeartbeatChecker(cfg, httpClient, apiClient, identitiesHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionChecker, err := NewAuctionChecker(cfg, httpClient, apiClient, identitiesHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	argsShardChecker := checkers.ArgsShardChecker{
+		Name:       cfg.Name,
+		Identities: identitiesHolder,
+	}
+	shardChecker, err := checkers.NewShardChecker(argsShardChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	peersRatingChecker, err := NewPeersRatingChecker(cfg, identitiesHolder, thresholdsResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	epochTracker, err := NewEpochTracker(cfg, httpClient, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	epochReporter, err := NewEpochReporter(cfg, identitiesHolder)
+	if err != nil {
+		return nil, err
+	}
+
 	argsExecutor := executors.ArgsBLSKeysExecutor{
 		OutputNotifiersHandler:     notifiersHandler,
 		RatingsChecker:             ratingsChecker,
-		ValidatorStatisticsQuerier: interactor,
+		ValidatorStatisticsQuerier: statisticsQuerier,
 		BlsKeysFetcher:             fetcher,
 		StatusHandler:              statusHandler,
 		BLSKeysFilter:              blsKeysFilter,
+		NetworkAnomalyDetector:     networkAnomalyDetector,
+		QuorumChecker:              quorumChecker,
+		HeartbeatChecker:           heartbeatChecker,
+		AuctionChecker:             auctionChecker,
+		ShardChecker:               shardChecker,
+		PeersRatingChecker:         peersRatingChecker,
+		ThresholdsResolver:         thresholdsResolver,
+		EpochTracker:               epochTracker,
+		EpochReporter:              epochReporter,
+		IdentitiesMetadata:         identitiesHolder.Metadata,
 		Name:                       cfg.Name,
 		ExplorerURL:                cfg.ExplorerURL,
 	}
@@ -64,8 +198,491 @@ func NewBLSKeysMonitor(
 		return nil, err
 	}
 
-	return monitor.NewBLSKeysMonitor(
+	statusHandler.AddEndpointsProvider(cfg.Name, statisticsEndpointsProvider)
+
+	reloadInterval := cfg.ListReloadIntervalInSeconds
+	if reloadInterval == 0 && !check.IfNil(listURLFetcher) {
+		// the downloaded list should be applied even if the reload of the list file is disabled
+		reloadInterval = cfg.ListURLRefreshIntervalInSeconds
+	}
+	if reloadInterval == 0 || len(loader.Filenames()) == 0 {
+		return monitor.NewBLSKeysMonitor(
+			executor,
+			time.Duration(cfg.PollingIntervalInSeconds)*time.Second,
+			cfg.Name)
+	}
+
+	argsReloader := executors.ArgsListFileReloader{
+		ListFiles: loader.Filenames(),
+		Loader:    loader,
+		// the thresholds resolver is the first one as it can reject the new identities
+		IdentitiesHandlers:     []executors.IdentitiesHandler{thresholdsResolver, ratingsChecker, fetcher, heartbeatChecker, auctionChecker, shardChecker, peersRatingChecker, epochReporter, executor},
+		OutputNotifiersHandler: notifiersHandler,
+		InitialIdentities:      identitiesHolder,
+		Name:                   cfg.Name,
+	}
+	reloader, err := executors.NewListFileReloader(argsReloader)
+	if err != nil {
+		return nil, err
+	}
+
+	blsKeysMonitor, err := monitor.NewBLSKeysMonitor(
 		executor,
 		time.Duration(cfg.PollingIntervalInSeconds)*time.Second,
 		cfg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	reloaderMonitor, err := monitor.NewBLSKeysMonitor(
+		reloader,
+		time.Duration(reloadInterval)*time.Second,
+		cfg.Name+" list reloader")
+	if err != nil {
+		_ = blsKeysMonitor.Close()
+		return nil, err
+	}
+
+	if check.IfNil(listURLFetcher) {
+		return monitor.NewMonitorsGroup(blsKeysMonitor, reloaderMonitor), nil
+	}
+
+	fetcherMonitor, err := monitor.NewBLSKeysMonitor(
+		listURLFetcher,
+		time.Duration(cfg.ListURLRefreshIntervalInSeconds)*time.Second,
+		cfg.Name+" list URL fetcher")
+	if err != nil {
+		_ = blsKeysMonitor.Close()
+		_ = reloaderMonitor.Close()
+		return nil, err
+	}
+
+	return monitor.NewMonitorsGroup(blsKeysMonitor, reloaderMonitor, fetcherMonitor), nil
+}
+
+// NewThresholdsResolver creates the component that resolves the thresholds and the enabled checks of each BLS key,
+// shared by all the checkers of the monitor
+func NewThresholdsResolver(cfg config.BLSKeysMonitorConfig, identities *core.IdentitiesHolder) (ThresholdsResolver, error) {
+	keyOverrides, labelOverrides, err := createThresholdsOverrides(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	args := checkers.ArgsThresholdsResolver{
+		Name:                 cfg.Name,
+		AlarmDeltaRatingDrop: cfg.AlarmDeltaRatingDrop,
+		Identities:           identities,
+		KeyOverrides:         keyOverrides,
+		LabelOverrides:       labelOverrides,
+	}
+	resolver, err := checkers.NewThresholdsResolver(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return resolver, nil
+}
+
+// createThresholdsOverrides splits the configured overrides in the BLS keys overrides and the labels overrides. The
+// BLS keys should be valid hex BLS keys, the labels are checked against the identities by the thresholds resolver, on
+// each reload
+func createThresholdsOverrides(cfg config.BLSKeysMonitorConfig) (map[string]core.ThresholdsOverride, map[string]core.ThresholdsOverride, error) {
+	keyOverrides := make(map[string]core.ThresholdsOverride)
+	labelOverrides := make(map[string]core.ThresholdsOverride)
+	for index, overrideConfig := range cfg.Overrides {
+		hasBLSKey := len(overrideConfig.BLSKey) > 0
+		hasLabel := len(overrideConfig.Label) > 0
+		if hasBLSKey == hasLabel {
+			return nil, nil, fmt.Errorf("%w at index %d for monitor %s: exactly one of BLSKey or Label should be set",
+				errInvalidThresholdsOverride, index, cfg.Name)
+		}
+		if hasBLSKey && !isHexBLSKey(overrideConfig.BLSKey) {
+			return nil, nil, fmt.Errorf("%w at index %d for monitor %s: %s is not a hex BLS key",
+				errInvalidThresholdsOverride, index, cfg.Name, overrideConfig.BLSKey)
+		}
+
+		override := core.ThresholdsOverride{
+			AlarmDeltaRatingDrop: overrideConfig.AlarmDeltaRatingDrop,
+			JailThreshold:        overrideConfig.JailThreshold,
+			EnabledChecks:        overrideConfig.EnabledChecks,
+		}
+
+		overrides, identifier := labelOverrides, overrideConfig.Label
+		if hasBLSKey {
+			overrides, identifier = keyOverrides, overrideConfig.BLSKey
+		}
+
+		_, exists := overrides[identifier]
+		if exists {
+			return nil, nil, fmt.Errorf("%w for %s in monitor %s", errDuplicatedThresholdsOverride, identifier, cfg.Name)
+		}
+
+		overrides[identifier] = override
+	}
+
+	return keyOverrides, labelOverrides, nil
+}
+
+func isHexBLSKey(blsKey string) bool {
+	if len(blsKey) != core.BLSHexKeyLen {
+		return false
+	}
+
+	_, err := hex.DecodeString(blsKey)
+
+	return err == nil
+}
+
+// NewIdentitiesLoader creates the component that loads the monitored identities from the list file, from the cache
+// file of the list URL and from the PEM files and directories
+func NewIdentitiesLoader(cfg config.BLSKeysMonitorConfig) (IdentitiesLoader, error) {
+	sources := make([]parsers.IdentitiesSource, 0, 2)
+	if len(cfg.ListFile) > 0 {
+		parser, err := NewIdentitiesParser(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, parsers.IdentitiesSource{
+			Filename: cfg.ListFile,
+			Parser:   parser,
+		})
+	}
+	if len(cfg.ListURL) > 0 {
+		parser, err := NewListURLCacheParser(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, parsers.IdentitiesSource{
+			Filename: cfg.ListURLCacheFile,
+			Parser:   parser,
+		})
+	}
+
+	args := parsers.ArgsIdentitiesLoader{
+		Sources:  sources,
+		PemFiles: cfg.PemFiles,
+		PemDirs:  cfg.PemDirs,
+	}
+
+	return parsers.NewIdentitiesLoader(args)
+}
+
+// createListURLFetcher creates the list URL fetcher, if the list URL is set, and downloads the list. The monitor can
+// start if the list could not be downloaded only if a previously downloaded list exists in the cache file
+func createListURLFetcher(cfg config.BLSKeysMonitorConfig, credentials config.CredentialsConfig) (executors.Executor, error) {
+	if len(cfg.ListURL) == 0 {
+		return nil, nil
+	}
+
+	parser, err := NewListURLCacheParser(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the list URL is usually hosted elsewhere, so only the transport options of the monitor's HTTP client are used,
+	// without the headers and the authorization meant for the API
+	listHTTPClientConfig := cfg.HTTPClient
+	listHTTPClientConfig.Headers = nil
+	listHTTPClientConfig.CredentialsName = ""
+	httpClient, err := NewHTTPClient(listHTTPClientConfig, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the list URL of monitor %s", err, cfg.Name)
+	}
+
+	argsFetcher := interactors.ArgsListURLFetcher{
+		HTTPClient:  clients.NewHTTPClientWrapper(httpClient, cfg.ListURL),
+		Parser:      parser,
+		CacheFile:   cfg.ListURLCacheFile,
+		BearerToken: getListURLBearerToken(cfg.Name, credentials),
+		Name:        cfg.Name,
+	}
+	fetcher, err := interactors.NewListURLFetcher(argsFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), initialListFetchTimeout)
+	defer cancel()
+
+	err = fetcher.Execute(ctx)
+	if err != nil && !fetcher.HasCache() {
+		return nil, fmt.Errorf("%w while fetching the list of monitor %s and no cached list exists", err, cfg.Name)
+	}
+
+	return fetcher, nil
+}
+
+func getListURLBearerToken(monitorName string, credentials config.CredentialsConfig) string {
+	for _, listURLCredentials := range credentials.ListURLs {
+		if listURLCredentials.Name == monitorName {
+			return listURLCredentials.BearerToken
+		}
+	}
+
+	return ""
+}
+
+// NewBLSKeysFetcher creates the component that fetches the BLS keys of the monitored addresses, applying the default
+// values for the concurrent requests and for the requests per second, if not set
+func NewBLSKeysFetcher(cfg config.BLSKeysMonitorConfig, apiClient APIClient, addresses []core.Address) (BLSKeysFetcher, error) {
+	maxConcurrentRequests := cfg.BLSKeysFetchMaxConcurrentRequests
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = defaultBLSKeysFetchMaxConcurrentRequests
+	}
+	requestsPerSecond := cfg.BLSKeysFetchRequestsPerSecond
+	if requestsPerSecond == 0 {
+		requestsPerSecond = defaultBLSKeysFetchRequestsPerSecond
+	}
+
+	rateLimiter, err := createTokenBucket(requestsPerSecond*float64(time.Minute/time.Second), 1)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the BLS keys fetcher of monitor %s", err, cfg.Name)
+	}
+
+	args := interactors.ArgsBLSKeysFetcher{
+		HTTPClientWrapper:     apiClient,
+		Addresses:             addresses,
+		RateLimiter:           rateLimiter,
+		MaxConcurrentRequests: maxConcurrentRequests,
+		RefreshInterval:       time.Duration(cfg.BLSKeysRefreshIntervalInSeconds) * time.Second,
+		TimeFunc:              time.Now,
+	}
+	fetcher, err := interactors.NewBLSKeysFetcher(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return fetcher, nil
+}
+
+// NewNetworkAnomalyDetector creates a new network anomaly detector based on the provided configuration. If the
+// network-wide degraded percent is not set, a disabled component is returned
+func NewNetworkAnomalyDetector(cfg config.BLSKeysMonitorConfig) (executors.NetworkAnomalyDetector, error) {
+	if cfg.NetworkWideDegradedPercent == 0 {
+		return disabled.NewDisabledNetworkAnomalyDetector(), nil
+	}
+
+	return checkers.NewNetworkAnomalyDetector(cfg.Name, cfg.AlarmDeltaRatingDrop, cfg.NetworkWideDegradedPercent)
+}
+
+// NewQuorumChecker creates the component that confirms the problematic BLS keys on the additional validator statistics
+// sources. If no additional source is set, a disabled component is returned
+func NewQuorumChecker(
+	cfg config.BLSKeysMonitorConfig,
+	httpClient *http.Client,
+	ratingsChecker checkers.RatingsChecker,
+	statisticsCache StatisticsCache,
+) (executors.QuorumChecker, error) {
+	if len(cfg.StatisticsSources) == 0 && cfg.StatisticsQuorum == 0 {
+		return disabled.NewDisabledQuorumChecker(), nil
+	}
+
+	sources := make([]checkers.StatisticsSource, 0, len(cfg.StatisticsSources))
+	for _, sourceConfig := range cfg.StatisticsSources {
+		client, err := createFailoverHTTPClient(cfg, httpClient, []string{sourceConfig.ApiURL}, cfg.Name+" "+sourceConfig.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the statistics source %s of monitor %s", err, sourceConfig.Name, cfg.Name)
+		}
+
+		interactor, err := interactors.NewValidatorStatisticsInteractor(client)
+		if err != nil {
+			return nil, err
+		}
+
+		querier, _, err := statisticsCache.Querier(createStatisticsCacheKey([]string{sourceConfig.ApiURL}, cfg), interactor, client)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, checkers.StatisticsSource{
+			Name:    sourceConfig.Name,
+			Querier: querier,
+		})
+	}
+
+	args := checkers.ArgsQuorumChecker{
+		Name:              cfg.Name,
+		PrimarySourceName: primarySourceName,
+		Sources:           sources,
+		RatingsChecker:    ratingsChecker,
+		Quorum:            cfg.StatisticsQuorum,
+	}
+	checker, err := checkers.NewQuorumChecker(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return checker, nil
+}
+
+// NewHeartbeatChecker creates the component that checks the heartbeats of the monitored BLS keys. The heartbeats are
+// queried from the heartbeat API URL, if set, otherwise from the monitor's API client. If the heartbeat check is not
+// enabled, a disabled component is returned
+func NewHeartbeatChecker(
+	cfg config.BLSKeysMonitorConfig,
+	httpClient *http.Client,
+	apiClient APIClient,
+	identities *core.IdentitiesHolder,
+) (HeartbeatChecker, error) {
+	if !cfg.Heartbeat.Enabled {
+		return disabled.NewDisabledHeartbeatChecker(), nil
+	}
+
+	client := apiClient
+	if len(cfg.Heartbeat.ApiURL) > 0 {
+		var err error
+		client, err = createFailoverHTTPClient(cfg, httpClient, []string{cfg.Heartbeat.ApiURL}, cfg.Name+" heartbeat")
+		if err != nil {
+			return nil, fmt.Errorf("%w for the heartbeat API URL of monitor %s", err, cfg.Name)
+		}
+	}
+
+	querier, err := interactors.NewHeartbeatStatusInteractor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	args := checkers.ArgsHeartbeatChecker{
+		Name:               cfg.Name,
+		Querier:            querier,
+		Identities:         identities,
+		ExpectedAppVersion: cfg.Heartbeat.ExpectedAppVersion,
+		ExpectedPeerTypes:  cfg.Heartbeat.ExpectedPeerTypes,
+	}
+	checker, err := checkers.NewHeartbeatChecker(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return checker, nil
+}
+
+// NewAuctionChecker creates the component that checks the staking auction selection of the monitored BLS keys. The
+// auction list is queried from the auction API URL, if set, otherwise from the monitor's API client. If the auction
+// check is not enabled, a disabled component is returned
+func NewAuctionChecker(
+	cfg config.BLSKeysMonitorConfig,
+	httpClient *http.Client,
+	apiClient APIClient,
+	identities *core.IdentitiesHolder,
+) (AuctionChecker, error) {
+	if !cfg.Auction.Enabled {
+		return disabled.NewDisabledAuctionChecker(), nil
+	}
+
+	client := apiClient
+	if len(cfg.Auction.ApiURL) > 0 {
+		var err error
+		client, err = createFailoverHTTPClient(cfg, httpClient, []string{cfg.Auction.ApiURL}, cfg.Name+" auction")
+		if err != nil {
+			return nil, fmt.Errorf("%w for the auction API URL of monitor %s", err, cfg.Name)
+		}
+	}
+
+	querier, err := interactors.NewAuctionListInteractor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	args := checkers.ArgsAuctionChecker{
+		Name:       cfg.Name,
+		Querier:    querier,
+		Identities: identities,
+	}
+	checker, err := checkers.NewAuctionChecker(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return checker, nil
+}
+
+// NewEpochTracker creates the component that tracks the current epoch. The epoch is queried from the epoch API URL, if
+// set, otherwise from the monitor's API client. If the epoch tracking is not enabled, a disabled component is returned
+func NewEpochTracker(
+	cfg config.BLSKeysMonitorConfig,
+	httpClient *http.Client,
+	apiClient APIClient,
+) (executors.EpochTracker, error) {
+	if !cfg.Epoch.Enabled {
+		return disabled.NewDisabledEpochTracker(), nil
+	}
+
+	client := apiClient
+	if len(cfg.Epoch.ApiURL) > 0 {
+		var err error
+		client, err = createFailoverHTTPClient(cfg, httpClient, []string{cfg.Epoch.ApiURL}, cfg.Name+" epoch")
+		if err != nil {
+			return nil, fmt.Errorf("%w for the epoch API URL of monitor %s", err, cfg.Name)
+		}
+	}
+
+	querier, err := interactors.NewNetworkStatusInteractor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	args := executors.ArgsEpochTracker{
+		Name:                 cfg.Name,
+		Querier:              querier,
+		GracePeriodInSeconds: cfg.Epoch.RatingDropGracePeriodInSeconds,
+		GetCurrentTimestamp: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+	tracker, err := executors.NewEpochTracker(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return tracker, nil
+}
+
+// NewEpochReporter creates the component that reports the monitored BLS keys at the end of each epoch. If the epoch
+// tracking or the epoch report is not enabled, a disabled component is returned
+func NewEpochReporter(cfg config.BLSKeysMonitorConfig, identities *core.IdentitiesHolder) (EpochReporter, error) {
+	if !cfg.Epoch.Enabled || !cfg.Epoch.ReportEnabled {
+		return disabled.NewDisabledEpochReporter(), nil
+	}
+
+	args := checkers.ArgsEpochReporter{
+		Name:       cfg.Name,
+		Identities: identities,
+	}
+	reporter, err := checkers.NewEpochReporter(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return reporter, nil
+}
+
+// NewPeersRatingChecker creates the component that compares the rating drops of the monitored BLS keys with the ones of
+// their peers, honouring the thresholds overrides of the monitor. If the peers comparison is not enabled, a disabled
+// component is returned
+func NewPeersRatingChecker(
+	cfg config.BLSKeysMonitorConfig,
+	identities *core.IdentitiesHolder,
+	thresholdsResolver checkers.ThresholdsResolver,
+) (PeersRatingChecker, error) {
+	if !cfg.PeersComparison.Enabled {
+		return disabled.NewDisabledPeersRatingChecker(), nil
+	}
+
+	args := checkers.ArgsPeersRatingChecker{
+		Name:               cfg.Name,
+		Identities:         identities,
+		WorstPercentile:    cfg.PeersComparison.WorstPercentile,
+		StandardDeviations: cfg.PeersComparison.StandardDeviations,
+		MinGroupSize:       cfg.PeersComparison.MinGroupSize,
+		ThresholdsResolver: thresholdsResolver,
+	}
+	checker, err := checkers.NewPeersRatingChecker(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return checker, nil
 }
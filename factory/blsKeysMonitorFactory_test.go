@@ -1,34 +1,85 @@
 package factory
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestNewBLSKeysMonitor(t *testing.T) {
+func createTestStatisticsCache() StatisticsCache {
+	statisticsCache, _ := NewStatisticsCache(config.GeneralConfigs{})
+
+	return statisticsCache
+}
+
+func createTestMonitorIdentities(t *testing.T, cfg config.BLSKeysMonitorConfig, credentials config.CredentialsConfig) *BLSKeysMonitorIdentities {
+	monitorIdentities, err := LoadBLSKeysMonitorIdentities(cfg, credentials)
+	require.Nil(t, err)
+
+	return monitorIdentities
+}
+
+func TestLoadBLSKeysMonitorIdentities(t *testing.T) {
 	t.Parallel()
 
 	t.Run("invalid BLS keys should error", func(t *testing.T) {
 		t.Parallel()
 
 		cfg := config.BLSKeysMonitorConfig{
-			AlarmDeltaRatingDrop:     1,
-			ApiURL:                   "url",
-			PollingIntervalInSeconds: 1,
-			ListFile:                 "./testdata/invalidKeys.list",
-			Name:                     "test",
+			ListFile: "./testdata/invalidKeys.list",
+			Name:     "test",
 		}
 
+		monitorIdentities, err := LoadBLSKeysMonitorIdentities(cfg, config.CredentialsConfig{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.Nil(t, monitorIdentities)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			ListFile: "./testdata/keys.list",
+			Name:     "test",
+		}
+
+		monitorIdentities, err := LoadBLSKeysMonitorIdentities(cfg, config.CredentialsConfig{})
+		assert.Nil(t, err)
+		assert.Equal(t, cfg, monitorIdentities.Config)
+		assert.Equal(t, []string{"./testdata/keys.list"}, monitorIdentities.Loader.Filenames())
+		assert.NotEmpty(t, monitorIdentities.Identities.BlsHexKeys)
+		assert.Nil(t, monitorIdentities.ListURLFetcher)
+	})
+}
+
+func TestNewBLSKeysMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil monitor identities should error", func(t *testing.T) {
+		t.Parallel()
+
 		monitor, err := NewBLSKeysMonitor(
-			cfg,
+			nil,
 			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
 			&mock.OutputNotifiersHandlerStub{},
 			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
 		)
-		assert.NotNil(t, err)
+		assert.Equal(t, errNilMonitorIdentities, err)
 		assert.Nil(t, monitor)
 	})
 	t.Run("invalid interval should error", func(t *testing.T) {
@@ -43,10 +94,35 @@ func TestNewBLSKeysMonitor(t *testing.T) {
 		}
 
 		monitor, err := NewBLSKeysMonitor(
-			cfg,
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
 			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
 			&mock.OutputNotifiersHandlerStub{},
 			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid network-wide degraded percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:       1,
+			ApiURL:                     "url",
+			PollingIntervalInSeconds:   1,
+			ListFile:                   "./testdata/keys.list",
+			Name:                       "test",
+			NetworkWideDegradedPercent: 101,
+		}
+
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
 		)
 		assert.NotNil(t, err)
 		assert.Nil(t, monitor)
@@ -63,10 +139,12 @@ func TestNewBLSKeysMonitor(t *testing.T) {
 		}
 
 		monitor, err := NewBLSKeysMonitor(
-			cfg,
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
 			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
 			&mock.OutputNotifiersHandlerStub{},
 			nil,
+			createTestStatisticsCache(),
 		)
 		assert.NotNil(t, err)
 		assert.Nil(t, monitor)
@@ -83,10 +161,356 @@ func TestNewBLSKeysMonitor(t *testing.T) {
 		}
 
 		monitor, err := NewBLSKeysMonitor(
-			cfg,
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, monitor)
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("monitors sharing the statistics should report the shared API client", func(t *testing.T) {
+		t.Parallel()
+
+		providers := make(map[string]core.EndpointsProvider)
+		statusHandler := &mock.StatusHandlerStub{
+			AddEndpointsProviderHandler: func(monitorName string, provider core.EndpointsProvider) {
+				providers[monitorName] = provider
+			},
+		}
+		statisticsCache := createTestStatisticsCache()
+		for _, name := range []string{"test 1", "test 2"} {
+			cfg := config.BLSKeysMonitorConfig{
+				AlarmDeltaRatingDrop:     1,
+				ApiURL:                   "url",
+				PollingIntervalInSeconds: 1,
+				ListFile:                 "./testdata/keys.list",
+				Name:                     name,
+			}
+
+			monitor, err := NewBLSKeysMonitor(
+				createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+				config.AlarmSnoozeConfig{},
+				config.CredentialsConfig{},
+				&mock.OutputNotifiersHandlerStub{},
+				statusHandler,
+				statisticsCache,
+			)
+			require.Nil(t, err)
+			_ = monitor.Close()
+		}
+
+		require.Equal(t, 2, len(providers))
+		assert.NotNil(t, providers["test 1"])
+		assert.True(t, providers["test 1"] == providers["test 2"])
+	})
+}
+
+func TestNewBLSKeysMonitorWithListReload(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.BLSKeysMonitorConfig{
+		AlarmDeltaRatingDrop:        1,
+		ApiURL:                      "url",
+		PollingIntervalInSeconds:    1,
+		ListFile:                    "./testdata/keys.list",
+		ListReloadIntervalInSeconds: 1,
+		Name:                        "test",
+	}
+
+	monitor, err := NewBLSKeysMonitor(
+		createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+		config.AlarmSnoozeConfig{},
+		config.CredentialsConfig{},
+		&mock.OutputNotifiersHandlerStub{},
+		&mock.StatusHandlerStub{},
+		createTestStatisticsCache(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "*monitor.monitorsGroup", fmt.Sprintf("%T", monitor))
+
+	err = monitor.Close()
+	assert.Nil(t, err)
+}
+
+func TestNewBLSKeysMonitorWithPEMReload(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.BLSKeysMonitorConfig{
+		AlarmDeltaRatingDrop:        1,
+		ApiURL:                      "url",
+		PollingIntervalInSeconds:    1,
+		PemDirs:                     []string{"./testdata/pems"},
+		ListReloadIntervalInSeconds: 1,
+		Name:                        "test",
+	}
+
+	monitor, err := NewBLSKeysMonitor(
+		createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+		config.AlarmSnoozeConfig{},
+		config.CredentialsConfig{},
+		&mock.OutputNotifiersHandlerStub{},
+		&mock.StatusHandlerStub{},
+		createTestStatisticsCache(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "*monitor.monitorsGroup", fmt.Sprintf("%T", monitor))
+
+	err = monitor.Close()
+	assert.Nil(t, err)
+}
+
+func TestNewBLSKeysMonitorWithListURL(t *testing.T) {
+	t.Parallel()
+
+	keysList, err := os.ReadFile("./testdata/keys.list")
+	require.Nil(t, err)
+
+	t.Run("unreachable URL without a cached list should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		cfg := config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:            1,
+			ApiURL:                          "url",
+			PollingIntervalInSeconds:        1,
+			ListURL:                         server.URL,
+			ListURLRefreshIntervalInSeconds: 1,
+			ListURLCacheFile:                filepath.Join(t.TempDir(), "keys.list"),
+			Name:                            "test",
+		}
+
+		monitorIdentities, err := LoadBLSKeysMonitorIdentities(cfg, config.CredentialsConfig{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no cached list exists")
+		assert.Nil(t, monitorIdentities)
+	})
+	t.Run("unreachable URL with a cached list should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		cacheFile := filepath.Join(t.TempDir(), "keys.list")
+		err := os.WriteFile(cacheFile, keysList, os.ModePerm)
+		require.Nil(t, err)
+
+		cfg := config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:            1,
+			ApiURL:                          "url",
+			PollingIntervalInSeconds:        1,
+			ListURL:                         server.URL,
+			ListURLRefreshIntervalInSeconds: 1,
+			ListURLCacheFile:                cacheFile,
+			Name:                            "test",
+		}
+
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, "*monitor.monitorsGroup", fmt.Sprintf("%T", monitor))
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should download the list with the bearer token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			_, _ = rw.Write(keysList)
+		}))
+		defer server.Close()
+
+		cacheFile := filepath.Join(t.TempDir(), "keys.list")
+		cfg := config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:            1,
+			ApiURL:                          "url",
+			PollingIntervalInSeconds:        1,
+			ListURL:                         server.URL,
+			ListURLRefreshIntervalInSeconds: 1,
+			ListURLCacheFile:                cacheFile,
+			Name:                            "test",
+		}
+		credentials := config.CredentialsConfig{
+			ListURLs: []config.ListURLCredentialsConfig{
+				{
+					Name:        "other",
+					BearerToken: "other token",
+				},
+				{
+					Name:        "test",
+					BearerToken: "token",
+				},
+			},
+		}
+
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, credentials),
+			config.AlarmSnoozeConfig{},
+			credentials,
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, "*monitor.monitorsGroup", fmt.Sprintf("%T", monitor))
+
+		cachedList, _ := os.ReadFile(cacheFile)
+		assert.Equal(t, keysList, cachedList)
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+}
+
+func TestNewBLSKeysMonitorWithThresholdsOverrides(t *testing.T) {
+	t.Parallel()
+
+	delta := 2.0
+	blsKey := "015c24a0585c3007e02bb9168c7988cccd183285161b26a0fd908b68f4daf64518517b947f58a3c6cb3caebc4a1c84015470b2b43b05d6d9dbd463c817162b7f6c30f2bcb95fd7bc5dce98e5858200087c1d2b095f097dea57c142e4c0c0e088"
+	createConfig := func(overrides ...config.ThresholdsOverrideConfig) config.BLSKeysMonitorConfig {
+		return config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:     1,
+			ApiURL:                   "url",
+			PollingIntervalInSeconds: 1,
+			ListFile:                 "./testdata/labeledKeys.list",
+			Name:                     "test",
+			Overrides:                overrides,
+		}
+	}
+
+	t.Run("override without BLS key or label should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.ThresholdsOverrideConfig{AlarmDeltaRatingDrop: &delta})
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.ErrorIs(t, err, errInvalidThresholdsOverride)
+		assert.Nil(t, monitor)
+	})
+	t.Run("override with both BLS key and label should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.ThresholdsOverrideConfig{BLSKey: blsKey, Label: "backup"})
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.ErrorIs(t, err, errInvalidThresholdsOverride)
+		assert.Nil(t, monitor)
+	})
+	t.Run("override with an invalid BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.ThresholdsOverrideConfig{BLSKey: "bls1", AlarmDeltaRatingDrop: &delta})
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.ErrorIs(t, err, errInvalidThresholdsOverride)
+		assert.Contains(t, err.Error(), "bls1 is not a hex BLS key")
+		assert.Nil(t, monitor)
+	})
+	t.Run("override with an unknown label should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.ThresholdsOverrideConfig{Label: "unknown", AlarmDeltaRatingDrop: &delta})
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
 			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
 			&mock.OutputNotifiersHandlerStub{},
 			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the overridden label is not defined in the identities: unknown for monitor test")
+		assert.Nil(t, monitor)
+	})
+	t.Run("duplicated override should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(
+			config.ThresholdsOverrideConfig{Label: "backup", AlarmDeltaRatingDrop: &delta},
+			config.ThresholdsOverrideConfig{Label: "backup", EnabledChecks: []string{"none"}},
+		)
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.ErrorIs(t, err, errDuplicatedThresholdsOverride)
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid override values should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.ThresholdsOverrideConfig{BLSKey: blsKey, EnabledChecks: []string{"unknown"}})
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, monitor)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(
+			config.ThresholdsOverrideConfig{Label: "backup", EnabledChecks: []string{"none"}},
+			config.ThresholdsOverrideConfig{BLSKey: blsKey, AlarmDeltaRatingDrop: &delta},
+			config.ThresholdsOverrideConfig{Label: "main", AlarmDeltaRatingDrop: &delta},
+		)
+		monitor, err := NewBLSKeysMonitor(
+			createTestMonitorIdentities(t, cfg, config.CredentialsConfig{}),
+			config.AlarmSnoozeConfig{},
+			config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{},
+			&mock.StatusHandlerStub{},
+			createTestStatisticsCache(),
 		)
 		assert.Nil(t, err)
 		assert.NotNil(t, monitor)
@@ -95,3 +519,528 @@ func TestNewBLSKeysMonitor(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestNewIdentitiesLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(config.BLSKeysMonitorConfig{})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(loader))
+	})
+	t.Run("unknown list format should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(config.BLSKeysMonitorConfig{
+			ListFile:   "keys.list",
+			ListFormat: "xml",
+		})
+		assert.ErrorIs(t, err, errUnknownListFormat)
+		assert.Nil(t, loader)
+	})
+	t.Run("should watch the list file, the cache file and the PEM directories", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(config.BLSKeysMonitorConfig{
+			ListFile:         "keys.list",
+			ListURL:          "url",
+			ListURLCacheFile: "./db/keys.json",
+			PemDirs:          []string{"./keys"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"keys.list", "./db/keys.json", "./keys"}, loader.Filenames())
+	})
+}
+
+func TestNewNetworkAnomalyDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		detector, err := NewNetworkAnomalyDetector(config.BLSKeysMonitorConfig{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledNetworkAnomalyDetector", fmt.Sprintf("%T", detector))
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		detector, err := NewNetworkAnomalyDetector(config.BLSKeysMonitorConfig{
+			AlarmDeltaRatingDrop:       1,
+			NetworkWideDegradedPercent: 30,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.networkAnomalyDetector", fmt.Sprintf("%T", detector))
+	})
+}
+
+func TestNewBLSKeysFetcher(t *testing.T) {
+	t.Parallel()
+
+	apiClient, _ := NewAPIClient(config.BLSKeysMonitorConfig{ApiURL: "https://api.example.com"}, nil)
+	t.Run("default values should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := NewBLSKeysFetcher(config.BLSKeysMonitorConfig{}, apiClient, nil)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(fetcher))
+	})
+	t.Run("invalid requests per second should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name:                          "test",
+			BLSKeysFetchRequestsPerSecond: -1,
+		}
+		fetcher, err := NewBLSKeysFetcher(cfg, apiClient, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for the BLS keys fetcher of monitor test")
+		assert.True(t, check.IfNil(fetcher))
+	})
+	t.Run("invalid concurrent requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name:                              "test",
+			BLSKeysFetchMaxConcurrentRequests: -1,
+		}
+		fetcher, err := NewBLSKeysFetcher(cfg, apiClient, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.True(t, check.IfNil(fetcher))
+	})
+}
+
+func TestNewQuorumChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(config.BLSKeysMonitorConfig{}, nil, &mock.RatingsCheckerStub{}, createTestStatisticsCache())
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledQuorumChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("quorum without sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(config.BLSKeysMonitorConfig{
+			Name:             "test",
+			StatisticsQuorum: 2,
+		}, nil, &mock.RatingsCheckerStub{}, createTestStatisticsCache())
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("empty source URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(config.BLSKeysMonitorConfig{
+			Name:              "test",
+			StatisticsSources: []config.StatisticsSourceConfig{{Name: "observer"}},
+			StatisticsQuorum:  2,
+		}, nil, &mock.RatingsCheckerStub{}, createTestStatisticsCache())
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for the statistics source observer of monitor test")
+	})
+	t.Run("invalid quorum should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(config.BLSKeysMonitorConfig{
+			Name:              "test",
+			StatisticsSources: []config.StatisticsSourceConfig{{Name: "observer", ApiURL: "http://127.0.0.1:8080"}},
+			StatisticsQuorum:  3,
+		}, nil, &mock.RatingsCheckerStub{}, createTestStatisticsCache())
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "allowed interval [1, 2] for monitor test")
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewQuorumChecker(config.BLSKeysMonitorConfig{
+			Name:              "test",
+			StatisticsSources: []config.StatisticsSourceConfig{{Name: "observer", ApiURL: "http://127.0.0.1:8080"}},
+			StatisticsQuorum:  2,
+		}, nil, &mock.RatingsCheckerStub{}, createTestStatisticsCache())
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.quorumChecker", fmt.Sprintf("%T", checker))
+	})
+}
+
+func TestNewHeartbeatChecker(t *testing.T) {
+	t.Parallel()
+
+	apiClient, _ := NewAPIClient(config.BLSKeysMonitorConfig{ApiURL: "http://127.0.0.1:8080"}, nil)
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewHeartbeatChecker(config.BLSKeysMonitorConfig{}, nil, apiClient, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledHeartbeatChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("invalid peer type should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Heartbeat: config.HeartbeatCheckConfig{
+				Enabled:           true,
+				ExpectedPeerTypes: []string{"validator"},
+			},
+		}
+		checker, err := NewHeartbeatChecker(cfg, nil, apiClient, &core.IdentitiesHolder{})
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Heartbeat: config.HeartbeatCheckConfig{
+				Enabled:           true,
+				ExpectedPeerTypes: []string{"eligible", "waiting"},
+			},
+		}
+		checker, err := NewHeartbeatChecker(cfg, nil, apiClient, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.heartbeatChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("enabled with a different API URL should query it", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/node/heartbeatstatus", r.URL.Path)
+			_, _ = rw.Write([]byte(`{"data":{"heartbeats":[{"publicKey":"bls1","isActive":false,"nodeDisplayName":"node"}]}}`))
+		}))
+		defer server.Close()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Heartbeat: config.HeartbeatCheckConfig{
+				Enabled: true,
+				ApiURL:  server.URL,
+			},
+		}
+		checker, err := NewHeartbeatChecker(cfg, nil, apiClient, &core.IdentitiesHolder{BlsHexKeys: []string{"bls1"}})
+		require.Nil(t, err)
+
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "bls1", results[0].HexBLSKey)
+	})
+}
+
+func TestNewAuctionChecker(t *testing.T) {
+	t.Parallel()
+
+	apiClient, _ := NewAPIClient(config.BLSKeysMonitorConfig{ApiURL: "http://127.0.0.1:8080"}, nil)
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewAuctionChecker(config.BLSKeysMonitorConfig{}, nil, apiClient, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledAuctionChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Auction: config.AuctionCheckConfig{
+				Enabled: true,
+			},
+		}
+		checker, err := NewAuctionChecker(cfg, nil, apiClient, nil)
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Auction: config.AuctionCheckConfig{
+				Enabled: true,
+			},
+		}
+		checker, err := NewAuctionChecker(cfg, nil, apiClient, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.auctionChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("enabled with a different API URL should query it", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/validator/auction", r.URL.Path)
+			_, _ = rw.Write([]byte(`{"data":{"auctionList":[{"owner":"owner","qualifiedTopUp":"0",` +
+				`"nodes":[{"blsKey":"bls1","qualified":false}]}]}}`))
+		}))
+		defer server.Close()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Auction: config.AuctionCheckConfig{
+				Enabled: true,
+				ApiURL:  server.URL,
+			},
+		}
+		checker, err := NewAuctionChecker(cfg, nil, apiClient, &core.IdentitiesHolder{BlsHexKeys: []string{"bls1"}})
+		require.Nil(t, err)
+
+		results, err := checker.Check(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "bls1", results[0].HexBLSKey)
+	})
+}
+
+func TestNewEpochTracker(t *testing.T) {
+	t.Parallel()
+
+	apiClient, _ := NewAPIClient(config.BLSKeysMonitorConfig{ApiURL: "http://127.0.0.1:8080"}, nil)
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewEpochTracker(config.BLSKeysMonitorConfig{}, nil, apiClient)
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledEpochTracker", fmt.Sprintf("%T", tracker))
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Epoch: config.EpochConfig{
+				Enabled: true,
+			},
+		}
+		tracker, err := NewEpochTracker(cfg, nil, apiClient)
+		assert.Nil(t, err)
+		assert.Equal(t, "*executors.epochTracker", fmt.Sprintf("%T", tracker))
+	})
+	t.Run("enabled with a different API URL should query it", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/network/status/4294967295", r.URL.Path)
+			_, _ = rw.Write([]byte(`{"data":{"status":{"erd_epoch_number":37}}}`))
+		}))
+		defer server.Close()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Epoch: config.EpochConfig{
+				Enabled: true,
+				ApiURL:  server.URL,
+			},
+		}
+		tracker, err := NewEpochTracker(cfg, nil, apiClient)
+		require.Nil(t, err)
+
+		_, _, err = tracker.Update(context.Background())
+		assert.Nil(t, err)
+		epoch, isKnown := tracker.CurrentEpoch()
+		assert.Equal(t, uint32(37), epoch)
+		assert.True(t, isKnown)
+	})
+	t.Run("the grace period should expire", func(t *testing.T) {
+		t.Parallel()
+
+		var epoch uint32 = 37
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			_, _ = rw.Write([]byte(fmt.Sprintf(`{"data":{"status":{"erd_epoch_number":%d}}}`, atomic.AddUint32(&epoch, 1))))
+		}))
+		defer server.Close()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Epoch: config.EpochConfig{
+				Enabled:                        true,
+				ApiURL:                         server.URL,
+				RatingDropGracePeriodInSeconds: 1,
+			},
+		}
+		tracker, err := NewEpochTracker(cfg, nil, apiClient)
+		require.Nil(t, err)
+
+		_, _, err = tracker.Update(context.Background())
+		require.Nil(t, err)
+		_, isEpochChanged, err := tracker.Update(context.Background())
+		require.Nil(t, err)
+		assert.True(t, isEpochChanged)
+		assert.True(t, tracker.IsInGracePeriod())
+
+		assert.Eventually(t, func() bool {
+			return !tracker.IsInGracePeriod()
+		}, time.Second*3, time.Millisecond*50)
+	})
+}
+
+func TestNewEpochReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled epoch tracking", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Epoch: config.EpochConfig{
+				ReportEnabled: true,
+			},
+		}
+		reporter, err := NewEpochReporter(cfg, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledEpochReporter", fmt.Sprintf("%T", reporter))
+	})
+	t.Run("disabled report", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Epoch: config.EpochConfig{
+				Enabled: true,
+			},
+		}
+		reporter, err := NewEpochReporter(cfg, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledEpochReporter", fmt.Sprintf("%T", reporter))
+	})
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Epoch: config.EpochConfig{
+				Enabled:       true,
+				ReportEnabled: true,
+			},
+		}
+		reporter, err := NewEpochReporter(cfg, nil)
+		assert.Nil(t, reporter)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Epoch: config.EpochConfig{
+				Enabled:       true,
+				ReportEnabled: true,
+			},
+		}
+		reporter, err := NewEpochReporter(cfg, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.epochReporter", fmt.Sprintf("%T", reporter))
+	})
+}
+
+func TestNewPeersRatingChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewPeersRatingChecker(config.BLSKeysMonitorConfig{}, &core.IdentitiesHolder{}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledPeersRatingChecker", fmt.Sprintf("%T", checker))
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			PeersComparison: config.PeersComparisonConfig{
+				Enabled:      true,
+				MinGroupSize: 20,
+			},
+		}
+		checker, err := NewPeersRatingChecker(cfg, &core.IdentitiesHolder{}, createTestThresholdsResolver())
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("nil thresholds resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			PeersComparison: config.PeersComparisonConfig{
+				Enabled:         true,
+				WorstPercentile: 5,
+				MinGroupSize:    20,
+			},
+		}
+		checker, err := NewPeersRatingChecker(cfg, &core.IdentitiesHolder{}, nil)
+		assert.Nil(t, checker)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			PeersComparison: config.PeersComparisonConfig{
+				Enabled:            true,
+				WorstPercentile:    5,
+				StandardDeviations: 3,
+				MinGroupSize:       20,
+			},
+		}
+		checker, err := NewPeersRatingChecker(cfg, &core.IdentitiesHolder{}, createTestThresholdsResolver())
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.peersRatingChecker", fmt.Sprintf("%T", checker))
+	})
+}
+
+func createTestThresholdsResolver() ThresholdsResolver {
+	resolver, _ := NewThresholdsResolver(config.BLSKeysMonitorConfig{Name: "test"}, &core.IdentitiesHolder{})
+
+	return resolver
+}
+
+func TestNewThresholdsResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name: "test",
+			Overrides: []config.ThresholdsOverrideConfig{
+				{Label: "missing", EnabledChecks: []string{core.NoChecks}},
+			},
+		}
+		resolver, err := NewThresholdsResolver(cfg, &core.IdentitiesHolder{})
+		assert.Nil(t, resolver)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "identities: missing for monitor test")
+	})
+	t.Run("invalid alarm delta rating drop should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.BLSKeysMonitorConfig{
+			Name:                 "test",
+			AlarmDeltaRatingDrop: 101,
+		}
+		resolver, err := NewThresholdsResolver(cfg, &core.IdentitiesHolder{})
+		assert.Nil(t, resolver)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := NewThresholdsResolver(config.BLSKeysMonitorConfig{Name: "test"}, &core.IdentitiesHolder{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*checkers.thresholdsResolver", fmt.Sprintf("%T", resolver))
+	})
+}
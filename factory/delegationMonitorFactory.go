@@ -0,0 +1,105 @@
+package factory
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-keys-monitor-go/checkers"
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/monitor"
+)
+
+var addressPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(core.AddressLen, core.AddressHRP)
+
+// oneEGLD is the number of the smallest denomination units in one EGLD
+var oneEGLD = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(egldDecimals), nil)
+
+// NewDelegationMonitor will create a delegation monitor, querying the configured delegation contracts through the
+// vm-values/query endpoint of the API
+func NewDelegationMonitor(
+	cfg config.DelegationMonitorConfig,
+	snoozeConfig config.AlarmSnoozeConfig,
+	credentials config.CredentialsConfig,
+	notifiersHandler OutputNotifiersHandler,
+	statusHandler executors.StatusHandler,
+) (Monitor, error) {
+	contracts, err := createMonitoredContracts(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := NewHTTPClient(cfg.HTTPClient, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the HTTP client of monitor %s", err, cfg.Name)
+	}
+
+	delegationStateQuerier, err := interactors.NewDelegationContractInteractor(clients.NewHTTPClientWrapper(httpClient, cfg.ApiURL))
+	if err != nil {
+		return nil, err
+	}
+
+	minStakePerNode := big.NewInt(0).SetUint64(cfg.MinStakePerNodeInEGLD)
+	delegationChecker, err := checkers.NewDelegationChecker(minStakePerNode.Mul(minStakePerNode, oneEGLD))
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	contractsFilter, err := NewBLSKeysFilter(snoozeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	argsExecutor := executors.ArgsDelegationExecutor{
+		OutputNotifiersHandler: notifiersHandler,
+		StatusHandler:          statusHandler,
+		ContractsFilter:        contractsFilter,
+		DelegationStateQuerier: delegationStateQuerier,
+		DelegationChecker:      delegationChecker,
+		Contracts:              contracts,
+		Name:                   cfg.Name,
+		ExplorerURL:            cfg.ExplorerURL,
+	}
+	executor, err := executors.NewDelegationExecutor(argsExecutor)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return monitor.NewBLSKeysMonitor(
+		executor,
+		time.Duration(cfg.PollingIntervalInSeconds)*time.Second,
+		cfg.Name)
+}
+
+func createMonitoredContracts(cfg config.DelegationMonitorConfig) ([]executors.MonitoredContract, error) {
+	contracts := make([]executors.MonitoredContract, 0, len(cfg.Contracts))
+	addresses := make(map[string]struct{})
+	for index, contractConfig := range cfg.Contracts {
+		decoded, errDecode := addressPubkeyConverter.Decode(contractConfig.Address)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w: %s at index %d for monitor %s", errInvalidContractAddress,
+				errDecode.Error(), index, cfg.Name)
+		}
+		_, found := addresses[contractConfig.Address]
+		if found {
+			return nil, fmt.Errorf("%w %s for monitor %s", errDuplicatedContractAddress, contractConfig.Address, cfg.Name)
+		}
+		addresses[contractConfig.Address] = struct{}{}
+
+		contracts = append(contracts, executors.MonitoredContract{
+			Address: core.Address{
+				Hex:    hex.EncodeToString(decoded),
+				Bech32: contractConfig.Address,
+			},
+			Label: contractConfig.Label,
+		})
+	}
+
+	return contracts, nil
+}
@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestDelegationMonitorConfig() config.DelegationMonitorConfig {
+	return config.DelegationMonitorConfig{
+		Name:                     "test",
+		ApiURL:                   "http://127.0.0.1:8080",
+		PollingIntervalInSeconds: 1,
+		MinStakePerNodeInEGLD:    2500,
+		Contracts: []config.DelegationContractConfig{
+			{
+				Address: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllllls27850s",
+				Label:   "provider",
+			},
+			{
+				Address: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqr8llllse9cj2t",
+			},
+		},
+	}
+}
+
+func TestNewDelegationMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		cfg.Contracts[1].Address = "invalid"
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errInvalidContractAddress)
+		assert.Contains(t, err.Error(), "at index 1")
+		assert.Nil(t, monitor)
+	})
+	t.Run("duplicated contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		cfg.Contracts[1].Address = cfg.Contracts[0].Address
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errDuplicatedContractAddress)
+		assert.Nil(t, monitor)
+	})
+	t.Run("no contracts should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		cfg.Contracts = nil
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid HTTP client config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		cfg.HTTPClient.CredentialsName = "missing"
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errHTTPClientCredentialsNotFound)
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		cfg.PollingIntervalInSeconds = 0
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Nil(t, monitor)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestDelegationMonitorConfig()
+		monitor, err := NewDelegationMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, monitor)
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/parsers"
+)
+
+const duplicatedIdentitiesCheckName = "duplicated identities check"
+const maxDuplicatedIdentitiesNotified = 10
+
+// CheckDuplicatedIdentities reports the BLS keys and addresses defined more than once, in the same monitor or in
+// different monitors, using the identities already loaded for the monitors. The duplicates are logged and notified
+// or, if failOnDuplicates is set, returned as an error
+func CheckDuplicatedIdentities(
+	monitorsIdentities []*BLSKeysMonitorIdentities,
+	failOnDuplicates bool,
+	notifiersHandler OutputNotifiersHandler,
+) error {
+	monitors := make([]parsers.MonitorIdentities, 0, len(monitorsIdentities))
+	for _, monitorIdentities := range monitorsIdentities {
+		if monitorIdentities == nil {
+			return errNilMonitorIdentities
+		}
+
+		monitors = append(monitors, parsers.MonitorIdentities{
+			MonitorName: monitorIdentities.Config.Name,
+			Identities:  monitorIdentities.Identities,
+		})
+	}
+
+	duplicates := parsers.FindDuplicatedIdentities(monitors)
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	for _, duplicate := range duplicates {
+		log.Warn("identity defined more than once", "identity", duplicate.Identity,
+			"locations", strings.Join(duplicate.Locations, ", "))
+	}
+
+	if failOnDuplicates {
+		return fmt.Errorf("%w: %d identities defined more than once, first one is %s",
+			errDuplicatedIdentities, len(duplicates), composeDuplicatedIdentity(duplicates[0]))
+	}
+
+	msg := core.OutputMessage{
+		Type:               core.WarningMessageOutputType,
+		ShortIdentifier:    fmt.Sprintf("%d identities are defined more than once, each monitor checks them only once", len(duplicates)),
+		ExecutorName:       duplicatedIdentitiesCheckName,
+		ProblemEncountered: composeDuplicatedIdentities(duplicates),
+	}
+
+	return notifiersHandler.NotifyWithRetry(duplicatedIdentitiesCheckName, msg)
+}
+
+func composeDuplicatedIdentities(duplicates []parsers.DuplicatedIdentity) string {
+	lines := make([]string, 0, maxDuplicatedIdentitiesNotified+1)
+	for index, duplicate := range duplicates {
+		if index == maxDuplicatedIdentitiesNotified {
+			lines = append(lines, fmt.Sprintf("and %d more", len(duplicates)-maxDuplicatedIdentitiesNotified))
+			break
+		}
+
+		lines = append(lines, composeDuplicatedIdentity(duplicate))
+	}
+
+	return strings.Join(lines, "; ")
+}
+
+func composeDuplicatedIdentity(duplicate parsers.DuplicatedIdentity) string {
+	return fmt.Sprintf("%s (%s)", duplicate.Identity, strings.Join(duplicate.Locations, ", "))
+}
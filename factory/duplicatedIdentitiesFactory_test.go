@@ -0,0 +1,79 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDuplicatedIdentities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil monitor identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckDuplicatedIdentities([]*BLSKeysMonitorIdentities{nil}, false, &mock.OutputNotifiersHandlerStub{})
+		assert.Equal(t, errNilMonitorIdentities, err)
+	})
+	t.Run("no duplicates should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		monitorsIdentities := []*BLSKeysMonitorIdentities{
+			createTestMonitorIdentities(t, config.BLSKeysMonitorConfig{Name: "test", ListFile: "./testdata/keys.list"}, config.CredentialsConfig{}),
+		}
+		notifiersHandler := &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not notified")
+				return nil
+			},
+		}
+
+		err := CheckDuplicatedIdentities(monitorsIdentities, true, notifiersHandler)
+		assert.Nil(t, err)
+	})
+	t.Run("duplicates across monitors should notify", func(t *testing.T) {
+		t.Parallel()
+
+		monitorsIdentities := []*BLSKeysMonitorIdentities{
+			createTestMonitorIdentities(t, config.BLSKeysMonitorConfig{Name: "test 1", ListFile: "./testdata/keys.list"}, config.CredentialsConfig{}),
+			createTestMonitorIdentities(t, config.BLSKeysMonitorConfig{Name: "test 2", ListFile: "./testdata/keys.list"}, config.CredentialsConfig{}),
+		}
+		var sentMessages []core.OutputMessage
+		notifiersHandler := &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Equal(t, duplicatedIdentitiesCheckName, caller)
+				sentMessages = append(sentMessages, messages...)
+				return nil
+			},
+		}
+
+		err := CheckDuplicatedIdentities(monitorsIdentities, false, notifiersHandler)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(sentMessages))
+		assert.Equal(t, core.WarningMessageOutputType, sentMessages[0].Type)
+		assert.Equal(t, "4 identities are defined more than once, each monitor checks them only once", sentMessages[0].ShortIdentifier)
+		assert.Contains(t, sentMessages[0].ProblemEncountered,
+			"erd1cemzcl4kah9ng8f7xle7vc3k8jvwvgmmgfzl2echjesspr23vzcqdexyy9 (monitor test 1: ./testdata/keys.list:2, monitor test 2: ./testdata/keys.list:2)")
+	})
+	t.Run("duplicates should error if configured", func(t *testing.T) {
+		t.Parallel()
+
+		monitorsIdentities := []*BLSKeysMonitorIdentities{
+			createTestMonitorIdentities(t, config.BLSKeysMonitorConfig{Name: "test 1", ListFile: "./testdata/keys.list"}, config.CredentialsConfig{}),
+			createTestMonitorIdentities(t, config.BLSKeysMonitorConfig{Name: "test 2", ListFile: "./testdata/keys.list"}, config.CredentialsConfig{}),
+		}
+		notifiersHandler := &mock.OutputNotifiersHandlerStub{
+			NotifyWithRetryHandler: func(caller string, messages ...core.OutputMessage) error {
+				assert.Fail(t, "should have not notified")
+				return nil
+			},
+		}
+
+		err := CheckDuplicatedIdentities(monitorsIdentities, true, notifiersHandler)
+		assert.ErrorIs(t, err, errDuplicatedIdentities)
+		assert.Contains(t, err.Error(), "4 identities defined more than once")
+	})
+}
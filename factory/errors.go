@@ -0,0 +1,19 @@
+package factory
+
+import "errors"
+
+var (
+	errUnknownListFormat             = errors.New("unknown list format")
+	errDuplicatedIdentities          = errors.New("duplicated identities")
+	errInvalidThresholdsOverride     = errors.New("invalid thresholds override")
+	errDuplicatedThresholdsOverride  = errors.New("duplicated thresholds override")
+	errHTTPClientCredentialsNotFound = errors.New("HTTP client credentials not found")
+	errEmptyNodeURL                  = errors.New("empty node URL")
+	errDuplicatedNodeName            = errors.New("duplicated node name")
+	errInvalidContractAddress        = errors.New("invalid contract address")
+	errDuplicatedContractAddress     = errors.New("duplicated contract address")
+	errInvalidAccountAddress         = errors.New("invalid account address")
+	errDuplicatedAccount             = errors.New("duplicated account")
+	errInvalidAmount                 = errors.New("invalid amount")
+	errNilMonitorIdentities          = errors.New("nil monitor identities")
+)
@@ -0,0 +1,69 @@
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+)
+
+// NewHTTPClient creates the HTTP client based on the provided configuration and on the credentials referenced by it.
+// If no option is set, a nil client is returned so the default HTTP client will be used
+func NewHTTPClient(cfg config.HTTPClientConfig, credentials config.CredentialsConfig) (*http.Client, error) {
+	if isDefaultHTTPClientConfig(cfg) {
+		return nil, nil
+	}
+
+	headers := make(map[string]string, len(cfg.Headers))
+	for key, value := range cfg.Headers {
+		headers[key] = value
+	}
+
+	args := clients.ArgsHTTPClient{
+		Timeout:        time.Duration(cfg.TimeoutInSeconds) * time.Second,
+		Headers:        headers,
+		ProxyURL:       cfg.ProxyURL,
+		CABundleFile:   cfg.CABundleFile,
+		ClientCertFile: cfg.ClientCertFile,
+		ClientKeyFile:  cfg.ClientKeyFile,
+	}
+
+	if len(cfg.CredentialsName) > 0 {
+		httpClientCredentials, found := getHTTPClientCredentials(cfg.CredentialsName, credentials)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errHTTPClientCredentialsNotFound, cfg.CredentialsName)
+		}
+
+		args.Username = httpClientCredentials.Username
+		args.Password = httpClientCredentials.Password
+		args.BearerToken = httpClientCredentials.BearerToken
+		// the secret headers from the credentials file override the ones with the same name from the config file
+		for key, value := range httpClientCredentials.Headers {
+			args.Headers[key] = value
+		}
+	}
+
+	return clients.NewHTTPClient(args)
+}
+
+func isDefaultHTTPClientConfig(cfg config.HTTPClientConfig) bool {
+	return cfg.TimeoutInSeconds == 0 &&
+		len(cfg.Headers) == 0 &&
+		len(cfg.ProxyURL) == 0 &&
+		len(cfg.CABundleFile) == 0 &&
+		len(cfg.ClientCertFile) == 0 &&
+		len(cfg.ClientKeyFile) == 0 &&
+		len(cfg.CredentialsName) == 0
+}
+
+func getHTTPClientCredentials(name string, credentials config.CredentialsConfig) (config.HTTPClientCredentialsConfig, bool) {
+	for _, httpClientCredentials := range credentials.HTTPClients {
+		if httpClientCredentials.Name == name {
+			return httpClientCredentials, true
+		}
+	}
+
+	return config.HTTPClientCredentialsConfig{}, false
+}
@@ -0,0 +1,81 @@
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no option set should return a nil client", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(config.HTTPClientConfig{}, config.CredentialsConfig{})
+		assert.Nil(t, err)
+		assert.Nil(t, client)
+	})
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(config.HTTPClientConfig{CredentialsName: "gateway"}, config.CredentialsConfig{})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, errHTTPClientCredentialsNotFound)
+		assert.Contains(t, err.Error(), "gateway")
+	})
+	t.Run("invalid option should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewHTTPClient(config.HTTPClientConfig{ProxyURL: "proxy:8080"}, config.CredentialsConfig{})
+		assert.Nil(t, client)
+		assert.NotNil(t, err)
+	})
+	t.Run("should use the headers and the credentials", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "public", req.Header.Get("X-Client"))
+			assert.Equal(t, "secret", req.Header.Get("X-Api-Key"))
+			assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testServer.Close()
+
+		cfg := config.HTTPClientConfig{
+			TimeoutInSeconds: 10,
+			Headers: map[string]string{
+				"X-Client":  "public",
+				"X-Api-Key": "overridden",
+			},
+			CredentialsName: "gateway",
+		}
+		credentials := config.CredentialsConfig{
+			HTTPClients: []config.HTTPClientCredentialsConfig{
+				{
+					Name:        "other",
+					BearerToken: "other token",
+				},
+				{
+					Name:        "gateway",
+					BearerToken: "token",
+					Headers: map[string]string{
+						"X-Api-Key": "secret",
+					},
+				},
+			},
+		}
+		client, err := NewHTTPClient(cfg, credentials)
+		require.Nil(t, err)
+		assert.Equal(t, "overridden", cfg.Headers["X-Api-Key"]) // the config should not be modified
+
+		response, err := client.Get(testServer.URL)
+		require.Nil(t, err)
+		_ = response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+}
@@ -1,9 +1,12 @@
 package factory
 
 import (
+	"context"
 	"time"
 
+	"github.com/multiversx/mx-chain-keys-monitor-go/checkers"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
 )
 
 // FileLoggingHandler will handle log file rotation
@@ -22,5 +25,84 @@ type Monitor interface {
 // OutputNotifiersHandler defines the behavior of a component that is able to notify all notifiers
 type OutputNotifiersHandler interface {
 	NotifyWithRetry(caller string, messages ...core.OutputMessage) error
+	NotifyWithoutOutbox(caller string, messages ...core.OutputMessage) error
+	GetMetrics() []core.NotifierMetrics
+	IsInterfaceNil() bool
+}
+
+// IdentitiesLoader defines the behavior of a component able to load the monitored identities from all the configured
+// sources
+type IdentitiesLoader interface {
+	LoadIdentities() (*core.IdentitiesHolder, error)
+	Filenames() []string
+	IsInterfaceNil() bool
+}
+
+// APIClient defines the behavior of the HTTP client used to query the API endpoints of a monitored network
+type APIClient interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	ActiveURL() string
+	PrimaryURL() string
+	IsInterfaceNil() bool
+}
+
+// StatisticsCache is able to share the validator statistics between the monitors querying the same API
+type StatisticsCache interface {
+	Querier(
+		key string,
+		querier interactors.ValidatorStatisticsQuerier,
+		endpointsProvider core.EndpointsProvider,
+	) (interactors.ValidatorStatisticsQuerier, core.EndpointsProvider, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// BLSKeysFetcher defines the behavior of the component that fetches the BLS keys of the monitored addresses and
+// accepts the reloaded identities
+type BLSKeysFetcher interface {
+	GetAllBLSKeys(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error)
+	SetIdentities(identities *core.IdentitiesHolder) error
+	IsInterfaceNil() bool
+}
+
+// HeartbeatChecker defines the behavior of the component that checks the heartbeats of the monitored BLS keys and
+// accepts the reloaded identities
+type HeartbeatChecker interface {
+	Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error)
+	SetIdentities(identities *core.IdentitiesHolder) error
+	IsInterfaceNil() bool
+}
+
+// AuctionChecker defines the behavior of the component that checks the staking auction selection of the monitored BLS
+// keys and accepts the reloaded identities
+type AuctionChecker interface {
+	Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error)
+	SetIdentities(identities *core.IdentitiesHolder) error
+	IsInterfaceNil() bool
+}
+
+// ThresholdsResolver defines the behavior of the component that resolves the thresholds and the enabled checks of each
+// BLS key, shared by all the checkers of a monitor
+type ThresholdsResolver interface {
+	GetThresholds(blsKey string) checkers.Thresholds
+	SetOwnersBLSKeys(ownersBLSKeys []core.OwnerBLSKeys)
+	SetIdentities(identities *core.IdentitiesHolder) error
+	IsInterfaceNil() bool
+}
+
+// PeersRatingChecker defines the behavior of the component that compares the rating drops of the monitored BLS keys with
+// the ones of their peers and accepts the reloaded identities
+type PeersRatingChecker interface {
+	Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+	SetIdentities(identities *core.IdentitiesHolder) error
+	IsInterfaceNil() bool
+}
+
+// EpochReporter defines the behavior of the component that reports the monitored BLS keys at the end of each epoch and
+// accepts the reloaded identities
+type EpochReporter interface {
+	Report(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+	SetIdentities(identities *core.IdentitiesHolder) error
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,123 @@
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/checkers"
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors/disabled"
+	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/monitor"
+)
+
+const defaultNodeRequestTimeout = time.Second * 10
+
+// NewNodesMonitor will create a nodes monitor, querying the status of the configured nodes through their REST API
+func NewNodesMonitor(
+	cfg config.NodesMonitorConfig,
+	snoozeConfig config.AlarmSnoozeConfig,
+	credentials config.CredentialsConfig,
+	notifiersHandler OutputNotifiersHandler,
+	statusHandler executors.StatusHandler,
+) (Monitor, error) {
+	httpClient, err := NewHTTPClient(cfg.HTTPClient, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the HTTP client of monitor %s", err, cfg.Name)
+	}
+
+	nodes, err := createMonitoredNodes(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	networkNonceQuerier, err := NewNetworkNonceQuerier(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesFilter, err := NewBLSKeysFilter(snoozeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := defaultNodeRequestTimeout
+	if cfg.RequestTimeoutInSeconds > 0 {
+		requestTimeout = time.Duration(cfg.RequestTimeoutInSeconds) * time.Second
+	}
+
+	argsNodeStatusChecker := checkers.ArgsNodeStatusChecker{
+		MaxNonceLag:          cfg.MaxNonceLag,
+		MinNumConnectedPeers: cfg.MinNumConnectedPeers,
+		ExpectedAppVersion:   cfg.ExpectedAppVersion,
+	}
+	nodeStatusChecker, err := checkers.NewNodeStatusChecker(argsNodeStatusChecker)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	argsExecutor := executors.ArgsNodesExecutor{
+		OutputNotifiersHandler: notifiersHandler,
+		StatusHandler:          statusHandler,
+		NodesFilter:            nodesFilter,
+		NodeStatusChecker:      nodeStatusChecker,
+		NetworkNonceQuerier:    networkNonceQuerier,
+		Nodes:                  nodes,
+		RequestTimeout:         requestTimeout,
+		Name:                   cfg.Name,
+	}
+	executor, err := executors.NewNodesExecutor(argsExecutor)
+	if err != nil {
+		return nil, fmt.Errorf("%w for monitor %s", err, cfg.Name)
+	}
+
+	return monitor.NewBLSKeysMonitor(
+		executor,
+		time.Duration(cfg.PollingIntervalInSeconds)*time.Second,
+		cfg.Name)
+}
+
+func createMonitoredNodes(cfg config.NodesMonitorConfig, httpClient *http.Client) ([]executors.MonitoredNode, error) {
+	nodes := make([]executors.MonitoredNode, 0, len(cfg.Nodes))
+	names := make(map[string]struct{})
+	for index, nodeConfig := range cfg.Nodes {
+		if len(nodeConfig.URL) == 0 {
+			return nil, fmt.Errorf("%w at index %d for monitor %s", errEmptyNodeURL, index, cfg.Name)
+		}
+		// the node name is used as identifier in notifications and by the alarm snooze
+		name := nodeConfig.Name
+		if len(name) == 0 {
+			name = nodeConfig.URL
+		}
+		_, found := names[name]
+		if found {
+			return nil, fmt.Errorf("%w %s for monitor %s", errDuplicatedNodeName, name, cfg.Name)
+		}
+		names[name] = struct{}{}
+
+		querier, err := interactors.NewNodeStatusInteractor(clients.NewHTTPClientWrapper(httpClient, nodeConfig.URL))
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, executors.MonitoredNode{
+			Name:    name,
+			Querier: querier,
+		})
+	}
+
+	return nodes, nil
+}
+
+// NewNetworkNonceQuerier will create the component used to query the current nonce of the network. If the network URL
+// is not set, the nodes' probable highest nonce will be used as reference
+func NewNetworkNonceQuerier(cfg config.NodesMonitorConfig, httpClient *http.Client) (executors.NetworkNonceQuerier, error) {
+	if len(cfg.NetworkURL) == 0 {
+		return disabled.NewDisabledNetworkNonceQuerier(), nil
+	}
+
+	return interactors.NewNetworkStatusInteractor(clients.NewHTTPClientWrapper(httpClient, cfg.NetworkURL))
+}
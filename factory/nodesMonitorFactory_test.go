@@ -0,0 +1,130 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestNodesMonitorConfig() config.NodesMonitorConfig {
+	return config.NodesMonitorConfig{
+		Name:                     "test",
+		PollingIntervalInSeconds: 1,
+		Nodes: []config.NodeConfig{
+			{
+				Name: "node 0",
+				URL:  "http://127.0.0.1:8080",
+			},
+			{
+				Name: "node 1",
+				URL:  "http://127.0.0.1:8081",
+			},
+		},
+	}
+}
+
+func TestNewNodesMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid HTTP client config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.HTTPClient.CredentialsName = "missing"
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errHTTPClientCredentialsNotFound)
+		assert.Nil(t, monitor)
+	})
+	t.Run("no nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.Nodes = nil
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.Nil(t, monitor)
+	})
+	t.Run("empty node URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.Nodes[1].URL = ""
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errEmptyNodeURL)
+		assert.Contains(t, err.Error(), "at index 1")
+		assert.Nil(t, monitor)
+	})
+	t.Run("duplicated node name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.Nodes[1].Name = cfg.Nodes[0].Name
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.ErrorIs(t, err, errDuplicatedNodeName)
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid expected app version should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.ExpectedAppVersion = "v1.6. "
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid expected app version")
+		assert.Contains(t, err.Error(), "for monitor test")
+		assert.Nil(t, monitor)
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.PollingIntervalInSeconds = 0
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Nil(t, monitor)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNodesMonitorConfig()
+		cfg.NetworkURL = "http://127.0.0.1:8079"
+		monitor, err := NewNodesMonitor(cfg, config.AlarmSnoozeConfig{}, config.CredentialsConfig{},
+			&mock.OutputNotifiersHandlerStub{}, &mock.StatusHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, monitor)
+
+		err = monitor.Close()
+		assert.Nil(t, err)
+	})
+}
+
+func TestNewNetworkNonceQuerier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty network URL should create a disabled component", func(t *testing.T) {
+		t.Parallel()
+
+		querier, err := NewNetworkNonceQuerier(config.NodesMonitorConfig{}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledNetworkNonceQuerier", fmt.Sprintf("%T", querier))
+	})
+	t.Run("should create the network status interactor", func(t *testing.T) {
+		t.Parallel()
+
+		querier, err := NewNetworkNonceQuerier(config.NodesMonitorConfig{NetworkURL: "http://127.0.0.1:8079"}, nil)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(querier))
+		assert.Equal(t, "*interactors.networkStatusInteractor", fmt.Sprintf("%T", querier))
+	})
+}
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-keys-monitor-go/config"
 	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
 	"github.com/multiversx/mx-chain-keys-monitor-go/notifiers"
@@ -9,14 +11,16 @@ import (
 
 var log = logger.GetOrCreate("factory")
 
-// CreateOutputNotifiers will create the output notifiers based on the provided configuration
-func CreateOutputNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotifier, error) {
+// CreateOutputNotifiers will create the output notifiers based on the provided configuration, together with the
+// rate limiters of each notifier
+func CreateOutputNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotifier, map[executors.OutputNotifier][]executors.RateLimiter, error) {
 	outputNotifiers := make([]executors.OutputNotifier, 0)
+	rateLimiters := make(map[executors.OutputNotifier][]executors.RateLimiter)
 
 	logForNotifier := logger.GetOrCreate("notifiers")
 	logNotifier, err := notifiers.NewLogNotifier(logForNotifier)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	log.Debug("created log notifier")
 
@@ -24,9 +28,17 @@ func CreateOutputNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotif
 	outputNotifiers = append(outputNotifiers, logNotifier)
 
 	if allConfig.Config.OutputNotifiers.Pushover.Enabled {
-		pushoverNotifiers := createPushoverNotifiers(allConfig)
+		pushoverNotifiers, credentials, err := createPushoverNotifiers(allConfig)
+		if err != nil {
+			return nil, nil, err
+		}
 		outputNotifiers = append(outputNotifiers, pushoverNotifiers...)
 
+		err = addRateLimiters(rateLimiters, allConfig.Config.OutputNotifiers.Pushover.RateLimit, pushoverNotifiers, credentials)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the pushover notifiers", err)
+		}
+
 		log.Debug("created pushover notifier(s)", "num pushover notifiers", len(pushoverNotifiers))
 	}
 	if allConfig.Config.OutputNotifiers.Smtp.Enabled {
@@ -38,82 +50,135 @@ func CreateOutputNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotif
 			Password: allConfig.Credentials.Smtp.Password,
 		}
 
-		outputNotifiers = append(outputNotifiers, notifiers.NewSmtpNotifier(args))
+		smtpNotifier := notifiers.NewSmtpNotifier(args)
+		outputNotifiers = append(outputNotifiers, smtpNotifier)
+
+		err = addRateLimiters(rateLimiters, allConfig.Config.OutputNotifiers.Smtp.RateLimit, []executors.OutputNotifier{smtpNotifier}, []string{args.From})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the smtp notifier", err)
+		}
+
 		log.Debug("created smtp notifier")
 	}
 	if allConfig.Config.OutputNotifiers.Telegram.Enabled {
-		telegramNotifiers := createTelegramNotifiers(allConfig)
+		telegramNotifiers, credentials, err := createTelegramNotifiers(allConfig)
+		if err != nil {
+			return nil, nil, err
+		}
 		outputNotifiers = append(outputNotifiers, telegramNotifiers...)
 
+		err = addRateLimiters(rateLimiters, allConfig.Config.OutputNotifiers.Telegram.RateLimit, telegramNotifiers, credentials)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the telegram notifiers", err)
+		}
+
 		log.Debug("created telegram notifier(s)", "num telegram notifiers", len(telegramNotifiers))
 	}
 	if allConfig.Config.OutputNotifiers.Slack.Enabled {
-		slackNotifiers := createSlackNotifiers(allConfig)
+		slackNotifiers, credentials, err := createSlackNotifiers(allConfig)
+		if err != nil {
+			return nil, nil, err
+		}
 		outputNotifiers = append(outputNotifiers, slackNotifiers...)
 
+		err = addRateLimiters(rateLimiters, allConfig.Config.OutputNotifiers.Slack.RateLimit, slackNotifiers, credentials)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the slack notifiers", err)
+		}
+
 		log.Debug("created slack notifier(s)", "num slack notifiers", len(slackNotifiers))
 	}
 
-	return outputNotifiers, nil
+	return outputNotifiers, rateLimiters, nil
 }
 
-func createPushoverNotifiers(allConfig config.AllConfigs) []executors.OutputNotifier {
+// createPushoverNotifiers returns the notifiers and, for each notifier, the credential used
+func createPushoverNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotifier, []string, error) {
+	httpClient, err := NewHTTPClient(allConfig.Config.OutputNotifiers.Pushover.HTTPClient, allConfig.Credentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for the pushover notifiers", err)
+	}
+
 	defaultNotifier := notifiers.NewPushoverNotifier(
+		httpClient,
 		allConfig.Config.OutputNotifiers.Pushover.URL,
 		allConfig.Credentials.Pushover.Token,
 		allConfig.Credentials.Pushover.UserKey,
 	)
 
 	notifierInstances := []executors.OutputNotifier{defaultNotifier}
+	credentialsList := []string{allConfig.Credentials.Pushover.Token + allConfig.Credentials.Pushover.UserKey}
 	for _, credentials := range allConfig.Credentials.Pushover.Additional {
 		notifierInstance := notifiers.NewPushoverNotifier(
+			httpClient,
 			allConfig.Config.OutputNotifiers.Pushover.URL,
 			credentials.Token,
 			credentials.UserKey,
 		)
 
 		notifierInstances = append(notifierInstances, notifierInstance)
+		credentialsList = append(credentialsList, credentials.Token+credentials.UserKey)
 	}
 
-	return notifierInstances
+	return notifierInstances, credentialsList, nil
 }
 
-func createTelegramNotifiers(allConfig config.AllConfigs) []executors.OutputNotifier {
+// createTelegramNotifiers returns the notifiers and, for each notifier, the credential used
+func createTelegramNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotifier, []string, error) {
+	httpClient, err := NewHTTPClient(allConfig.Config.OutputNotifiers.Telegram.HTTPClient, allConfig.Credentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for the telegram notifiers", err)
+	}
+
 	defaultNotifier := notifiers.NewTelegramNotifier(
+		httpClient,
 		allConfig.Config.OutputNotifiers.Telegram.URL,
 		allConfig.Credentials.Telegram.Token,
 		allConfig.Credentials.Telegram.ChatID,
 	)
 
 	notifierInstances := []executors.OutputNotifier{defaultNotifier}
+	credentialsList := []string{allConfig.Credentials.Telegram.Token + allConfig.Credentials.Telegram.ChatID}
 	for _, credentials := range allConfig.Credentials.Telegram.Additional {
 		notifierInstance := notifiers.NewTelegramNotifier(
+			httpClient,
 			allConfig.Config.OutputNotifiers.Telegram.URL,
 			credentials.Token,
 			credentials.ChatID,
 		)
 
 		notifierInstances = append(notifierInstances, notifierInstance)
+		credentialsList = append(credentialsList, credentials.Token+credentials.ChatID)
 	}
 
-	return notifierInstances
+	return notifierInstances, credentialsList, nil
 }
 
-func createSlackNotifiers(allConfig config.AllConfigs) []executors.OutputNotifier {
+// createSlackNotifiers returns the notifiers and, for each notifier, the credential used
+func createSlackNotifiers(allConfig config.AllConfigs) ([]executors.OutputNotifier, []string, error) {
+	httpClient, err := NewHTTPClient(allConfig.Config.OutputNotifiers.Slack.HTTPClient, allConfig.Credentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for the slack notifiers", err)
+	}
+
 	defaultNotifier := notifiers.NewSlackNotifier(
+		httpClient,
 		allConfig.Config.OutputNotifiers.Slack.URL,
 		allConfig.Credentials.Slack.Secret,
 	)
 
 	notifierInstances := []executors.OutputNotifier{defaultNotifier}
+	credentialsList := []string{allConfig.Credentials.Slack.Secret}
 	for _, credentials := range allConfig.Credentials.Slack.Additional {
 		notifierInstance := notifiers.NewSlackNotifier(
+			httpClient,
 			allConfig.Config.OutputNotifiers.Slack.URL,
 			credentials.Secret,
 		)
 
 		notifierInstances = append(notifierInstances, notifierInstance)
+		credentialsList = append(credentialsList, credentials.Secret)
 	}
 
-	return notifierInstances
+	return notifierInstances, credentialsList, nil
 }
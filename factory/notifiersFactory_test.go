@@ -12,14 +12,14 @@ func TestCreateOutputNotifiers(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nothing enabled should create a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{})
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{})
 
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(notifiers))
 		assert.Equal(t, "*notifiers.logNotifier", fmt.Sprintf("%T", notifiers[0]))
 	})
 	t.Run("should create a pushover and a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{
 			Config: config.MainConfig{
 				OutputNotifiers: config.OutputNotifiersConfig{
 					Pushover: config.PushoverNotifierConfig{
@@ -35,7 +35,7 @@ func TestCreateOutputNotifiers(t *testing.T) {
 		assert.Equal(t, "*notifiers.pushoverNotifier", fmt.Sprintf("%T", notifiers[1]))
 	})
 	t.Run("should create 3 pushover notifiers and a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{
 			Config: config.MainConfig{
 				OutputNotifiers: config.OutputNotifiersConfig{
 					Pushover: config.PushoverNotifierConfig{
@@ -71,7 +71,7 @@ func TestCreateOutputNotifiers(t *testing.T) {
 		assert.Equal(t, "*notifiers.pushoverNotifier", fmt.Sprintf("%T", notifiers[3]))
 	})
 	t.Run("should create a smtp and a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{
 			Config: config.MainConfig{
 				OutputNotifiers: config.OutputNotifiersConfig{
 					Smtp: config.SmtpNotifierConfig{
@@ -87,7 +87,7 @@ func TestCreateOutputNotifiers(t *testing.T) {
 		assert.Equal(t, "*notifiers.smtpNotifier", fmt.Sprintf("%T", notifiers[1]))
 	})
 	t.Run("should create a telegram and a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{
 			Config: config.MainConfig{
 				OutputNotifiers: config.OutputNotifiersConfig{
 					Telegram: config.TelegramNotifierConfig{
@@ -103,7 +103,7 @@ func TestCreateOutputNotifiers(t *testing.T) {
 		assert.Equal(t, "*notifiers.telegramNotifier", fmt.Sprintf("%T", notifiers[1]))
 	})
 	t.Run("should create 3 telegram notifiers and a log notifier", func(t *testing.T) {
-		notifiers, err := CreateOutputNotifiers(config.AllConfigs{
+		notifiers, _, err := CreateOutputNotifiers(config.AllConfigs{
 			Config: config.MainConfig{
 				OutputNotifiers: config.OutputNotifiersConfig{
 					Telegram: config.TelegramNotifierConfig{
@@ -138,4 +138,64 @@ func TestCreateOutputNotifiers(t *testing.T) {
 		assert.Equal(t, "*notifiers.telegramNotifier", fmt.Sprintf("%T", notifiers[2]))
 		assert.Equal(t, "*notifiers.telegramNotifier", fmt.Sprintf("%T", notifiers[3]))
 	})
+	t.Run("should create the rate limiters for the notifiers", func(t *testing.T) {
+		notifiers, rateLimiters, err := CreateOutputNotifiers(config.AllConfigs{
+			Config: config.MainConfig{
+				OutputNotifiers: config.OutputNotifiersConfig{
+					Telegram: config.TelegramNotifierConfig{
+						Enabled: true,
+						RateLimit: config.RateLimitConfig{
+							MessagesPerMinute:     20,
+							Burst:                 5,
+							TypeMessagesPerMinute: 1800,
+							TypeBurst:             30,
+						},
+					},
+				},
+			},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(notifiers))
+		assert.Empty(t, rateLimiters[notifiers[0]]) // the log notifier is not rate limited
+		assert.Equal(t, 2, len(rateLimiters[notifiers[1]]))
+	})
+	t.Run("invalid rate limit config should error", func(t *testing.T) {
+		notifiers, rateLimiters, err := CreateOutputNotifiers(config.AllConfigs{
+			Config: config.MainConfig{
+				OutputNotifiers: config.OutputNotifiersConfig{
+					Slack: config.SlackNotifierConfig{
+						Enabled: true,
+						RateLimit: config.RateLimitConfig{
+							MessagesPerMinute: 20,
+						},
+					},
+				},
+			},
+		})
+
+		assert.Nil(t, notifiers)
+		assert.Nil(t, rateLimiters)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for the slack notifiers")
+	})
+	t.Run("invalid HTTP client config should error", func(t *testing.T) {
+		notifiers, rateLimiters, err := CreateOutputNotifiers(config.AllConfigs{
+			Config: config.MainConfig{
+				OutputNotifiers: config.OutputNotifiersConfig{
+					Telegram: config.TelegramNotifierConfig{
+						Enabled: true,
+						HTTPClient: config.HTTPClientConfig{
+							CredentialsName: "missing",
+						},
+					},
+				},
+			},
+		})
+
+		assert.Nil(t, notifiers)
+		assert.Nil(t, rateLimiters)
+		assert.ErrorIs(t, err, errHTTPClientCredentialsNotFound)
+		assert.Contains(t, err.Error(), "for the telegram notifiers")
+	})
 }
@@ -0,0 +1,70 @@
+package factory
+
+import (
+	"io"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors/disabled"
+	"github.com/multiversx/mx-chain-keys-monitor-go/storage"
+)
+
+const disabledOutboxCheckInterval = time.Minute
+
+// CreateNotifiersHandler will create the notifiers handler and its outbox. The returned io.Closer should be called
+// when the application closes
+func CreateNotifiersHandler(
+	cfg config.OutputNotifiersConfig,
+	notifiers []executors.OutputNotifier,
+	rateLimiters map[executors.OutputNotifier][]executors.RateLimiter,
+) (OutputNotifiersHandler, io.Closer, error) {
+	outbox, err := NewOutbox(cfg.Outbox)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outboxCheckInterval := time.Duration(cfg.Outbox.CheckIntervalInSec) * time.Second
+	if !cfg.Outbox.Enabled {
+		outboxCheckInterval = disabledOutboxCheckInterval
+	}
+
+	argsNotifiersHandler := executors.ArgsNotifiersHandler{
+		Notifiers:             notifiers,
+		RateLimiters:          rateLimiters,
+		NumRetries:            cfg.NumRetries,
+		TimeBetweenRetries:    time.Duration(cfg.SecondsBetweenRetries) * time.Second,
+		MaxTimeBetweenRetries: time.Duration(cfg.MaxSecondsBetweenRetries) * time.Second,
+		RetryJitter:           cfg.RetryJitter,
+		SendTimeout:           time.Duration(cfg.SendTimeoutInSeconds) * time.Second,
+		QueueSize:             cfg.QueueSize,
+		Outbox:                outbox,
+		OutboxCheckInterval:   outboxCheckInterval,
+	}
+
+	notifiersHandler, err := executors.NewNotifiersHandler(argsNotifiersHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return notifiersHandler, notifiersHandler, nil
+}
+
+// NewOutbox creates a new instance of type NotificationsOutbox
+func NewOutbox(cfg config.OutboxConfig) (executors.NotificationsOutbox, error) {
+	if !cfg.Enabled {
+		return disabled.NewDisabledOutbox(), nil
+	}
+
+	args := storage.ArgsFileOutbox{
+		Directory:           cfg.Directory,
+		MaxAgeInSec:         cfg.MaxAgeInSec,
+		InitialBackoffInSec: cfg.InitialBackoffInSec,
+		MaxBackoffInSec:     cfg.MaxBackoffInSec,
+		GetCurrentTimestamp: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+
+	return storage.NewFileOutbox(args)
+}
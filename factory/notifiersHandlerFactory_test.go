@@ -0,0 +1,95 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewOutbox(config.OutboxConfig{
+			Enabled: false,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledOutbox", fmt.Sprintf("%T", instance))
+	})
+	t.Run("enabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewOutbox(config.OutboxConfig{
+			Enabled:             true,
+			Directory:           t.TempDir(),
+			MaxAgeInSec:         100,
+			InitialBackoffInSec: 1,
+			MaxBackoffInSec:     10,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "*storage.fileOutbox", fmt.Sprintf("%T", instance))
+	})
+	t.Run("enabled with invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewOutbox(config.OutboxConfig{
+			Enabled: true,
+		})
+		assert.NotNil(t, err)
+		assert.Nil(t, instance)
+	})
+}
+
+func TestCreateNotifiersHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid outbox config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.OutputNotifiersConfig{
+			SecondsBetweenRetries:    1,
+			MaxSecondsBetweenRetries: 1,
+			SendTimeoutInSeconds:     1,
+			QueueSize:                1,
+			Outbox: config.OutboxConfig{
+				Enabled: true,
+			},
+		}
+		handler, closer, err := CreateNotifiersHandler(cfg, nil, nil)
+		assert.NotNil(t, err)
+		assert.Nil(t, handler)
+		assert.Nil(t, closer)
+	})
+	t.Run("invalid notifiers handler config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.OutputNotifiersConfig{
+			SecondsBetweenRetries:    1,
+			MaxSecondsBetweenRetries: 1,
+			SendTimeoutInSeconds:     1,
+			QueueSize:                0,
+		}
+		handler, closer, err := CreateNotifiersHandler(cfg, nil, nil)
+		assert.NotNil(t, err)
+		assert.Nil(t, handler)
+		assert.Nil(t, closer)
+	})
+	t.Run("should work with disabled outbox", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.OutputNotifiersConfig{
+			SecondsBetweenRetries:    1,
+			MaxSecondsBetweenRetries: 1,
+			SendTimeoutInSeconds:     1,
+			QueueSize:                1,
+		}
+		handler, closer, err := CreateNotifiersHandler(cfg, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "*executors.notifiersHandler", fmt.Sprintf("%T", handler))
+		assert.Nil(t, closer.Close())
+	})
+}
@@ -0,0 +1,55 @@
+package factory
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/parsers"
+)
+
+const (
+	listFormat = "list"
+	jsonFormat = "json"
+	yamlFormat = "yaml"
+	ymlFormat  = "yml"
+	csvFormat  = "csv"
+)
+
+// NewIdentitiesParser creates the parser for the monitor's list file. The format is given by the ListFormat option or,
+// if not set, by the file extension. Unknown extensions are parsed as .list files
+func NewIdentitiesParser(cfg config.BLSKeysMonitorConfig) (executors.IdentitiesParser, error) {
+	return newParser(cfg.ListFile, cfg.ListFormat, cfg.Name)
+}
+
+// NewListURLCacheParser creates the parser for the cache file of the monitor's list URL. The format is always given by
+// the cache file extension, unknown extensions are parsed as .list files
+func NewListURLCacheParser(cfg config.BLSKeysMonitorConfig) (executors.IdentitiesParser, error) {
+	return newParser(cfg.ListURLCacheFile, "", cfg.Name)
+}
+
+func newParser(filename string, explicitFormat string, monitorName string) (executors.IdentitiesParser, error) {
+	format := strings.ToLower(explicitFormat)
+	if len(format) == 0 {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+
+	switch format {
+	case jsonFormat:
+		return parsers.NewJSONParser(), nil
+	case yamlFormat, ymlFormat:
+		return parsers.NewYAMLParser(), nil
+	case csvFormat:
+		return parsers.NewCSVParser(), nil
+	case listFormat:
+		return parsers.NewListParser(), nil
+	}
+
+	if len(explicitFormat) > 0 {
+		return nil, fmt.Errorf("%w: %s for monitor %s", errUnknownListFormat, explicitFormat, monitorName)
+	}
+
+	return parsers.NewListParser(), nil
+}
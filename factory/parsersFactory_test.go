@@ -0,0 +1,68 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdentitiesParser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown list format should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser, err := NewIdentitiesParser(config.BLSKeysMonitorConfig{
+			Name:       "test",
+			ListFile:   "keys.list",
+			ListFormat: "xml",
+		})
+		assert.ErrorIs(t, err, errUnknownListFormat)
+		assert.Contains(t, err.Error(), "xml for monitor test")
+		assert.Nil(t, parser)
+	})
+	t.Run("detected by the file extension", func(t *testing.T) {
+		t.Parallel()
+
+		testExtension := func(filename string, expectedType string) {
+			parser, err := NewIdentitiesParser(config.BLSKeysMonitorConfig{
+				ListFile: filename,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, expectedType, fmt.Sprintf("%T", parser))
+		}
+
+		testExtension("keys.list", "*parsers.listParser")
+		testExtension("keys", "*parsers.listParser")
+		testExtension("keys.txt", "*parsers.listParser")
+		testExtension("keys.json", "*parsers.jsonParser")
+		testExtension("keys.JSON", "*parsers.jsonParser")
+		testExtension("keys.yaml", "*parsers.yamlParser")
+		testExtension("keys.yml", "*parsers.yamlParser")
+		testExtension("keys.csv", "*parsers.csvParser")
+	})
+	t.Run("explicit list format should take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		parser, err := NewIdentitiesParser(config.BLSKeysMonitorConfig{
+			ListFile:   "inventory.txt",
+			ListFormat: "CSV",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "*parsers.csvParser", fmt.Sprintf("%T", parser))
+	})
+}
+
+func TestNewListURLCacheParser(t *testing.T) {
+	t.Parallel()
+
+	parser, err := NewListURLCacheParser(config.BLSKeysMonitorConfig{
+		ListFile:         "keys.list",
+		ListFormat:       "csv",
+		ListURLCacheFile: "./db/keys.yaml",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "*parsers.yamlParser", fmt.Sprintf("%T", parser))
+}
@@ -0,0 +1,64 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/ratelimit"
+)
+
+// addRateLimiters will create the rate limiters for the provided notifiers of the same type. The notifiers that use
+// the same credential will share the same token bucket, while all notifiers share the token bucket of the type
+func addRateLimiters(
+	rateLimiters map[executors.OutputNotifier][]executors.RateLimiter,
+	cfg config.RateLimitConfig,
+	notifiers []executors.OutputNotifier,
+	credentials []string,
+) error {
+	var typeRateLimiter executors.RateLimiter
+	if cfg.TypeMessagesPerMinute > 0 {
+		var err error
+		typeRateLimiter, err = createTokenBucket(cfg.TypeMessagesPerMinute, cfg.TypeBurst)
+		if err != nil {
+			return err
+		}
+	}
+
+	credentialsRateLimiters := make(map[string]executors.RateLimiter)
+	for index, notifier := range notifiers {
+		if cfg.MessagesPerMinute > 0 {
+			credentialRateLimiter, found := credentialsRateLimiters[credentials[index]]
+			if !found {
+				var err error
+				credentialRateLimiter, err = createTokenBucket(cfg.MessagesPerMinute, cfg.Burst)
+				if err != nil {
+					return err
+				}
+				credentialsRateLimiters[credentials[index]] = credentialRateLimiter
+			}
+
+			rateLimiters[notifier] = append(rateLimiters[notifier], credentialRateLimiter)
+		}
+		if typeRateLimiter != nil {
+			rateLimiters[notifier] = append(rateLimiters[notifier], typeRateLimiter)
+		}
+	}
+
+	return nil
+}
+
+func createTokenBucket(messagesPerMinute float64, burst uint32) (executors.RateLimiter, error) {
+	args := ratelimit.ArgsTokenBucket{
+		MessagesPerMinute: messagesPerMinute,
+		Burst:             burst,
+		GetCurrentTime:    time.Now,
+	}
+
+	tokenBucket, err := ratelimit.NewTokenBucket(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenBucket, nil
+}
@@ -0,0 +1,83 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	notifier1 := &mock.OutputNotifierStub{}
+	notifier2 := &mock.OutputNotifierStub{}
+	notifier3 := &mock.OutputNotifierStub{}
+	testNotifiers := []executors.OutputNotifier{notifier1, notifier2, notifier3}
+	testCredentials := []string{"credential1", "credential2", "credential1"}
+
+	t.Run("disabled limits should not create rate limiters", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimiters := make(map[executors.OutputNotifier][]executors.RateLimiter)
+		err := addRateLimiters(rateLimiters, config.RateLimitConfig{}, testNotifiers, testCredentials)
+		assert.Nil(t, err)
+		assert.Empty(t, rateLimiters)
+	})
+	t.Run("invalid burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimiters := make(map[executors.OutputNotifier][]executors.RateLimiter)
+		cfg := config.RateLimitConfig{
+			MessagesPerMinute: 10,
+		}
+		err := addRateLimiters(rateLimiters, cfg, testNotifiers, testCredentials)
+		assert.NotNil(t, err)
+
+		cfg = config.RateLimitConfig{
+			TypeMessagesPerMinute: 10,
+		}
+		err = addRateLimiters(rateLimiters, cfg, testNotifiers, testCredentials)
+		assert.NotNil(t, err)
+	})
+	t.Run("should share the buckets between the same credentials and the same type", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimiters := make(map[executors.OutputNotifier][]executors.RateLimiter)
+		cfg := config.RateLimitConfig{
+			MessagesPerMinute:     20,
+			Burst:                 5,
+			TypeMessagesPerMinute: 100,
+			TypeBurst:             10,
+		}
+		err := addRateLimiters(rateLimiters, cfg, testNotifiers, testCredentials)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(rateLimiters))
+		for _, notifier := range testNotifiers {
+			assert.Equal(t, 2, len(rateLimiters[notifier]))
+		}
+
+		// same credential, same bucket
+		assert.True(t, rateLimiters[notifier1][0] == rateLimiters[notifier3][0])
+		assert.False(t, rateLimiters[notifier1][0] == rateLimiters[notifier2][0])
+		// the type bucket is shared by all
+		assert.True(t, rateLimiters[notifier1][1] == rateLimiters[notifier2][1])
+		assert.True(t, rateLimiters[notifier1][1] == rateLimiters[notifier3][1])
+	})
+	t.Run("only the type limit enabled", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimiters := make(map[executors.OutputNotifier][]executors.RateLimiter)
+		cfg := config.RateLimitConfig{
+			TypeMessagesPerMinute: 100,
+			TypeBurst:             10,
+		}
+		err := addRateLimiters(rateLimiters, cfg, testNotifiers, testCredentials)
+		assert.Nil(t, err)
+		for _, notifier := range testNotifiers {
+			assert.Equal(t, 1, len(rateLimiters[notifier]))
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package factory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/interactors"
+)
+
+// NewStatisticsCache creates the cache that shares the validator statistics between the monitors querying the same API
+func NewStatisticsCache(generalConfig config.GeneralConfigs) (StatisticsCache, error) {
+	args := interactors.ArgsStatisticsCache{
+		TTL:      time.Duration(generalConfig.StatisticsCacheTTLInSeconds) * time.Second,
+		TimeFunc: time.Now,
+	}
+
+	return interactors.NewStatisticsCache(args)
+}
+
+// createStatisticsCacheKey returns the key under which the validator statistics are shared. The statistics are shared
+// only between the monitors querying the same API URLs with the same HTTP client configuration, as the headers, the
+// credentials and the timeouts can change the response. The credentials are identified by their name, so the secrets
+// are not part of the key
+func createStatisticsCacheKey(apiURLs []string, cfg config.BLSKeysMonitorConfig) string {
+	headerNames := make([]string, 0, len(cfg.HTTPClient.Headers))
+	for name := range cfg.HTTPClient.Headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	headers := make([]string, 0, len(headerNames))
+	for _, name := range headerNames {
+		headers = append(headers, fmt.Sprintf("%q=%q", name, cfg.HTTPClient.Headers[name]))
+	}
+
+	fields := []string{
+		"urls=" + strings.Join(apiURLs, ","),
+		"headers=" + strings.Join(headers, ","),
+		"timeout=" + strconv.Itoa(cfg.HTTPClient.TimeoutInSeconds),
+		"proxy=" + cfg.HTTPClient.ProxyURL,
+		"caBundle=" + cfg.HTTPClient.CABundleFile,
+		"clientCert=" + cfg.HTTPClient.ClientCertFile,
+		"clientKey=" + cfg.HTTPClient.ClientKeyFile,
+		"credentials=" + cfg.HTTPClient.CredentialsName,
+		"requestTimeout=" + strconv.Itoa(cfg.ApiRequestTimeoutInSeconds),
+		"unhealthyRetryInterval=" + strconv.Itoa(cfg.ApiUnhealthyRetryIntervalInSeconds),
+	}
+
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,61 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateStatisticsCacheKey(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.BLSKeysMonitorConfig{
+		ApiURL: "url",
+		HTTPClient: config.HTTPClientConfig{
+			Headers: map[string]string{"X-Api-Key": "key1"},
+		},
+	}
+	key := createStatisticsCacheKey([]string{"url"}, cfg)
+	assert.Equal(t, key, createStatisticsCacheKey([]string{"url"}, cfg))
+	assert.NotEqual(t, key, createStatisticsCacheKey([]string{"url", "fallback"}, cfg))
+
+	otherHeaders := cfg
+	otherHeaders.HTTPClient.Headers = map[string]string{"X-Api-Key": "key2"}
+	assert.NotEqual(t, key, createStatisticsCacheKey([]string{"url"}, otherHeaders))
+
+	otherCredentials := cfg
+	otherCredentials.HTTPClient.CredentialsName = "other"
+	assert.NotEqual(t, key, createStatisticsCacheKey([]string{"url"}, otherCredentials))
+
+	otherTimeout := cfg
+	otherTimeout.ApiRequestTimeoutInSeconds = 5
+	assert.NotEqual(t, key, createStatisticsCacheKey([]string{"url"}, otherTimeout))
+
+	otherProxy := cfg
+	otherProxy.HTTPClient.ProxyURL = "http://proxy"
+	assert.NotEqual(t, key, createStatisticsCacheKey([]string{"url"}, otherProxy))
+}
+
+func TestCreateStatisticsCacheKey_ShouldNotDependOnTheHeadersOrder(t *testing.T) {
+	t.Parallel()
+
+	headers := make(map[string]string)
+	for i := 0; i < 20; i++ {
+		headers[fmt.Sprintf("X-Header-%d", i)] = fmt.Sprintf("value%d", i)
+	}
+	cfg := config.BLSKeysMonitorConfig{
+		HTTPClient: config.HTTPClientConfig{
+			Headers:         headers,
+			CredentialsName: "api",
+		},
+	}
+
+	key := createStatisticsCacheKey([]string{"url"}, cfg)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, key, createStatisticsCacheKey([]string{"url"}, cfg))
+	}
+	assert.Equal(t, `urls=url headers="X-Header-0"="value0",`, key[:len(`urls=url headers="X-Header-0"="value0",`)])
+	assert.Contains(t, key, " credentials=api ")
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/config"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
-	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
 	"github.com/multiversx/mx-chain-keys-monitor-go/factory"
 	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -58,8 +57,11 @@ func TestBlsKeysNotifier(t *testing.T) {
 	allConfigs := config.AllConfigs{
 		Config: config.MainConfig{
 			OutputNotifiers: config.OutputNotifiersConfig{
-				NumRetries:            0,
-				SecondsBetweenRetries: 1,
+				NumRetries:               0,
+				SecondsBetweenRetries:    1,
+				MaxSecondsBetweenRetries: 1,
+				SendTimeoutInSeconds:     1,
+				QueueSize:                10,
 				Pushover: config.PushoverNotifierConfig{
 					Enabled: false,
 				},
@@ -74,25 +76,27 @@ func TestBlsKeysNotifier(t *testing.T) {
 	}
 
 	// test also the output notifiers factory
-	notifiers, err := factory.CreateOutputNotifiers(allConfigs)
+	notifiers, rateLimiters, err := factory.CreateOutputNotifiers(allConfigs)
 	assert.Nil(t, err)
 
 	notifiers = append(notifiers, notifier) // add the notifier used in this test
 
-	argsNotifiersHandler := executors.ArgsNotifiersHandler{
-		Notifiers:          notifiers,
-		NumRetries:         allConfigs.Config.OutputNotifiers.NumRetries,
-		TimeBetweenRetries: time.Second * time.Duration(allConfigs.Config.OutputNotifiers.SecondsBetweenRetries),
-	}
+	notifiersHandler, notifiersHandlerCloser, err := factory.CreateNotifiersHandler(allConfigs.Config.OutputNotifiers, notifiers, rateLimiters)
+	assert.Nil(t, err)
 
-	notifiersHandler, err := executors.NewNotifiersHandler(argsNotifiersHandler)
+	statisticsCache, err := factory.NewStatisticsCache(config.GeneralConfigs{})
+	assert.Nil(t, err)
+
+	monitorIdentities, err := factory.LoadBLSKeysMonitorIdentities(cfg, config.CredentialsConfig{})
 	assert.Nil(t, err)
 
 	monitor, err := factory.NewBLSKeysMonitor(
-		cfg,
+		monitorIdentities,
 		config.AlarmSnoozeConfig{},
+		config.CredentialsConfig{},
 		notifiersHandler,
 		errorHandler,
+		statisticsCache,
 	)
 	assert.Nil(t, err)
 
@@ -100,6 +104,8 @@ func TestBlsKeysNotifier(t *testing.T) {
 
 	err = monitor.Close()
 	assert.Nil(t, err)
+	err = notifiersHandlerCloser.Close()
+	assert.Nil(t, err)
 
 	expectedMessages := []core.OutputMessage{
 		{
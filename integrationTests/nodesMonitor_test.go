@@ -0,0 +1,145 @@
+package integrationTests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/config"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/executors"
+	"github.com/multiversx/mx-chain-keys-monitor-go/factory"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFakeNodeServer(nonce uint64, isSyncing uint64, numPeers uint64, appVersion string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/node/status" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{"data":{"metrics":{"erd_nonce":%d,"erd_probable_highest_nonce":%d,`+
+			`"erd_is_syncing":%d,"erd_num_connected_peers":%d,"erd_app_version":"%s","erd_shard_id":1,"erd_epoch_number":37}},"code":"successful"}`,
+			nonce, nonce, isSyncing, numPeers, appVersion)))
+	}))
+}
+
+func TestNodesMonitor(t *testing.T) {
+	healthyNode := createFakeNodeServer(1000, 0, 50, "v1.7.13-0-gabcdef/go1.20.7/linux-amd64")
+	defer healthyNode.Close()
+
+	laggingNode := createFakeNodeServer(900, 1, 5, "v1.7.12-0-gabcdef/go1.20.7/linux-amd64")
+	defer laggingNode.Close()
+
+	downNode := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer downNode.Close()
+
+	networkServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/network/status/1", req.URL.Path)
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte(`{"data":{"status":{"erd_nonce":1001}},"code":"successful"}`))
+	}))
+	defer networkServer.Close()
+
+	cfg := config.NodesMonitorConfig{
+		Name:                     "integration-test",
+		NetworkURL:               networkServer.URL,
+		PollingIntervalInSeconds: 60,
+		MaxNonceLag:              10,
+		MinNumConnectedPeers:     10,
+		ExpectedAppVersion:       "v1.7.13",
+		Nodes: []config.NodeConfig{
+			{
+				Name: "healthy",
+				URL:  healthyNode.URL,
+			},
+			{
+				Name: "lagging",
+				URL:  laggingNode.URL,
+			},
+			{
+				Name: "down",
+				URL:  downNode.URL,
+			},
+		},
+	}
+	statusHandler := &mock.StatusHandlerStub{
+		ErrorEncounteredHandler: func(err error) {
+			require.Fail(t, "should have not called ErrorEncountered")
+		},
+	}
+
+	mut := sync.RWMutex{}
+	result := make([]core.OutputMessage, 0)
+	notifier := &mock.OutputNotifierStub{
+		OutputMessagesHandler: func(messages ...core.OutputMessage) error {
+			mut.Lock()
+			result = append(result, messages...)
+			mut.Unlock()
+
+			return nil
+		},
+	}
+
+	outputNotifiersConfig := config.OutputNotifiersConfig{
+		SecondsBetweenRetries:    1,
+		MaxSecondsBetweenRetries: 1,
+		SendTimeoutInSeconds:     1,
+		QueueSize:                10,
+	}
+	notifiersHandler, notifiersHandlerCloser, err := factory.CreateNotifiersHandler(outputNotifiersConfig, []executors.OutputNotifier{notifier}, nil)
+	require.Nil(t, err)
+
+	monitor, err := factory.NewNodesMonitor(
+		cfg,
+		config.AlarmSnoozeConfig{},
+		config.CredentialsConfig{},
+		notifiersHandler,
+		statusHandler,
+	)
+	require.Nil(t, err)
+
+	time.Sleep(time.Second)
+
+	err = monitor.Close()
+	assert.Nil(t, err)
+	err = notifiersHandlerCloser.Close()
+	assert.Nil(t, err)
+
+	expectedEpoch := uint32(37)
+	expectedMessages := []core.OutputMessage{
+		{
+			IdentifierType:  "Node",
+			Identifier:      "lagging",
+			ShortIdentifier: "lagging",
+			ExecutorName:    "integration-test",
+			ProblemEncountered: "Not synchronized: nonce: 900, probable highest nonce: 900; " +
+				"Nonce lag: nonce: 900, network nonce: 1001, lag: 101 blocks; " +
+				"Low peer count: connected peers: 5, minimum: 10; " +
+				"App version mismatch: running: v1.7.12-0-gabcdef/go1.20.7/linux-amd64, expected: v1.7.13",
+			Type:  core.ErrorMessageOutputType,
+			Epoch: &expectedEpoch,
+		},
+		{
+			IdentifierType:     "Node",
+			Identifier:         "down",
+			ShortIdentifier:    "down",
+			ExecutorName:       "integration-test",
+			ProblemEncountered: "Node status can not be queried: HTTP return code is not OK, but 500",
+			Type:               core.ErrorMessageOutputType,
+		},
+	}
+
+	mut.RLock()
+	assert.Equal(t, expectedMessages, result)
+	mut.RUnlock()
+}
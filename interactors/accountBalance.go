@@ -0,0 +1,87 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const (
+	accountBalanceURLFormat = "address/%s/balance"
+	esdtBalanceURLFormat    = "address/%s/esdt/%s"
+)
+
+type accountBalanceInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewAccountBalanceInteractor can interact with the address API endpoint routes of a proxy
+func NewAccountBalanceInteractor(httpClientWrapper HTTPClientWrapper) (*accountBalanceInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &accountBalanceInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// GetBalance returns the balance of the provided bech32 address, in the smallest denomination. The EGLD balance is
+// returned if the token is empty, otherwise the balance of the provided ESDT token
+func (interactor *accountBalanceInteractor) GetBalance(ctx context.Context, address string, token string) (*big.Int, error) {
+	log.Debug("accountBalanceInteractor.GetBalance", "address", address, "token", token)
+	if len(token) == 0 {
+		return interactor.getEGLDBalance(ctx, address)
+	}
+
+	return interactor.getESDTBalance(ctx, address, token)
+}
+
+func (interactor *accountBalanceInteractor) getEGLDBalance(ctx context.Context, address string) (*big.Int, error) {
+	response := &core.AccountBalanceResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, fmt.Sprintf(accountBalanceURLFormat, url.PathEscape(address)), response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w, %s", errInvalidBalanceResponse, response.Error)
+	}
+
+	return parseBalance(response.Data.Balance)
+}
+
+func (interactor *accountBalanceInteractor) getESDTBalance(ctx context.Context, address string, token string) (*big.Int, error) {
+	endpoint := fmt.Sprintf(esdtBalanceURLFormat, url.PathEscape(address), url.PathEscape(token))
+	response := &core.ESDTBalanceResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, endpoint, response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w, %s", errInvalidBalanceResponse, response.Error)
+	}
+	// the proxy returns an empty token data if the account does not hold the token
+	if len(response.Data.TokenData.Balance) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	return parseBalance(response.Data.TokenData.Balance)
+}
+
+func parseBalance(balance string) (*big.Int, error) {
+	value, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w, invalid balance %q", errInvalidBalanceResponse, balance)
+	}
+
+	return value, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *accountBalanceInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
@@ -0,0 +1,149 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBalanceAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func TestNewAccountBalanceInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewAccountBalanceInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewAccountBalanceInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountBalanceInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *accountBalanceInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &accountBalanceInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestAccountBalanceInteractor_GetBalance(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "")
+		assert.Nil(t, balance)
+		assert.Equal(t, expectedErr, err)
+
+		balance, err = instance.GetBalance(context.Background(), testBalanceAddress, "USDC-c76f1f")
+		assert.Nil(t, balance)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("not-ok status code should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusInternalServerError, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "")
+		assert.Nil(t, balance)
+		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+	})
+	t.Run("error in response should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":null,"error":"invalid address","code":"bad_request"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "")
+		assert.Nil(t, balance)
+		assert.ErrorIs(t, err, errInvalidBalanceResponse)
+		assert.Contains(t, err.Error(), "invalid address")
+
+		balance, err = instance.GetBalance(context.Background(), testBalanceAddress, "USDC-c76f1f")
+		assert.Nil(t, balance)
+		assert.ErrorIs(t, err, errInvalidBalanceResponse)
+	})
+	t.Run("invalid balance should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{"balance":"not-a-number"},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "")
+		assert.Nil(t, balance)
+		assert.ErrorIs(t, err, errInvalidBalanceResponse)
+		assert.Contains(t, err.Error(), "not-a-number")
+	})
+	t.Run("should return the EGLD balance", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "address/"+testBalanceAddress+"/balance", endpoint)
+				return []byte(`{"data":{"balance":"1500000000000000000"},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "")
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1500000000000000000), balance)
+	})
+	t.Run("should return the ESDT balance", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "address/"+testBalanceAddress+"/esdt/USDC-c76f1f", endpoint)
+				return []byte(`{"data":{"tokenData":{"tokenIdentifier":"USDC-c76f1f","balance":"2500000"}},"code":"successful"}`),
+					http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "USDC-c76f1f")
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(2500000), balance)
+	})
+	t.Run("missing ESDT token should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{"tokenData":{}},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAccountBalanceInteractor(wrapper)
+		balance, err := instance.GetBalance(context.Background(), testBalanceAddress, "USDC-c76f1f")
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0), balance)
+	})
+}
@@ -0,0 +1,53 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const auctionListURL = "validator/auction"
+
+type auctionListInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewAuctionListInteractor can interact with the validator/auction API endpoint route of a proxy
+func NewAuctionListInteractor(httpClientWrapper HTTPClientWrapper) (*auctionListInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &auctionListInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// Query will query the validator/auction API endpoint route and return the owners from the staking auction list
+func (interactor *auctionListInteractor) Query(ctx context.Context) ([]*core.AuctionListValidator, error) {
+	log.Debug("auctionListInteractor.Query")
+	response := &core.AuctionListResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, auctionListURL, response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w, %s", errInvalidStatusResponse, response.Error)
+	}
+
+	auctionList := make([]*core.AuctionListValidator, 0, len(response.Data.AuctionList))
+	for _, validator := range response.Data.AuctionList {
+		if validator != nil {
+			auctionList = append(auctionList, validator)
+		}
+	}
+
+	return auctionList, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *auctionListInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
@@ -0,0 +1,120 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuctionListInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewAuctionListInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewAuctionListInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuctionListInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *auctionListInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &auctionListInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestAuctionListInteractor_Query(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewAuctionListInteractor(wrapper)
+		auctionList, err := instance.Query(context.Background())
+		assert.Nil(t, auctionList)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("not-ok status code should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusInternalServerError, nil
+			},
+		}
+		instance, _ := NewAuctionListInteractor(wrapper)
+		auctionList, err := instance.Query(context.Background())
+		assert.Nil(t, auctionList)
+		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+	})
+	t.Run("error in response should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":null,"error":"staking v4 not enabled","code":"internal_issue"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAuctionListInteractor(wrapper)
+		auctionList, err := instance.Query(context.Background())
+		assert.Nil(t, auctionList)
+		assert.ErrorIs(t, err, errInvalidStatusResponse)
+		assert.Contains(t, err.Error(), "staking v4 not enabled")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, auctionListURL, endpoint)
+				return []byte(`{"data":{"auctionList":[` +
+					`{"owner":"owner1","numStakedNodes":2,"totalTopUp":"200","topUpPerNode":"100","qualifiedTopUp":"100",` +
+					`"nodes":[{"blsKey":"key1","qualified":true},{"blsKey":"key2","qualified":false}]},` +
+					`null` +
+					`]},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewAuctionListInteractor(wrapper)
+		auctionList, err := instance.Query(context.Background())
+		assert.Nil(t, err)
+		expectedAuctionList := []*core.AuctionListValidator{
+			{
+				Owner:          "owner1",
+				NumStakedNodes: 2,
+				TotalTopUp:     "200",
+				TopUpPerNode:   "100",
+				QualifiedTopUp: "100",
+				Nodes: []*core.AuctionNode{
+					{
+						BlsKey:    "key1",
+						Qualified: true,
+					},
+					{
+						BlsKey:    "key2",
+						Qualified: false,
+					},
+				},
+			},
+		}
+		assert.Equal(t, expectedAuctionList, auctionList)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -16,6 +17,7 @@ const (
 	validatorScAddress       = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqplllst77y4l"
 	endpoint                 = "vm-values/query"
 	stakedStatus             = "staked"
+	minConcurrentRequests    = 1
 )
 
 type vmQueryRequest struct {
@@ -28,64 +30,191 @@ type vmQueryRequest struct {
 type vmQueryResponse struct {
 	Data struct {
 		Data struct {
-			ReturnData [][]byte `json:"returnData"`
-			ReturnCode string   `json:"returnCode"`
+			ReturnData    [][]byte `json:"returnData"`
+			ReturnCode    string   `json:"returnCode"`
+			ReturnMessage string   `json:"returnMessage"`
 		} `json:"data"`
 	} `json:"data"`
 	Code string `json:"code"`
 }
 
+// ArgsBLSKeysFetcher is the DTO used to create a new instance of type blsKeysFetcher
+type ArgsBLSKeysFetcher struct {
+	HTTPClientWrapper     HTTPClientWrapper
+	Addresses             []core.Address
+	RateLimiter           RateLimiter
+	MaxConcurrentRequests int
+	RefreshInterval       time.Duration
+	TimeFunc              func() time.Time
+}
+
 type blsKeysFetcher struct {
-	httpClientWrapper  HTTPClientWrapper
-	addresses          []core.Address
-	timeBetweenQueries time.Duration
-}
-
-// NewBLSKeysFetcher creates a new instance of type bls keys fetcher
-func NewBLSKeysFetcher(
-	httpClientWrapper HTTPClientWrapper,
-	addresses []core.Address,
-	timeBetweenQueries time.Duration,
-) (*blsKeysFetcher, error) {
-	if check.IfNil(httpClientWrapper) {
+	httpClientWrapper     HTTPClientWrapper
+	rateLimiter           RateLimiter
+	maxConcurrentRequests int
+	refreshInterval       time.Duration
+	timeFunc              func() time.Time
+
+	mutAddresses sync.RWMutex
+	addresses    []core.Address
+
+	mutCache sync.Mutex
+	cache    map[string]cachedBLSKeys
+}
+
+type cachedBLSKeys struct {
+	blsKeys   []string
+	fetchedAt time.Time
+}
+
+// NewBLSKeysFetcher creates a new instance of type bls keys fetcher. The BLS keys of the addresses are fetched using
+// at most MaxConcurrentRequests concurrent requests, limited by the rate limiter. The BLS keys of each address are
+// cached and fetched again only after the refresh interval passed. A refresh interval of 0 fetches them on each call,
+// the cached BLS keys being used only if the fetch fails
+func NewBLSKeysFetcher(args ArgsBLSKeysFetcher) (*blsKeysFetcher, error) {
+	if check.IfNil(args.HTTPClientWrapper) {
 		return nil, errNilHTTPClientWrapper
 	}
+	if check.IfNil(args.RateLimiter) {
+		return nil, errNilRateLimiter
+	}
+	if args.MaxConcurrentRequests < minConcurrentRequests {
+		return nil, fmt.Errorf("%w, provided: %d, minimum: %d", errInvalidMaxConcurrentRequests, args.MaxConcurrentRequests, minConcurrentRequests)
+	}
+	if args.RefreshInterval < 0 {
+		return nil, errInvalidRefreshInterval
+	}
+	if args.TimeFunc == nil {
+		return nil, errNilTimeFunc
+	}
 
 	return &blsKeysFetcher{
-		httpClientWrapper:  httpClientWrapper,
-		addresses:          addresses,
-		timeBetweenQueries: timeBetweenQueries,
+		httpClientWrapper:     args.HTTPClientWrapper,
+		rateLimiter:           args.RateLimiter,
+		maxConcurrentRequests: args.MaxConcurrentRequests,
+		refreshInterval:       args.RefreshInterval,
+		timeFunc:              args.TimeFunc,
+		addresses:             args.Addresses,
+		cache:                 make(map[string]cachedBLSKeys),
 	}, nil
 }
 
-// GetAllBLSKeys will fetch all BLS keys for the set addresses
-func (fetcher *blsKeysFetcher) GetAllBLSKeys(ctx context.Context, sender string) ([]string, error) {
-	allBLSKeys := make([]string, 0)
-
-	timer := time.NewTimer(fetcher.timeBetweenQueries)
-	defer timer.Stop()
-
+// GetAllBLSKeys will fetch all BLS keys for the set addresses, grouped by the owner address
+func (fetcher *blsKeysFetcher) GetAllBLSKeys(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
+	fetcher.mutAddresses.RLock()
+	addresses := make([]core.Address, 0, len(fetcher.addresses))
 	for _, address := range fetcher.addresses {
-		if len(address.Hex) == 0 {
-			continue
+		if len(address.Hex) > 0 {
+			addresses = append(addresses, address)
 		}
+	}
+	fetcher.mutAddresses.RUnlock()
+
+	allBLSKeys := make([]core.OwnerBLSKeys, len(addresses))
+	errs := make([]error, len(addresses))
+	indexes := make(chan int)
 
-		blsKeys, err := fetcher.getBlsKeys(ctx, address, sender)
+	numWorkers := fetcher.maxConcurrentRequests
+	if numWorkers > len(addresses) {
+		numWorkers = len(addresses)
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				allBLSKeys[index].Owner = addresses[index]
+				allBLSKeys[index].BLSKeys, errs[index] = fetcher.getCachedBLSKeys(ctx, addresses[index], sender)
+			}
+		}()
+	}
+
+	fetcher.sendIndexes(ctx, indexes, len(addresses))
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, errContextClosing
+	}
+	for _, err := range errs {
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		timer.Reset(fetcher.timeBetweenQueries)
+	return allBLSKeys, nil
+}
+
+func (fetcher *blsKeysFetcher) sendIndexes(ctx context.Context, indexes chan int, numIndexes int) {
+	defer close(indexes)
+
+	for index := 0; index < numIndexes; index++ {
 		select {
-		case <-timer.C:
+		case indexes <- index:
 		case <-ctx.Done():
-			return nil, errContextClosing
+			return
 		}
+	}
+}
+
+// getCachedBLSKeys returns the cached BLS keys of the address, if not expired. Otherwise, the BLS keys are fetched
+// again and, if the fetch fails, the previously cached BLS keys are returned
+func (fetcher *blsKeysFetcher) getCachedBLSKeys(ctx context.Context, address core.Address, sender string) ([]string, error) {
+	fetcher.mutCache.Lock()
+	cached, found := fetcher.cache[address.Hex]
+	fetcher.mutCache.Unlock()
 
-		allBLSKeys = append(allBLSKeys, blsKeys...)
+	if found && fetcher.timeFunc().Sub(cached.fetchedAt) < fetcher.refreshInterval {
+		return cached.blsKeys, nil
 	}
 
-	return allBLSKeys, nil
+	blsKeys, err := fetcher.fetchBLSKeys(ctx, address, sender)
+	if err != nil {
+		if found && ctx.Err() == nil {
+			log.Warn("blsKeysFetcher: could not refresh the BLS keys, using the cached ones", "sender", sender,
+				"address", address.Bech32, "fetched at", cached.fetchedAt.Format(time.RFC3339), "error", err)
+
+			return cached.blsKeys, nil
+		}
+
+		return nil, err
+	}
+
+	fetcher.mutCache.Lock()
+	fetcher.cache[address.Hex] = cachedBLSKeys{
+		blsKeys:   blsKeys,
+		fetchedAt: fetcher.timeFunc(),
+	}
+	fetcher.mutCache.Unlock()
+
+	return blsKeys, nil
+}
+
+func (fetcher *blsKeysFetcher) fetchBLSKeys(ctx context.Context, address core.Address, sender string) ([]string, error) {
+	err := fetcher.waitRateLimiter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetcher.getBlsKeys(ctx, address, sender)
+}
+
+func (fetcher *blsKeysFetcher) waitRateLimiter(ctx context.Context) error {
+	for {
+		waitTime := fetcher.rateLimiter.Reserve()
+		if waitTime == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(waitTime)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errContextClosing
+		}
+	}
 }
 
 func (fetcher *blsKeysFetcher) getBlsKeys(ctx context.Context, address core.Address, sender string) ([]string, error) {
@@ -97,20 +226,7 @@ func (fetcher *blsKeysFetcher) getBlsKeys(ctx context.Context, address core.Addr
 		Args:      []string{address.Hex},
 	}
 
-	requestBytes, err := json.Marshal(request)
-	if err != nil {
-		return nil, err
-	}
-
-	responseBytes, statusCode, err := fetcher.httpClientWrapper.PostHTTP(ctx, endpoint, requestBytes)
-	if err != nil {
-		return nil, err
-	}
-	if !core.IsHttpStatusCodeSuccess(statusCode) {
-		return nil, fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
-	}
-	response := &vmQueryResponse{}
-	err = json.Unmarshal(responseBytes, response)
+	response, err := postVMQuery(ctx, fetcher.httpClientWrapper, request)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +251,62 @@ func (fetcher *blsKeysFetcher) getBlsKeys(ctx context.Context, address core.Addr
 	return keys, nil
 }
 
+// postVMQuery sends the smart contract query on the vm-values/query endpoint and unmarshalls the response
+func postVMQuery(ctx context.Context, httpClientWrapper HTTPClientWrapper, request *vmQueryRequest) (*vmQueryResponse, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBytes, statusCode, err := httpClientWrapper.PostHTTP(ctx, endpoint, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !core.IsHttpStatusCodeSuccess(statusCode) {
+		return nil, fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
+	}
+	response := &vmQueryResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SetIdentities will atomically replace the addresses used to fetch the BLS keys. The new addresses are used starting
+// with the next GetAllBLSKeys call and the cached BLS keys of the removed addresses are dropped
+func (fetcher *blsKeysFetcher) SetIdentities(identities *core.IdentitiesHolder) error {
+	if identities == nil {
+		return errNilIdentitiesHolder
+	}
+
+	fetcher.mutAddresses.Lock()
+	fetcher.addresses = identities.Addresses
+	fetcher.mutAddresses.Unlock()
+
+	fetcher.removeUnusedCachedBLSKeys(identities.Addresses)
+
+	return nil
+}
+
+func (fetcher *blsKeysFetcher) removeUnusedCachedBLSKeys(addresses []core.Address) {
+	usedAddresses := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		usedAddresses[address.Hex] = struct{}{}
+	}
+
+	fetcher.mutCache.Lock()
+	defer fetcher.mutCache.Unlock()
+
+	for address := range fetcher.cache {
+		_, isUsed := usedAddresses[address]
+		if !isUsed {
+			delete(fetcher.cache, address)
+		}
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fetcher *blsKeysFetcher) IsInterfaceNil() bool {
 	return fetcher == nil
@@ -6,7 +6,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,16 +18,56 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func createMockArgsBLSKeysFetcher(wrapper HTTPClientWrapper, addresses []core.Address) ArgsBLSKeysFetcher {
+	return ArgsBLSKeysFetcher{
+		HTTPClientWrapper:     wrapper,
+		Addresses:             addresses,
+		RateLimiter:           &mock.RateLimiterStub{},
+		MaxConcurrentRequests: 2,
+		RefreshInterval:       0,
+		TimeFunc:              time.Now,
+	}
+}
+
 func TestNewBLSKeysFetcher(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
-		instance, err := NewBLSKeysFetcher(nil, nil, 0)
+		instance, err := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(nil, nil))
 		assert.Nil(t, instance)
 		assert.Equal(t, errNilHTTPClientWrapper, err)
 	})
+	t.Run("nil rate limiter should error", func(t *testing.T) {
+		args := createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil)
+		args.RateLimiter = nil
+		instance, err := NewBLSKeysFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilRateLimiter, err)
+	})
+	t.Run("invalid maximum concurrent requests should error", func(t *testing.T) {
+		args := createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil)
+		args.MaxConcurrentRequests = 0
+		instance, err := NewBLSKeysFetcher(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidMaxConcurrentRequests)
+		assert.Contains(t, err.Error(), "provided: 0, minimum: 1")
+	})
+	t.Run("invalid refresh interval should error", func(t *testing.T) {
+		args := createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil)
+		args.RefreshInterval = -time.Second
+		instance, err := NewBLSKeysFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errInvalidRefreshInterval, err)
+	})
+	t.Run("nil time function should error", func(t *testing.T) {
+		args := createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil)
+		args.TimeFunc = nil
+		instance, err := NewBLSKeysFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilTimeFunc, err)
+	})
 	t.Run("should work", func(t *testing.T) {
-		instance, err := NewBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil, 0)
+		instance, err := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil))
 		assert.NotNil(t, instance)
 		assert.Nil(t, err)
 	})
@@ -83,7 +126,7 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, nil, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, nil))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.Nil(t, err)
 		assert.Empty(t, list)
@@ -100,7 +143,7 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, []core.Address{{}}, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, []core.Address{{}}))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.Nil(t, err)
 		assert.Empty(t, list)
@@ -115,7 +158,7 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
 		assert.Empty(t, list)
@@ -129,7 +172,7 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.ErrorIs(t, err, expectedErr)
 		assert.Empty(t, list)
@@ -143,7 +186,7 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid character")
@@ -174,14 +217,19 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.Nil(t, err)
 
-		expectedList := []string{
-			hex.EncodeToString(bls1),
-			hex.EncodeToString(bls2),
-			hex.EncodeToString(bls3),
+		expectedList := []core.OwnerBLSKeys{
+			{
+				Owner:   addresses[0],
+				BLSKeys: []string{hex.EncodeToString(bls1), hex.EncodeToString(bls2)},
+			},
+			{
+				Owner:   addresses[1],
+				BLSKeys: []string{hex.EncodeToString(bls3)},
+			},
 		}
 		assert.Equal(t, expectedList, list)
 	})
@@ -210,14 +258,19 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, 0)
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
 		list, err := instance.GetAllBLSKeys(context.Background(), "test")
 		assert.Nil(t, err)
 
-		expectedList := []string{
-			hex.EncodeToString(bls1),
-			hex.EncodeToString(bls2),
-			hex.EncodeToString(bls3),
+		expectedList := []core.OwnerBLSKeys{
+			{
+				Owner:   addresses[0],
+				BLSKeys: []string{hex.EncodeToString(bls1), hex.EncodeToString(bls2)},
+			},
+			{
+				Owner:   addresses[1],
+				BLSKeys: []string{hex.EncodeToString(bls3)},
+			},
 		}
 		assert.Equal(t, expectedList, list)
 	})
@@ -246,7 +299,13 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 			},
 		}
 
-		instance, _ := NewBLSKeysFetcher(wrapper, addresses, time.Hour)
+		args := createMockArgsBLSKeysFetcher(wrapper, addresses)
+		args.RateLimiter = &mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				return time.Hour
+			},
+		}
+		instance, _ := NewBLSKeysFetcher(args)
 
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
@@ -256,3 +315,255 @@ func TestBlsKeysFetcher_GetAllBLSKeys(t *testing.T) {
 		assert.Empty(t, list)
 	})
 }
+
+func TestBlsKeysFetcher_SetIdentities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil identities should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(&mock.HTTPClientWrapperStub{}, nil))
+		err := instance.SetIdentities(nil)
+		assert.Equal(t, errNilIdentitiesHolder, err)
+	})
+	t.Run("should use the new addresses", func(t *testing.T) {
+		t.Parallel()
+
+		queriedAddresses := make([]string, 0)
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				request := &vmQueryRequest{}
+				_ = json.Unmarshal(data, request)
+				queriedAddresses = append(queriedAddresses, request.Args[0])
+
+				return []byte("{}"), http.StatusOK, nil
+			},
+		}
+
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, []core.Address{{Hex: "old", Bech32: "old"}}))
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Addresses: []core.Address{{Hex: "new", Bech32: "new"}},
+		})
+		assert.Nil(t, err)
+
+		result, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, []string{"new"}, queriedAddresses)
+	})
+}
+
+func createTestBLSKeysResponse(t *testing.T, blsKeys ...[]byte) []byte {
+	response := &vmQueryResponse{}
+	for _, blsKey := range blsKeys {
+		response.Data.Data.ReturnData = append(response.Data.Data.ReturnData, blsKey, []byte(stakedStatus))
+	}
+
+	respBytes, err := json.Marshal(response)
+	assert.Nil(t, err)
+
+	return respBytes
+}
+
+func TestBlsKeysFetcher_CachedBLSKeys(t *testing.T) {
+	t.Parallel()
+
+	addresses := []core.Address{{Hex: "address1", Bech32: "address1"}}
+	bls1 := bytes.Repeat([]byte("1"), core.BLSKeyLen)
+	bls2 := bytes.Repeat([]byte("2"), core.BLSKeyLen)
+	expectedErr := errors.New("expected error")
+
+	t.Run("should use the cached BLS keys during the refresh interval", func(t *testing.T) {
+		t.Parallel()
+
+		numPosts := uint32(0)
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				atomic.AddUint32(&numPosts, 1)
+				return createTestBLSKeysResponse(t, bls1), http.StatusOK, nil
+			},
+		}
+
+		currentTime := time.Now()
+		args := createMockArgsBLSKeysFetcher(wrapper, addresses)
+		args.RefreshInterval = time.Minute
+		args.TimeFunc = func() time.Time {
+			return currentTime
+		}
+		instance, _ := NewBLSKeysFetcher(args)
+
+		expectedList := []core.OwnerBLSKeys{
+			{
+				Owner:   addresses[0],
+				BLSKeys: []string{hex.EncodeToString(bls1)},
+			},
+		}
+		list, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		assert.Equal(t, expectedList, list)
+
+		currentTime = currentTime.Add(time.Second * 59)
+		list, err = instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		assert.Equal(t, expectedList, list)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numPosts))
+
+		// the refresh interval passed
+		currentTime = currentTime.Add(time.Second)
+		_, _ = instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numPosts))
+	})
+	t.Run("failed refresh should use the cached BLS keys", func(t *testing.T) {
+		t.Parallel()
+
+		shouldFail := false
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				if shouldFail {
+					return nil, http.StatusInternalServerError, expectedErr
+				}
+
+				return createTestBLSKeysResponse(t, bls1, bls2), http.StatusOK, nil
+			},
+		}
+
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
+		_, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+
+		shouldFail = true
+		list, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		expectedList := []core.OwnerBLSKeys{
+			{
+				Owner:   addresses[0],
+				BLSKeys: []string{hex.EncodeToString(bls1), hex.EncodeToString(bls2)},
+			},
+		}
+		assert.Equal(t, expectedList, list)
+	})
+	t.Run("failed fetch without cached BLS keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				var request vmQueryRequest
+				_ = json.Unmarshal(data, &request)
+				if request.Args[0] == "address2" {
+					return nil, http.StatusInternalServerError, expectedErr
+				}
+
+				return createTestBLSKeysResponse(t, bls1), http.StatusOK, nil
+			},
+		}
+
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
+		_, _ = instance.GetAllBLSKeys(context.Background(), "test")
+
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Addresses: append(addresses, core.Address{Hex: "address2", Bech32: "address2"}),
+		})
+		assert.Nil(t, err)
+
+		list, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, list)
+	})
+	t.Run("removed addresses should drop the cached BLS keys", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				return createTestBLSKeysResponse(t, bls1), http.StatusOK, nil
+			},
+		}
+
+		instance, _ := NewBLSKeysFetcher(createMockArgsBLSKeysFetcher(wrapper, addresses))
+		_, _ = instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Equal(t, 1, len(instance.cache))
+
+		err := instance.SetIdentities(&core.IdentitiesHolder{
+			Addresses: []core.Address{{Hex: "address2", Bech32: "address2"}},
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, instance.cache)
+	})
+}
+
+func TestBlsKeysFetcher_ConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should not exceed the maximum concurrent requests and keep the order", func(t *testing.T) {
+		t.Parallel()
+
+		numAddresses := 20
+		addresses := make([]core.Address, 0, numAddresses)
+		for i := 0; i < numAddresses; i++ {
+			address := fmt.Sprintf("address%d", i)
+			addresses = append(addresses, core.Address{Hex: address, Bech32: address})
+		}
+
+		numInFlight := int32(0)
+		maxInFlight := int32(0)
+		mut := sync.Mutex{}
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				inFlight := atomic.AddInt32(&numInFlight, 1)
+				defer atomic.AddInt32(&numInFlight, -1)
+
+				mut.Lock()
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mut.Unlock()
+				time.Sleep(time.Millisecond * 10)
+
+				var request vmQueryRequest
+				_ = json.Unmarshal(data, &request)
+				blsKey := bytes.Repeat([]byte(request.Args[0][len("address"):]), core.BLSKeyLen)[:core.BLSKeyLen]
+
+				return createTestBLSKeysResponse(t, blsKey), http.StatusOK, nil
+			},
+		}
+
+		args := createMockArgsBLSKeysFetcher(wrapper, addresses)
+		args.MaxConcurrentRequests = 3
+		instance, _ := NewBLSKeysFetcher(args)
+		list, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		assert.Equal(t, numAddresses, len(list))
+		for i, ownerBLSKeys := range list {
+			assert.Equal(t, addresses[i], ownerBLSKeys.Owner)
+		}
+		assert.LessOrEqual(t, maxInFlight, int32(3))
+		assert.Greater(t, maxInFlight, int32(1))
+	})
+	t.Run("should wait for the rate limiter", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := []core.Address{{Hex: "address1", Bech32: "address1"}}
+		numReserves := 0
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				assert.Equal(t, 3, numReserves)
+				return createTestBLSKeysResponse(t), http.StatusOK, nil
+			},
+		}
+
+		args := createMockArgsBLSKeysFetcher(wrapper, addresses)
+		args.RateLimiter = &mock.RateLimiterStub{
+			ReserveHandler: func() time.Duration {
+				numReserves++
+				if numReserves < 3 {
+					return time.Millisecond
+				}
+
+				return 0
+			},
+		}
+		instance, _ := NewBLSKeysFetcher(args)
+		_, err := instance.GetAllBLSKeys(context.Background(), "test")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numReserves)
+	})
+}
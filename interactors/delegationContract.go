@@ -0,0 +1,105 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const (
+	getContractConfigFuncName   = "getContractConfig"
+	getTotalActiveStakeFuncName = "getTotalActiveStake"
+	getNumNodesFuncName         = "getNumNodes"
+	vmQueryReturnCodeOk         = "ok"
+	trueValue                   = "true"
+
+	contractConfigServiceFeeIndex        = 1
+	contractConfigMaxDelegationCapIndex  = 2
+	contractConfigWithDelegationCapIndex = 5
+)
+
+type delegationContractInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewDelegationContractInteractor can query the state of the delegation contracts using the vm-values/query endpoint
+func NewDelegationContractInteractor(httpClientWrapper HTTPClientWrapper) (*delegationContractInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &delegationContractInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// GetState queries the contract config, the total active stake and the number of nodes of the delegation contract
+func (interactor *delegationContractInteractor) GetState(ctx context.Context, contract core.Address) (*core.DelegationContractState, error) {
+	log.Debug("delegationContractInteractor.GetState", "contract", contract.Bech32)
+	contractConfig, err := interactor.query(ctx, contract, getContractConfigFuncName)
+	if err != nil {
+		return nil, err
+	}
+	if len(contractConfig) <= contractConfigWithDelegationCapIndex {
+		return nil, fmt.Errorf("%w for %s, %d values returned", errInvalidVMQueryResponse,
+			getContractConfigFuncName, len(contractConfig))
+	}
+
+	totalActiveStake, err := interactor.querySingleValue(ctx, contract, getTotalActiveStakeFuncName)
+	if err != nil {
+		return nil, err
+	}
+
+	numNodes, err := interactor.querySingleValue(ctx, contract, getNumNodesFuncName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.DelegationContractState{
+		ServiceFee:        big.NewInt(0).SetBytes(contractConfig[contractConfigServiceFeeIndex]).Uint64(),
+		MaxDelegationCap:  big.NewInt(0).SetBytes(contractConfig[contractConfigMaxDelegationCapIndex]),
+		WithDelegationCap: string(contractConfig[contractConfigWithDelegationCapIndex]) == trueValue,
+		TotalActiveStake:  big.NewInt(0).SetBytes(totalActiveStake),
+		NumNodes:          big.NewInt(0).SetBytes(numNodes).Uint64(),
+	}, nil
+}
+
+func (interactor *delegationContractInteractor) querySingleValue(ctx context.Context, contract core.Address, funcName string) ([]byte, error) {
+	returnData, err := interactor.query(ctx, contract, funcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) == 0 {
+		return nil, fmt.Errorf("%w for %s, no value returned", errInvalidVMQueryResponse, funcName)
+	}
+
+	return returnData[0], nil
+}
+
+func (interactor *delegationContractInteractor) query(ctx context.Context, contract core.Address, funcName string) ([][]byte, error) {
+	request := &vmQueryRequest{
+		ScAddress: contract.Bech32,
+		FuncName:  funcName,
+		Caller:    contract.Bech32,
+		Args:      make([]string, 0),
+	}
+
+	response, err := postVMQuery(ctx, interactor.httpClientWrapper, request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Data.ReturnCode != vmQueryReturnCodeOk {
+		return nil, fmt.Errorf("%w for %s on %s, return code: %s, message: %s", errInvalidVMQueryResponse, funcName,
+			contract.Bech32, response.Data.Data.ReturnCode, response.Data.Data.ReturnMessage)
+	}
+
+	return response.Data.Data.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *delegationContractInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
@@ -0,0 +1,180 @@
+package interactors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDelegationContract = core.Address{
+	Hex:    "000000000000000000010000000000000000000000000000000000000bffffff",
+	Bech32: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllllls27850s",
+}
+
+func createDelegationContractWrapper(t *testing.T, returnData map[string][][]byte) *mock.HTTPClientWrapperStub {
+	return &mock.HTTPClientWrapperStub{
+		PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+			request := &vmQueryRequest{}
+			err := json.Unmarshal(data, request)
+			assert.Nil(t, err)
+			assert.Equal(t, "vm-values/query", endpoint)
+			assert.Equal(t, testDelegationContract.Bech32, request.ScAddress)
+			assert.Empty(t, request.Args)
+
+			response := &vmQueryResponse{}
+			values, found := returnData[request.FuncName]
+			if found {
+				response.Data.Data.ReturnCode = vmQueryReturnCodeOk
+				response.Data.Data.ReturnData = values
+			} else {
+				response.Data.Data.ReturnCode = "function not found"
+				response.Data.Data.ReturnMessage = "invalid function"
+			}
+
+			responseBytes, err := json.Marshal(response)
+			assert.Nil(t, err)
+
+			return responseBytes, http.StatusOK, nil
+		},
+	}
+}
+
+func createTestContractConfig(serviceFee int64, maxDelegationCap *big.Int, withDelegationCap string) [][]byte {
+	return [][]byte{
+		[]byte("owner"),
+		big.NewInt(serviceFee).Bytes(),
+		maxDelegationCap.Bytes(),
+		big.NewInt(1).Bytes(),
+		[]byte("false"),
+		[]byte(withDelegationCap),
+		[]byte("true"),
+	}
+}
+
+func TestNewDelegationContractInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewDelegationContractInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewDelegationContractInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestDelegationContractInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *delegationContractInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &delegationContractInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestDelegationContractInteractor_GetState(t *testing.T) {
+	t.Parallel()
+
+	maxDelegationCap, _ := big.NewInt(0).SetString("10000000000000000000000", 10)
+	totalActiveStake, _ := big.NewInt(0).SetString("7500000000000000000000", 10)
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		wrapper := &mock.HTTPClientWrapperStub{
+			PostHTTPHandler: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, state)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("failed query should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := createDelegationContractWrapper(t, map[string][][]byte{
+			getContractConfigFuncName: createTestContractConfig(1000, maxDelegationCap, "true"),
+		})
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, state)
+		assert.ErrorIs(t, err, errInvalidVMQueryResponse)
+		assert.Contains(t, err.Error(), "for getTotalActiveStake")
+		assert.Contains(t, err.Error(), "message: invalid function")
+	})
+	t.Run("incomplete contract config should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := createDelegationContractWrapper(t, map[string][][]byte{
+			getContractConfigFuncName: {[]byte("owner")},
+		})
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, state)
+		assert.ErrorIs(t, err, errInvalidVMQueryResponse)
+		assert.Contains(t, err.Error(), "1 values returned")
+	})
+	t.Run("missing value should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := createDelegationContractWrapper(t, map[string][][]byte{
+			getContractConfigFuncName:   createTestContractConfig(1000, maxDelegationCap, "true"),
+			getTotalActiveStakeFuncName: {},
+		})
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, state)
+		assert.ErrorIs(t, err, errInvalidVMQueryResponse)
+		assert.Contains(t, err.Error(), "no value returned")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := createDelegationContractWrapper(t, map[string][][]byte{
+			getContractConfigFuncName:   createTestContractConfig(1250, maxDelegationCap, "true"),
+			getTotalActiveStakeFuncName: {totalActiveStake.Bytes()},
+			getNumNodesFuncName:         {big.NewInt(3).Bytes()},
+		})
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, err)
+		expectedState := &core.DelegationContractState{
+			ServiceFee:        1250,
+			MaxDelegationCap:  maxDelegationCap,
+			WithDelegationCap: true,
+			TotalActiveStake:  totalActiveStake,
+			NumNodes:          3,
+		}
+		assert.Equal(t, expectedState, state)
+	})
+	t.Run("empty values should be decoded as 0", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := createDelegationContractWrapper(t, map[string][][]byte{
+			getContractConfigFuncName:   createTestContractConfig(0, big.NewInt(0), "false"),
+			getTotalActiveStakeFuncName: {{}},
+			getNumNodesFuncName:         {{}},
+		})
+		instance, _ := NewDelegationContractInteractor(wrapper)
+		state, err := instance.GetState(context.Background(), testDelegationContract)
+		assert.Nil(t, err)
+		assert.Zero(t, state.ServiceFee)
+		assert.False(t, state.WithDelegationCap)
+		assert.Zero(t, state.TotalActiveStake.Sign())
+		assert.Zero(t, state.MaxDelegationCap.Sign())
+		assert.Zero(t, state.NumNodes)
+	})
+}
@@ -3,8 +3,21 @@ package interactors
 import "errors"
 
 var (
-	errNilHTTPClientWrapper = errors.New("nil HTTP client wrapper")
-	errReturnCodeIsNotOk    = errors.New("HTTP return code is not OK")
-	errInvalidResponse      = errors.New("invalid response, nil statistics map")
-	errContextClosing       = errors.New("context closing")
+	errNilHTTPClientWrapper          = errors.New("nil HTTP client wrapper")
+	errReturnCodeIsNotOk             = errors.New("HTTP return code is not OK")
+	errInvalidResponse               = errors.New("invalid response, nil statistics map")
+	errContextClosing                = errors.New("context closing")
+	errNilIdentitiesHolder           = errors.New("nil identities holder")
+	errNilIdentitiesParser           = errors.New("nil identities parser")
+	errEmptyCacheFile                = errors.New("empty cache file name")
+	errInvalidDownloadedList         = errors.New("invalid downloaded list")
+	errInvalidStatisticsCacheTTL     = errors.New("invalid statistics cache TTL")
+	errNilTimeFunc                   = errors.New("nil time function")
+	errNilValidatorStatisticsQuerier = errors.New("nil validator statistics querier")
+	errNilRateLimiter                = errors.New("nil rate limiter")
+	errInvalidMaxConcurrentRequests  = errors.New("invalid maximum number of concurrent requests")
+	errInvalidRefreshInterval        = errors.New("invalid refresh interval")
+	errInvalidStatusResponse         = errors.New("invalid status response")
+	errInvalidVMQueryResponse        = errors.New("invalid VM query response")
+	errInvalidBalanceResponse        = errors.New("invalid balance response")
 )
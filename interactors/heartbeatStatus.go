@@ -0,0 +1,61 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const heartbeatStatusURL = "node/heartbeatstatus"
+
+type heartbeatStatusInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewHeartbeatStatusInteractor can interact with the node/heartbeatstatus API endpoint route of a proxy
+func NewHeartbeatStatusInteractor(httpClientWrapper HTTPClientWrapper) (*heartbeatStatusInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &heartbeatStatusInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// Query will query the node/heartbeatstatus API endpoint route and return the heartbeats mapped by the BLS keys
+func (interactor *heartbeatStatusInteractor) Query(ctx context.Context) (map[string]*core.HeartbeatStatus, error) {
+	log.Debug("heartbeatStatusInteractor.Query")
+	response := &core.HeartbeatStatusResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, heartbeatStatusURL, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Heartbeats == nil {
+		return nil, fmt.Errorf("%w, nil heartbeats", errInvalidStatusResponse)
+	}
+
+	heartbeats := make(map[string]*core.HeartbeatStatus, len(response.Data.Heartbeats))
+	for _, heartbeat := range response.Data.Heartbeats {
+		if heartbeat == nil {
+			continue
+		}
+
+		// the same key can be reported more than once, by different node instances, one active instance is enough
+		existing, found := heartbeats[heartbeat.PublicKey]
+		if found && existing.IsActive {
+			continue
+		}
+
+		heartbeats[heartbeat.PublicKey] = heartbeat
+	}
+
+	return heartbeats, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *heartbeatStatusInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
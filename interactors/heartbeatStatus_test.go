@@ -0,0 +1,117 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHeartbeatStatusInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewHeartbeatStatusInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewHeartbeatStatusInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestHeartbeatStatusInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *heartbeatStatusInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &heartbeatStatusInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestHeartbeatStatusInteractor_Query(t *testing.T) {
+	t.Parallel()
+
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewHeartbeatStatusInteractor(wrapper)
+		heartbeats, err := instance.Query(context.Background())
+		assert.Nil(t, heartbeats)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("http status code not OK should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusNotFound, nil
+			},
+		}
+		instance, _ := NewHeartbeatStatusInteractor(wrapper)
+		heartbeats, err := instance.Query(context.Background())
+		assert.Nil(t, heartbeats)
+		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+	})
+	t.Run("missing heartbeats should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{}}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewHeartbeatStatusInteractor(wrapper)
+		heartbeats, err := instance.Query(context.Background())
+		assert.Nil(t, heartbeats)
+		assert.ErrorIs(t, err, errInvalidStatusResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, heartbeatStatusURL, endpoint)
+				return []byte(`{"data":{"heartbeats":[` +
+					`{"publicKey":"key1","isActive":true,"peerType":"eligible","versionNumber":"v1.7.13","nodeDisplayName":"node-1"},` +
+					`{"publicKey":"key2","isActive":false,"peerType":"waiting","versionNumber":"v1.7.12","nodeDisplayName":"node-2"},` +
+					`{"publicKey":"key2","isActive":true,"peerType":"waiting","versionNumber":"v1.7.13","nodeDisplayName":"node-2b"},` +
+					`{"publicKey":"key2","isActive":false,"peerType":"waiting","versionNumber":"v1.7.11","nodeDisplayName":"node-2c"}` +
+					`]},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewHeartbeatStatusInteractor(wrapper)
+		heartbeats, err := instance.Query(context.Background())
+		assert.Nil(t, err)
+		expectedHeartbeats := map[string]*core.HeartbeatStatus{
+			"key1": {
+				PublicKey:       "key1",
+				IsActive:        true,
+				PeerType:        "eligible",
+				VersionNumber:   "v1.7.13",
+				NodeDisplayName: "node-1",
+			},
+			"key2": {
+				PublicKey:       "key2",
+				IsActive:        true,
+				PeerType:        "waiting",
+				VersionNumber:   "v1.7.13",
+				NodeDisplayName: "node-2b",
+			},
+		}
+		assert.Equal(t, expectedHeartbeats, heartbeats)
+	})
+}
@@ -1,6 +1,12 @@
 package interactors
 
-import "context"
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
 
 // HTTPClientWrapper defines what an HTTP client wrapper should implement
 type HTTPClientWrapper interface {
@@ -8,3 +14,27 @@ type HTTPClientWrapper interface {
 	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
 	IsInterfaceNil() bool
 }
+
+// HTTPGetterWithHeaders defines the behavior of a component able to do GET requests with custom headers
+type HTTPGetterWithHeaders interface {
+	GetHTTPWithHeaders(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error)
+	IsInterfaceNil() bool
+}
+
+// IdentitiesParser defines the behavior of a component able to parse a file containing the monitored identities
+type IdentitiesParser interface {
+	ParseFile(filename string) (*core.IdentitiesHolder, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorStatisticsQuerier defines the operations of a component able to query the validators statistics
+type ValidatorStatisticsQuerier interface {
+	Query(ctx context.Context) (map[string]*core.ValidatorStatistics, error)
+	IsInterfaceNil() bool
+}
+
+// RateLimiter defines the behavior of a component able to limit the number of requests
+type RateLimiter interface {
+	Reserve() time.Duration
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,179 @@
+package interactors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const (
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+	authorizationHeader   = "Authorization"
+	bearerPrefix          = "Bearer "
+	cacheMetadataSuffix   = ".meta"
+	cacheTempSuffix       = ".tmp"
+	cacheFilesPermissions = 0644
+)
+
+type listCacheMetadata struct {
+	ETag         string `json:"etag"`
+	LastModified string `json:"lastModified"`
+}
+
+type listURLFetcher struct {
+	httpClient  HTTPGetterWithHeaders
+	parser      IdentitiesParser
+	cacheFile   string
+	bearerToken string
+	name        string
+	metadata    listCacheMetadata
+}
+
+// ArgsListURLFetcher defines the DTO struct for the NewListURLFetcher constructor function
+type ArgsListURLFetcher struct {
+	HTTPClient  HTTPGetterWithHeaders
+	Parser      IdentitiesParser
+	CacheFile   string
+	BearerToken string
+	Name        string
+}
+
+// NewListURLFetcher creates a new instance of type listURLFetcher. The fetcher downloads the identities list and,
+// if valid, stores it in the cache file. The cache file is then used as the monitor's list file
+func NewListURLFetcher(args ArgsListURLFetcher) (*listURLFetcher, error) {
+	if check.IfNil(args.HTTPClient) {
+		return nil, errNilHTTPClientWrapper
+	}
+	if check.IfNil(args.Parser) {
+		return nil, errNilIdentitiesParser
+	}
+	if len(args.CacheFile) == 0 {
+		return nil, errEmptyCacheFile
+	}
+
+	fetcher := &listURLFetcher{
+		httpClient:  args.HTTPClient,
+		parser:      args.Parser,
+		cacheFile:   args.CacheFile,
+		bearerToken: args.BearerToken,
+		name:        args.Name,
+	}
+	fetcher.loadMetadata()
+
+	return fetcher, nil
+}
+
+// loadMetadata loads the ETag and Last-Modified values of the cached list, if the cached list exists
+func (fetcher *listURLFetcher) loadMetadata() {
+	if !fetcher.HasCache() {
+		return
+	}
+
+	data, err := os.ReadFile(fetcher.cacheFile + cacheMetadataSuffix)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &fetcher.metadata)
+	if err != nil {
+		log.Debug("listURLFetcher.loadMetadata", "monitor", fetcher.name, "error", err)
+		fetcher.metadata = listCacheMetadata{}
+	}
+}
+
+// Execute downloads the list, if changed since the last download, and stores it in the cache file
+func (fetcher *listURLFetcher) Execute(ctx context.Context) error {
+	headers := http.Header{}
+	if len(fetcher.metadata.ETag) > 0 {
+		headers.Set(ifNoneMatchHeader, fetcher.metadata.ETag)
+	}
+	if len(fetcher.metadata.LastModified) > 0 {
+		headers.Set(ifModifiedSinceHeader, fetcher.metadata.LastModified)
+	}
+	if len(fetcher.bearerToken) > 0 {
+		headers.Set(authorizationHeader, bearerPrefix+fetcher.bearerToken)
+	}
+
+	response, err := fetcher.httpClient.GetHTTPWithHeaders(ctx, "", headers)
+	if err != nil {
+		log.Warn("could not fetch the list, the cached list is used", "monitor", fetcher.name, "error", err)
+		return err
+	}
+	if response.StatusCode == http.StatusNotModified {
+		log.Debug("listURLFetcher.Execute: list not modified", "monitor", fetcher.name)
+		return nil
+	}
+	if !core.IsHttpStatusCodeSuccess(response.StatusCode) {
+		log.Warn("could not fetch the list, the cached list is used", "monitor", fetcher.name,
+			"status code", response.StatusCode)
+		return fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, response.StatusCode)
+	}
+
+	err = fetcher.storeList(response.Body)
+	if err != nil {
+		log.Error("the downloaded list was rejected, the cached list is used", "monitor", fetcher.name, "error", err)
+		return err
+	}
+
+	fetcher.metadata = listCacheMetadata{
+		ETag:         response.Header.Get(etagHeader),
+		LastModified: response.Header.Get(lastModifiedHeader),
+	}
+	fetcher.saveMetadata()
+	log.Debug("listURLFetcher.Execute: list downloaded", "monitor", fetcher.name, "num bytes", len(response.Body))
+
+	return nil
+}
+
+// storeList validates the downloaded list and atomically replaces the cache file
+func (fetcher *listURLFetcher) storeList(data []byte) error {
+	err := os.MkdirAll(filepath.Dir(fetcher.cacheFile), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tempFile := fetcher.cacheFile + cacheTempSuffix
+	err = os.WriteFile(tempFile, data, cacheFilesPermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = fetcher.parser.ParseFile(tempFile)
+	if err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("%w: %s", errInvalidDownloadedList, err.Error())
+	}
+
+	return os.Rename(tempFile, fetcher.cacheFile)
+}
+
+func (fetcher *listURLFetcher) saveMetadata() {
+	data, err := json.Marshal(fetcher.metadata)
+	if err == nil {
+		err = os.WriteFile(fetcher.cacheFile+cacheMetadataSuffix, data, cacheFilesPermissions)
+	}
+	if err != nil {
+		log.Debug("listURLFetcher.saveMetadata", "monitor", fetcher.name, "error", err)
+	}
+}
+
+// HasCache returns true if a list was previously downloaded and stored in the cache file
+func (fetcher *listURLFetcher) HasCache() bool {
+	_, err := os.Stat(fetcher.cacheFile)
+
+	return err == nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fetcher *listURLFetcher) IsInterfaceNil() bool {
+	return fetcher == nil
+}
@@ -0,0 +1,228 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestArgsListURLFetcher(t *testing.T) ArgsListURLFetcher {
+	return ArgsListURLFetcher{
+		HTTPClient: &mock.HTTPClientWrapperStub{},
+		Parser:     &mock.IdentitiesParserStub{},
+		CacheFile:  filepath.Join(t.TempDir(), "cache", "keys.list"),
+		Name:       "test",
+	}
+}
+
+func createOkResponse(body string, etag string, lastModified string) *core.HTTPResponse {
+	header := http.Header{}
+	if len(etag) > 0 {
+		header.Set(etagHeader, etag)
+	}
+	if len(lastModified) > 0 {
+		header.Set(lastModifiedHeader, lastModified)
+	}
+
+	return &core.HTTPResponse{
+		Body:       []byte(body),
+		StatusCode: http.StatusOK,
+		Header:     header,
+	}
+}
+
+func TestNewListURLFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.HTTPClient = nil
+		instance, err := NewListURLFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("nil parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.Parser = nil
+		instance, err := NewListURLFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilIdentitiesParser, err)
+	})
+	t.Run("empty cache file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.CacheFile = ""
+		instance, err := NewListURLFetcher(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errEmptyCacheFile, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewListURLFetcher(createTestArgsListURLFetcher(t))
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+		assert.False(t, instance.HasCache())
+	})
+}
+
+func TestListURLFetcher_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *listURLFetcher
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &listURLFetcher{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestListURLFetcher_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HTTP client errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createTestArgsListURLFetcher(t)
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		fetcher, _ := NewListURLFetcher(args)
+
+		err := fetcher.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, fetcher.HasCache())
+	})
+	t.Run("not ok status code should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				return &core.HTTPResponse{StatusCode: http.StatusUnauthorized}, nil
+			},
+		}
+		fetcher, _ := NewListURLFetcher(args)
+
+		err := fetcher.Execute(context.Background())
+		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+		assert.Contains(t, err.Error(), "401")
+		assert.False(t, fetcher.HasCache())
+	})
+	t.Run("invalid list should keep the cached list", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createTestArgsListURLFetcher(t)
+		body := "valid list"
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				return createOkResponse(body, "", ""), nil
+			},
+		}
+		args.Parser = &mock.IdentitiesParserStub{
+			ParseFileHandler: func(filename string) (*core.IdentitiesHolder, error) {
+				data, _ := os.ReadFile(filename)
+				if string(data) != "valid list" {
+					return nil, expectedErr
+				}
+
+				return &core.IdentitiesHolder{}, nil
+			},
+		}
+		fetcher, _ := NewListURLFetcher(args)
+
+		err := fetcher.Execute(context.Background())
+		assert.Nil(t, err)
+
+		body = "invalid list"
+		err = fetcher.Execute(context.Background())
+		assert.ErrorIs(t, err, errInvalidDownloadedList)
+		assert.Contains(t, err.Error(), expectedErr.Error())
+
+		data, _ := os.ReadFile(args.CacheFile)
+		assert.Equal(t, "valid list", string(data))
+		_, err = os.Stat(args.CacheFile + cacheTempSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should store the list and send the conditional headers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.BearerToken = "token"
+		numCalls := 0
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				numCalls++
+				assert.Empty(t, endpoint)
+				assert.Equal(t, "Bearer token", headers.Get(authorizationHeader))
+				if numCalls == 1 {
+					assert.Empty(t, headers.Get(ifNoneMatchHeader))
+					assert.Empty(t, headers.Get(ifModifiedSinceHeader))
+
+					return createOkResponse("list content", `"v1"`, "Mon, 19 Oct 2026 10:00:00 GMT"), nil
+				}
+
+				assert.Equal(t, `"v1"`, headers.Get(ifNoneMatchHeader))
+				assert.Equal(t, "Mon, 19 Oct 2026 10:00:00 GMT", headers.Get(ifModifiedSinceHeader))
+
+				return &core.HTTPResponse{StatusCode: http.StatusNotModified}, nil
+			},
+		}
+		fetcher, _ := NewListURLFetcher(args)
+
+		err := fetcher.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, fetcher.HasCache())
+		data, _ := os.ReadFile(args.CacheFile)
+		assert.Equal(t, "list content", string(data))
+
+		err = fetcher.Execute(context.Background())
+		assert.Nil(t, err)
+		data, _ = os.ReadFile(args.CacheFile)
+		assert.Equal(t, "list content", string(data))
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should use the cache metadata after restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsListURLFetcher(t)
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				assert.Empty(t, headers.Get(authorizationHeader))
+
+				return createOkResponse("list content", `"v1"`, ""), nil
+			},
+		}
+		fetcher, _ := NewListURLFetcher(args)
+		err := fetcher.Execute(context.Background())
+		assert.Nil(t, err)
+
+		args.HTTPClient = &mock.HTTPClientWrapperStub{
+			GetHTTPWithHeadersHandler: func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+				assert.Equal(t, `"v1"`, headers.Get(ifNoneMatchHeader))
+				assert.Empty(t, headers.Get(ifModifiedSinceHeader))
+
+				return &core.HTTPResponse{StatusCode: http.StatusNotModified}, nil
+			},
+		}
+		restartedFetcher, _ := NewListURLFetcher(args)
+		assert.True(t, restartedFetcher.HasCache())
+		err = restartedFetcher.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,66 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const networkStatusURLFormat = "network/status/%d"
+
+type networkStatusInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewNetworkStatusInteractor can interact with the network/status/{shard} gateway endpoint route
+func NewNetworkStatusInteractor(httpClientWrapper HTTPClientWrapper) (*networkStatusInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &networkStatusInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// GetNetworkNonce returns the current nonce of the provided shard, as known by the gateway
+func (interactor *networkStatusInteractor) GetNetworkNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	log.Debug("networkStatusInteractor.GetNetworkNonce", "shard", shardID)
+	status, err := interactor.getNetworkStatus(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.Nonce, nil
+}
+
+// GetEpoch returns the current epoch, as known by the gateway's metachain observers
+func (interactor *networkStatusInteractor) GetEpoch(ctx context.Context) (uint32, error) {
+	log.Debug("networkStatusInteractor.GetEpoch")
+	status, err := interactor.getNetworkStatus(ctx, core.MetachainShardID)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.EpochNumber, nil
+}
+
+func (interactor *networkStatusInteractor) getNetworkStatus(ctx context.Context, shardID uint32) (*core.NetworkStatus, error) {
+	response := &core.NetworkStatusResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, fmt.Sprintf(networkStatusURLFormat, shardID), response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Status == nil {
+		return nil, fmt.Errorf("%w, nil network status for shard %d", errInvalidStatusResponse, shardID)
+	}
+
+	return response.Data.Status, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *networkStatusInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
@@ -0,0 +1,129 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNetworkStatusInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewNetworkStatusInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewNetworkStatusInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNetworkStatusInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *networkStatusInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &networkStatusInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestNetworkStatusInteractor_GetNetworkNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		nonce, err := instance.GetNetworkNonce(context.Background(), 0)
+		assert.Zero(t, nonce)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("missing status should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{}}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		nonce, err := instance.GetNetworkNonce(context.Background(), 2)
+		assert.Zero(t, nonce)
+		assert.ErrorIs(t, err, errInvalidStatusResponse)
+		assert.Contains(t, err.Error(), "shard 2")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "network/status/4294967295", endpoint)
+				return []byte(`{"data":{"status":{"erd_nonce":1234}},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		nonce, err := instance.GetNetworkNonce(context.Background(), 4294967295)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1234), nonce)
+	})
+}
+
+func TestNetworkStatusInteractor_GetEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		epoch, err := instance.GetEpoch(context.Background())
+		assert.Zero(t, epoch)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("missing status should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{}}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		epoch, err := instance.GetEpoch(context.Background())
+		assert.Zero(t, epoch)
+		assert.ErrorIs(t, err, errInvalidStatusResponse)
+	})
+	t.Run("should query the metachain status", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "network/status/4294967295", endpoint)
+				return []byte(`{"data":{"status":{"erd_nonce":1234,"erd_epoch_number":37}},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNetworkStatusInteractor(wrapper)
+		epoch, err := instance.GetEpoch(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(37), epoch)
+	})
+}
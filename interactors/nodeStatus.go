@@ -0,0 +1,60 @@
+package interactors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const nodeStatusURL = "node/status"
+
+type nodeStatusInteractor struct {
+	httpClientWrapper HTTPClientWrapper
+}
+
+// NewNodeStatusInteractor can interact with the node/status REST API endpoint route of a node
+func NewNodeStatusInteractor(httpClientWrapper HTTPClientWrapper) (*nodeStatusInteractor, error) {
+	if check.IfNil(httpClientWrapper) {
+		return nil, errNilHTTPClientWrapper
+	}
+
+	return &nodeStatusInteractor{
+		httpClientWrapper: httpClientWrapper,
+	}, nil
+}
+
+// GetNodeStatus will query the node/status REST API endpoint route of the node
+func (interactor *nodeStatusInteractor) GetNodeStatus(ctx context.Context) (*core.NodeStatus, error) {
+	log.Debug("nodeStatusInteractor.GetNodeStatus")
+	response := &core.NodeStatusResponse{}
+	err := getJSON(ctx, interactor.httpClientWrapper, nodeStatusURL, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Metrics == nil {
+		return nil, fmt.Errorf("%w, nil node metrics", errInvalidStatusResponse)
+	}
+
+	return response.Data.Metrics, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interactor *nodeStatusInteractor) IsInterfaceNil() bool {
+	return interactor == nil
+}
+
+// getJSON does a GET request on the provided endpoint and unmarshalls the JSON response
+func getJSON(ctx context.Context, httpClientWrapper HTTPClientWrapper, endpoint string, response interface{}) error {
+	bytes, statusCode, err := httpClientWrapper.GetHTTP(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	if !core.IsHttpStatusCodeSuccess(statusCode) {
+		return fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
+	}
+
+	return json.Unmarshal(bytes, response)
+}
@@ -0,0 +1,121 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNodeStatusInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client wrapper should error", func(t *testing.T) {
+		instance, err := NewNodeStatusInteractor(nil)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilHTTPClientWrapper, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		instance, err := NewNodeStatusInteractor(&mock.HTTPClientWrapperStub{})
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodeStatusInteractor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *nodeStatusInteractor
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &nodeStatusInteractor{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestNodeStatusInteractor_GetNodeStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("http client wrapper errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		instance, _ := NewNodeStatusInteractor(wrapper)
+		status, err := instance.GetNodeStatus(context.Background())
+		assert.Nil(t, status)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("not-ok status code should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return nil, http.StatusInternalServerError, nil
+			},
+		}
+		instance, _ := NewNodeStatusInteractor(wrapper)
+		status, err := instance.GetNodeStatus(context.Background())
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+		assert.Contains(t, err.Error(), "500")
+	})
+	t.Run("non-json response should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte("not-a-json"), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNodeStatusInteractor(wrapper)
+		status, err := instance.GetNodeStatus(context.Background())
+		assert.Nil(t, status)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing metrics should return error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return []byte(`{"data":{},"code":"successful"}`), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNodeStatusInteractor(wrapper)
+		status, err := instance.GetNodeStatus(context.Background())
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, errInvalidStatusResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &mock.HTTPClientWrapperStub{
+			GetHTTPHandler: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "node/status", endpoint)
+				response := `{"data":{"metrics":{"erd_nonce":100,"erd_probable_highest_nonce":102,"erd_is_syncing":1,
+"erd_num_connected_peers":35,"erd_app_version":"v1.6.0","erd_shard_id":4294967295}},"error":"","code":"successful"}`
+
+				return []byte(response), http.StatusOK, nil
+			},
+		}
+		instance, _ := NewNodeStatusInteractor(wrapper)
+		status, err := instance.GetNodeStatus(context.Background())
+		assert.Nil(t, err)
+		expectedStatus := &core.NodeStatus{
+			Nonce:                100,
+			ProbableHighestNonce: 102,
+			IsSyncing:            1,
+			NumConnectedPeers:    35,
+			AppVersion:           "v1.6.0",
+			ShardID:              4294967295,
+		}
+		assert.Equal(t, expectedStatus, status)
+	})
+}
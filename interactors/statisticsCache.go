@@ -0,0 +1,170 @@
+package interactors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// ArgsStatisticsCache is the DTO used to create a new instance of type statisticsCache
+type ArgsStatisticsCache struct {
+	TTL      time.Duration
+	TimeFunc func() time.Time
+}
+
+type statisticsCache struct {
+	ttl      time.Duration
+	timeFunc func() time.Time
+	ctx      context.Context
+	cancel   func()
+
+	mut     sync.Mutex
+	entries map[string]*statisticsCacheEntry
+}
+
+type statisticsCacheEntry struct {
+	querier           ValidatorStatisticsQuerier
+	endpointsProvider core.EndpointsProvider
+
+	mut        sync.Mutex
+	statistics map[string]*core.ValidatorStatistics
+	fetchedAt  time.Time
+	request    *statisticsRequest
+}
+
+type statisticsRequest struct {
+	done       chan struct{}
+	statistics map[string]*core.ValidatorStatistics
+	err        error
+}
+
+// NewStatisticsCache creates a cache that shares the validator statistics between all the monitors querying the same
+// API. The statistics are kept for the provided TTL and the concurrent queries are coalesced in one request. A TTL of
+// 0 only coalesces the concurrent queries. The requests in progress are canceled when the cache is closed
+func NewStatisticsCache(args ArgsStatisticsCache) (*statisticsCache, error) {
+	if args.TTL < 0 {
+		return nil, errInvalidStatisticsCacheTTL
+	}
+	if args.TimeFunc == nil {
+		return nil, errNilTimeFunc
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &statisticsCache{
+		ttl:      args.TTL,
+		timeFunc: args.TimeFunc,
+		ctx:      ctx,
+		cancel:   cancel,
+		entries:  make(map[string]*statisticsCacheEntry),
+	}, nil
+}
+
+// Querier returns the querier that uses the cached statistics of the provided key, usually the API URL. The provided
+// querier and endpoints provider are used only by the first call for a key, the next calls will share them, so the
+// returned endpoints provider is the one of the API client actually queried. The returned statistics map is shared and
+// should not be modified
+func (cache *statisticsCache) Querier(
+	key string,
+	querier ValidatorStatisticsQuerier,
+	endpointsProvider core.EndpointsProvider,
+) (ValidatorStatisticsQuerier, core.EndpointsProvider, error) {
+	if check.IfNil(querier) {
+		return nil, nil, errNilValidatorStatisticsQuerier
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	entry, found := cache.entries[key]
+	if !found {
+		entry = &statisticsCacheEntry{
+			querier:           querier,
+			endpointsProvider: endpointsProvider,
+		}
+		cache.entries[key] = entry
+	}
+
+	cachedQuerier := &cachedStatisticsQuerier{
+		cache: cache,
+		entry: entry,
+	}
+
+	return cachedQuerier, entry.endpointsProvider, nil
+}
+
+func (cache *statisticsCache) query(ctx context.Context, entry *statisticsCacheEntry) (map[string]*core.ValidatorStatistics, error) {
+	entry.mut.Lock()
+	if entry.statistics != nil && cache.timeFunc().Sub(entry.fetchedAt) < cache.ttl {
+		statistics := entry.statistics
+		entry.mut.Unlock()
+
+		return statistics, nil
+	}
+
+	request := entry.request
+	if request == nil {
+		request = &statisticsRequest{
+			done: make(chan struct{}),
+		}
+		entry.request = request
+
+		go cache.fetch(entry, request)
+	}
+	entry.mut.Unlock()
+
+	select {
+	case <-request.done:
+		return request.statistics, request.err
+	case <-ctx.Done():
+		return nil, errContextClosing
+	}
+}
+
+// fetch does the request shared by all waiting queriers. The request is bound to the lifetime of the cache and not to
+// the context of one of the queriers, so a querier that gives up will not fail the others
+func (cache *statisticsCache) fetch(entry *statisticsCacheEntry, request *statisticsRequest) {
+	statistics, err := entry.querier.Query(cache.ctx)
+
+	entry.mut.Lock()
+	request.statistics = statistics
+	request.err = err
+	if err == nil {
+		entry.statistics = statistics
+		entry.fetchedAt = cache.timeFunc()
+	}
+	entry.request = nil
+	entry.mut.Unlock()
+
+	close(request.done)
+}
+
+// Close cancels the requests in progress
+func (cache *statisticsCache) Close() error {
+	cache.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *statisticsCache) IsInterfaceNil() bool {
+	return cache == nil
+}
+
+type cachedStatisticsQuerier struct {
+	cache *statisticsCache
+	entry *statisticsCacheEntry
+}
+
+// Query returns the cached validator statistics or waits for the in-flight request of the same key
+func (querier *cachedStatisticsQuerier) Query(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+	return querier.cache.query(ctx, querier.entry)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (querier *cachedStatisticsQuerier) IsInterfaceNil() bool {
+	return querier == nil
+}
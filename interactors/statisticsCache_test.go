@@ -0,0 +1,254 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/multiversx/mx-chain-keys-monitor-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsStatisticsCache(currentTime *time.Time, mut *sync.Mutex) ArgsStatisticsCache {
+	return ArgsStatisticsCache{
+		TTL: time.Minute,
+		TimeFunc: func() time.Time {
+			mut.Lock()
+			defer mut.Unlock()
+
+			return *currentTime
+		},
+	}
+}
+
+func TestNewStatisticsCache(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	mut := &sync.Mutex{}
+	t.Run("invalid TTL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsCache(&currentTime, mut)
+		args.TTL = -time.Second
+		cache, err := NewStatisticsCache(args)
+		assert.Nil(t, cache)
+		assert.Equal(t, errInvalidStatisticsCacheTTL, err)
+	})
+	t.Run("nil time function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsCache(&currentTime, mut)
+		args.TimeFunc = nil
+		cache, err := NewStatisticsCache(args)
+		assert.Nil(t, cache)
+		assert.Equal(t, errNilTimeFunc, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewStatisticsCache(createMockArgsStatisticsCache(&currentTime, mut))
+		assert.NotNil(t, cache)
+		assert.Nil(t, err)
+	})
+}
+
+func TestStatisticsCache_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *statisticsCache
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &statisticsCache{}
+	assert.False(t, instance.IsInterfaceNil())
+
+	var querier *cachedStatisticsQuerier
+	assert.True(t, querier.IsInterfaceNil())
+
+	querier = &cachedStatisticsQuerier{}
+	assert.False(t, querier.IsInterfaceNil())
+}
+
+func TestStatisticsCache_Querier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil querier should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{}))
+		querier, endpointsProvider, err := cache.Querier("url", nil, nil)
+		assert.Nil(t, querier)
+		assert.Nil(t, endpointsProvider)
+		assert.Equal(t, errNilValidatorStatisticsQuerier, err)
+	})
+	t.Run("same key should share the statistics during the TTL", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		mut := &sync.Mutex{}
+		numQueries1 := uint32(0)
+		statistics := map[string]*core.ValidatorStatistics{"bls1": {Rating: 100}}
+		querier1 := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				atomic.AddUint32(&numQueries1, 1)
+				return statistics, nil
+			},
+		}
+		numQueries2 := uint32(0)
+		querier2 := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				atomic.AddUint32(&numQueries2, 1)
+				return statistics, nil
+			},
+		}
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&currentTime, mut))
+		endpointsProvider1 := &mock.EndpointsProviderStub{}
+		cached1, sharedEndpointsProvider, _ := cache.Querier("url", querier1, endpointsProvider1)
+		assert.True(t, sharedEndpointsProvider == endpointsProvider1)
+		cached2, sharedEndpointsProvider, _ := cache.Querier("url", querier2, &mock.EndpointsProviderStub{})
+		// the endpoints provider of the first querier is shared, as its API client is the one queried
+		assert.True(t, sharedEndpointsProvider == endpointsProvider1)
+
+		result, err := cached1.Query(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, statistics, result)
+		result, err = cached2.Query(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, statistics, result)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numQueries1))
+		assert.Zero(t, atomic.LoadUint32(&numQueries2))
+
+		// the TTL expired
+		mut.Lock()
+		currentTime = currentTime.Add(time.Minute)
+		mut.Unlock()
+		_, _ = cached2.Query(context.Background())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numQueries1))
+		assert.Zero(t, atomic.LoadUint32(&numQueries2))
+	})
+	t.Run("different keys should not share the statistics", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		querier := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				atomic.AddUint32(&numQueries, 1)
+				return make(map[string]*core.ValidatorStatistics), nil
+			},
+		}
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{}))
+		cached1, _, _ := cache.Querier("url1", querier, nil)
+		cached2, _, _ := cache.Querier("url2", querier, nil)
+		_, _ = cached1.Query(context.Background())
+		_, _ = cached2.Query(context.Background())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numQueries))
+	})
+	t.Run("errors should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numQueries := uint32(0)
+		querier := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				atomic.AddUint32(&numQueries, 1)
+				return nil, expectedErr
+			},
+		}
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{}))
+		cached, _, _ := cache.Querier("url", querier, nil)
+		result, err := cached.Query(context.Background())
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+		_, err = cached.Query(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numQueries))
+	})
+	t.Run("concurrent queries should be coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		release := make(chan struct{})
+		querier := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				atomic.AddUint32(&numQueries, 1)
+				<-release
+				return make(map[string]*core.ValidatorStatistics), nil
+			},
+		}
+
+		args := createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{})
+		args.TTL = 0
+		cache, _ := NewStatisticsCache(args)
+
+		numQueriers := 10
+		wg := sync.WaitGroup{}
+		wg.Add(numQueriers)
+		for i := 0; i < numQueriers; i++ {
+			cached, _, _ := cache.Querier("url", querier, nil)
+			go func() {
+				defer wg.Done()
+
+				_, err := cached.Query(context.Background())
+				assert.Nil(t, err)
+			}()
+		}
+
+		time.Sleep(time.Millisecond * 100)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+	})
+	t.Run("closed context should not wait for the request", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+		defer close(release)
+		querier := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				<-release
+				return make(map[string]*core.ValidatorStatistics), nil
+			},
+		}
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{}))
+		cached, _, _ := cache.Querier("url", querier, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		result, err := cached.Query(ctx)
+		assert.Nil(t, result)
+		assert.Equal(t, errContextClosing, err)
+	})
+	t.Run("close should cancel the request in progress", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		started := make(chan struct{})
+		querier := &mock.ValidatorStatisticsQuerierStub{
+			QueryHandler: func(ctx context.Context) (map[string]*core.ValidatorStatistics, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, expectedErr
+			},
+		}
+
+		cache, _ := NewStatisticsCache(createMockArgsStatisticsCache(&time.Time{}, &sync.Mutex{}))
+		cached, _, _ := cache.Querier("url", querier, nil)
+
+		go func() {
+			<-started
+			_ = cache.Close()
+		}()
+
+		result, err := cached.Query(context.Background())
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// AuctionCheckerStub -
+type AuctionCheckerStub struct {
+	CheckHandler func(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error)
+}
+
+// Check -
+func (stub *AuctionCheckerStub) Check(ctx context.Context, extraBLSKeys []string) ([]core.CheckResponse, error) {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(ctx, extraBLSKeys)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *AuctionCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// AuctionListQuerierStub -
+type AuctionListQuerierStub struct {
+	QueryHandler func(ctx context.Context) ([]*core.AuctionListValidator, error)
+}
+
+// Query -
+func (stub *AuctionListQuerierStub) Query(ctx context.Context) ([]*core.AuctionListValidator, error) {
+	if stub.QueryHandler != nil {
+		return stub.QueryHandler(ctx)
+	}
+
+	return make([]*core.AuctionListValidator, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *AuctionListQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "math/big"
+
+// BalanceCheckerStub -
+type BalanceCheckerStub struct {
+	CheckHandler func(previous *big.Int, current *big.Int) ([]string, []string)
+}
+
+// Check -
+func (stub *BalanceCheckerStub) Check(previous *big.Int, current *big.Int) ([]string, []string) {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(previous, current)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *BalanceCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
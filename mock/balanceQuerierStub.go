@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"context"
+	"math/big"
+)
+
+// BalanceQuerierStub -
+type BalanceQuerierStub struct {
+	GetBalanceHandler func(ctx context.Context, address string, token string) (*big.Int, error)
+}
+
+// GetBalance -
+func (stub *BalanceQuerierStub) GetBalance(ctx context.Context, address string, token string) (*big.Int, error) {
+	if stub.GetBalanceHandler != nil {
+		return stub.GetBalanceHandler(ctx, address, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// IsInterfaceNil -
+func (stub *BalanceQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
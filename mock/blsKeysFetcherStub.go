@@ -1,19 +1,23 @@
 package mock
 
-import "context"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
 
 // BLSKEysFetcherStub -
 type BLSKEysFetcherStub struct {
-	GetAllBLSKeysHandler func(ctx context.Context, sender string) ([]string, error)
+	GetAllBLSKeysHandler func(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error)
 }
 
 // GetAllBLSKeys -
-func (stub *BLSKEysFetcherStub) GetAllBLSKeys(ctx context.Context, sender string) ([]string, error) {
+func (stub *BLSKEysFetcherStub) GetAllBLSKeys(ctx context.Context, sender string) ([]core.OwnerBLSKeys, error) {
 	if stub.GetAllBLSKeysHandler != nil {
 		return stub.GetAllBLSKeysHandler(ctx, sender)
 	}
 
-	return make([]string, 0), nil
+	return make([]core.OwnerBLSKeys, 0), nil
 }
 
 // IsInterfaceNil -
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// DelegationCheckerStub -
+type DelegationCheckerStub struct {
+	CheckHandler func(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string)
+}
+
+// Check -
+func (stub *DelegationCheckerStub) Check(previous *core.DelegationContractState, current *core.DelegationContractState) ([]string, []string) {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(previous, current)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *DelegationCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// DelegationStateQuerierStub -
+type DelegationStateQuerierStub struct {
+	GetStateHandler func(ctx context.Context, contract core.Address) (*core.DelegationContractState, error)
+}
+
+// GetState -
+func (stub *DelegationStateQuerierStub) GetState(ctx context.Context, contract core.Address) (*core.DelegationContractState, error) {
+	if stub.GetStateHandler != nil {
+		return stub.GetStateHandler(ctx, contract)
+	}
+
+	return &core.DelegationContractState{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *DelegationStateQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
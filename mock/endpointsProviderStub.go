@@ -0,0 +1,30 @@
+package mock
+
+// EndpointsProviderStub -
+type EndpointsProviderStub struct {
+	ActiveURLHandler  func() string
+	PrimaryURLHandler func() string
+}
+
+// ActiveURL -
+func (stub *EndpointsProviderStub) ActiveURL() string {
+	if stub.ActiveURLHandler != nil {
+		return stub.ActiveURLHandler()
+	}
+
+	return ""
+}
+
+// PrimaryURL -
+func (stub *EndpointsProviderStub) PrimaryURL() string {
+	if stub.PrimaryURLHandler != nil {
+		return stub.PrimaryURLHandler()
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (stub *EndpointsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "context"
+
+// EpochQuerierStub -
+type EpochQuerierStub struct {
+	GetEpochHandler func(ctx context.Context) (uint32, error)
+}
+
+// GetEpoch -
+func (stub *EpochQuerierStub) GetEpoch(ctx context.Context) (uint32, error) {
+	if stub.GetEpochHandler != nil {
+		return stub.GetEpochHandler(ctx)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *EpochQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
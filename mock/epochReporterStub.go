@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// EpochReporterStub -
+type EpochReporterStub struct {
+	ReportHandler func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+}
+
+// Report -
+func (stub *EpochReporterStub) Report(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+	if stub.ReportHandler != nil {
+		return stub.ReportHandler(statistics, extraBLSKeys)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EpochReporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package mock
+
+import "context"
+
+// EpochTrackerStub -
+type EpochTrackerStub struct {
+	UpdateHandler          func(ctx context.Context) (uint32, bool, error)
+	CurrentEpochHandler    func() (uint32, bool)
+	IsInGracePeriodHandler func() bool
+}
+
+// Update -
+func (stub *EpochTrackerStub) Update(ctx context.Context) (uint32, bool, error) {
+	if stub.UpdateHandler != nil {
+		return stub.UpdateHandler(ctx)
+	}
+
+	return 0, false, nil
+}
+
+// CurrentEpoch -
+func (stub *EpochTrackerStub) CurrentEpoch() (uint32, bool) {
+	if stub.CurrentEpochHandler != nil {
+		return stub.CurrentEpochHandler()
+	}
+
+	return 0, false
+}
+
+// IsInGracePeriod -
+func (stub *EpochTrackerStub) IsInGracePeriod() bool {
+	if stub.IsInGracePeriodHandler != nil {
+		return stub.IsInGracePeriodHandler()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *EpochTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
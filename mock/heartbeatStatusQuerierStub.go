@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// HeartbeatStatusQuerierStub -
+type HeartbeatStatusQuerierStub struct {
+	QueryHandler func(ctx context.Context) (map[string]*core.HeartbeatStatus, error)
+}
+
+// Query -
+func (stub *HeartbeatStatusQuerierStub) Query(ctx context.Context) (map[string]*core.HeartbeatStatus, error) {
+	if stub.QueryHandler != nil {
+		return stub.QueryHandler(ctx)
+	}
+
+	return make(map[string]*core.HeartbeatStatus), nil
+}
+
+// IsInterfaceNil -
+func (stub *HeartbeatStatusQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
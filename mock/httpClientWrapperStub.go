@@ -3,12 +3,16 @@ package mock
 import (
 	"context"
 	"errors"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 )
 
 // HTTPClientWrapperStub -
 type HTTPClientWrapperStub struct {
-	GetHTTPHandler  func(ctx context.Context, endpoint string) ([]byte, int, error)
-	PostHTTPHandler func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	GetHTTPHandler            func(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTPHandler           func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	GetHTTPWithHeadersHandler func(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error)
 }
 
 // GetHTTP -
@@ -29,6 +33,15 @@ func (stub *HTTPClientWrapperStub) PostHTTP(ctx context.Context, endpoint string
 	return nil, 0, errors.New("not implemented")
 }
 
+// GetHTTPWithHeaders -
+func (stub *HTTPClientWrapperStub) GetHTTPWithHeaders(ctx context.Context, endpoint string, headers http.Header) (*core.HTTPResponse, error) {
+	if stub.GetHTTPWithHeadersHandler != nil {
+		return stub.GetHTTPWithHeadersHandler(ctx, endpoint, headers)
+	}
+
+	return nil, errors.New("not implemented")
+}
+
 // IsInterfaceNil -
 func (stub *HTTPClientWrapperStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// IdentitiesHandlerStub -
+type IdentitiesHandlerStub struct {
+	SetIdentitiesHandler func(identities *core.IdentitiesHolder) error
+}
+
+// SetIdentities -
+func (stub *IdentitiesHandlerStub) SetIdentities(identities *core.IdentitiesHolder) error {
+	if stub.SetIdentitiesHandler != nil {
+		return stub.SetIdentitiesHandler(identities)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *IdentitiesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
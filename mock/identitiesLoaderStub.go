@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// IdentitiesLoaderStub -
+type IdentitiesLoaderStub struct {
+	LoadIdentitiesHandler func() (*core.IdentitiesHolder, error)
+}
+
+// LoadIdentities -
+func (stub *IdentitiesLoaderStub) LoadIdentities() (*core.IdentitiesHolder, error) {
+	if stub.LoadIdentitiesHandler != nil {
+		return stub.LoadIdentitiesHandler()
+	}
+
+	return &core.IdentitiesHolder{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *IdentitiesLoaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
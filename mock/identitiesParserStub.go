@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// IdentitiesParserStub -
+type IdentitiesParserStub struct {
+	ParseFileHandler func(filename string) (*core.IdentitiesHolder, error)
+}
+
+// ParseFile -
+func (stub *IdentitiesParserStub) ParseFile(filename string) (*core.IdentitiesHolder, error) {
+	if stub.ParseFileHandler != nil {
+		return stub.ParseFileHandler(filename)
+	}
+
+	return &core.IdentitiesHolder{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *IdentitiesParserStub) IsInterfaceNil() bool {
+	return stub == nil
+}
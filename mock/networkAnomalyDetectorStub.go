@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// NetworkAnomalyDetectorStub -
+type NetworkAnomalyDetectorStub struct {
+	DetectHandler func(statistics map[string]*core.ValidatorStatistics) (float64, bool)
+}
+
+// Detect -
+func (stub *NetworkAnomalyDetectorStub) Detect(statistics map[string]*core.ValidatorStatistics) (float64, bool) {
+	if stub.DetectHandler != nil {
+		return stub.DetectHandler(statistics)
+	}
+
+	return 0, false
+}
+
+// IsInterfaceNil -
+func (stub *NetworkAnomalyDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "context"
+
+// NetworkNonceQuerierStub -
+type NetworkNonceQuerierStub struct {
+	GetNetworkNonceHandler func(ctx context.Context, shardID uint32) (uint64, error)
+}
+
+// GetNetworkNonce -
+func (stub *NetworkNonceQuerierStub) GetNetworkNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	if stub.GetNetworkNonceHandler != nil {
+		return stub.GetNetworkNonceHandler(ctx, shardID)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *NetworkNonceQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// NodeStatusCheckerStub -
+type NodeStatusCheckerStub struct {
+	CheckHandler func(status *core.NodeStatus, networkNonce uint64) []string
+}
+
+// Check -
+func (stub *NodeStatusCheckerStub) Check(status *core.NodeStatus, networkNonce uint64) []string {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(status, networkNonce)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *NodeStatusCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// NodeStatusQuerierStub -
+type NodeStatusQuerierStub struct {
+	GetNodeStatusHandler func(ctx context.Context) (*core.NodeStatus, error)
+}
+
+// GetNodeStatus -
+func (stub *NodeStatusQuerierStub) GetNodeStatus(ctx context.Context) (*core.NodeStatus, error) {
+	if stub.GetNodeStatusHandler != nil {
+		return stub.GetNodeStatusHandler(ctx)
+	}
+
+	return &core.NodeStatus{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *NodeStatusQuerierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,62 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// NotificationsOutboxStub -
+type NotificationsOutboxStub struct {
+	AddHandler           func(notifierID string, caller string, messages []core.OutputMessage) error
+	GetDueEntriesHandler func(notifierID string) []core.OutboxEntry
+	MarkDeliveredHandler func(notifierID string, entryID uint64) error
+	MarkFailedHandler    func(notifierID string, entryID uint64) error
+	NumEntriesHandler    func(notifierID string) int
+}
+
+// Add -
+func (stub *NotificationsOutboxStub) Add(notifierID string, caller string, messages []core.OutputMessage) error {
+	if stub.AddHandler != nil {
+		return stub.AddHandler(notifierID, caller, messages)
+	}
+
+	return nil
+}
+
+// GetDueEntries -
+func (stub *NotificationsOutboxStub) GetDueEntries(notifierID string) []core.OutboxEntry {
+	if stub.GetDueEntriesHandler != nil {
+		return stub.GetDueEntriesHandler(notifierID)
+	}
+
+	return nil
+}
+
+// MarkDelivered -
+func (stub *NotificationsOutboxStub) MarkDelivered(notifierID string, entryID uint64) error {
+	if stub.MarkDeliveredHandler != nil {
+		return stub.MarkDeliveredHandler(notifierID, entryID)
+	}
+
+	return nil
+}
+
+// MarkFailed -
+func (stub *NotificationsOutboxStub) MarkFailed(notifierID string, entryID uint64) error {
+	if stub.MarkFailedHandler != nil {
+		return stub.MarkFailedHandler(notifierID, entryID)
+	}
+
+	return nil
+}
+
+// NumEntries -
+func (stub *NotificationsOutboxStub) NumEntries(notifierID string) int {
+	if stub.NumEntriesHandler != nil {
+		return stub.NumEntriesHandler(notifierID)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *NotificationsOutboxStub) IsInterfaceNil() bool {
+	return stub == nil
+}
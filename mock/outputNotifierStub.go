@@ -6,8 +6,9 @@ import (
 
 // OutputNotifierStub -
 type OutputNotifierStub struct {
-	NameHandler           func() string
-	OutputMessagesHandler func(messages ...core.OutputMessage) error
+	NameHandler             func() string
+	OutputMessagesHandler   func(messages ...core.OutputMessage) error
+	FitsInOnePayloadHandler func(messages ...core.OutputMessage) bool
 }
 
 // OutputMessages -
@@ -19,6 +20,15 @@ func (stub *OutputNotifierStub) OutputMessages(messages ...core.OutputMessage) e
 	return nil
 }
 
+// FitsInOnePayload -
+func (stub *OutputNotifierStub) FitsInOnePayload(messages ...core.OutputMessage) bool {
+	if stub.FitsInOnePayloadHandler != nil {
+		return stub.FitsInOnePayloadHandler(messages...)
+	}
+
+	return true
+}
+
 // Name -
 func (stub *OutputNotifierStub) Name() string {
 	if stub.NameHandler != nil {
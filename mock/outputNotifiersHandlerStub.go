@@ -6,7 +6,9 @@ import (
 
 // OutputNotifiersHandlerStub -
 type OutputNotifiersHandlerStub struct {
-	NotifyWithRetryHandler func(caller string, messages ...core.OutputMessage) error
+	NotifyWithRetryHandler     func(caller string, messages ...core.OutputMessage) error
+	NotifyWithoutOutboxHandler func(caller string, messages ...core.OutputMessage) error
+	GetMetricsHandler          func() []core.NotifierMetrics
 }
 
 // NotifyWithRetry -
@@ -18,6 +20,24 @@ func (stub *OutputNotifiersHandlerStub) NotifyWithRetry(caller string, messages
 	return nil
 }
 
+// NotifyWithoutOutbox -
+func (stub *OutputNotifiersHandlerStub) NotifyWithoutOutbox(caller string, messages ...core.OutputMessage) error {
+	if stub.NotifyWithoutOutboxHandler != nil {
+		return stub.NotifyWithoutOutboxHandler(caller, messages...)
+	}
+
+	return nil
+}
+
+// GetMetrics -
+func (stub *OutputNotifiersHandlerStub) GetMetrics() []core.NotifierMetrics {
+	if stub.GetMetricsHandler != nil {
+		return stub.GetMetricsHandler()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (stub *OutputNotifiersHandlerStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// PeersRatingCheckerStub -
+type PeersRatingCheckerStub struct {
+	CheckHandler func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse
+}
+
+// Check -
+func (stub *PeersRatingCheckerStub) Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) []core.CheckResponse {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(statistics, extraBLSKeys)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeersRatingCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
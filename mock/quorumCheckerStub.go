@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// QuorumCheckerStub -
+type QuorumCheckerStub struct {
+	CheckHandler func(ctx context.Context, problematicKeys []core.CheckResponse, extraBLSKeys []string) ([]core.CheckResponse, []core.SourcesDisagreement)
+}
+
+// Check -
+func (stub *QuorumCheckerStub) Check(ctx context.Context, problematicKeys []core.CheckResponse, extraBLSKeys []string) ([]core.CheckResponse, []core.SourcesDisagreement) {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(ctx, problematicKeys, extraBLSKeys)
+	}
+
+	return problematicKeys, nil
+}
+
+// IsInterfaceNil -
+func (stub *QuorumCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import "time"
+
+// RateLimiterStub -
+type RateLimiterStub struct {
+	ReserveHandler func() time.Duration
+	RefundHandler  func()
+}
+
+// Reserve -
+func (stub *RateLimiterStub) Reserve() time.Duration {
+	if stub.ReserveHandler != nil {
+		return stub.ReserveHandler()
+	}
+
+	return 0
+}
+
+// Refund -
+func (stub *RateLimiterStub) Refund() {
+	if stub.RefundHandler != nil {
+		stub.RefundHandler()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *RateLimiterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// ShardCheckerStub -
+type ShardCheckerStub struct {
+	CheckHandler func(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.ShardChange, map[string]uint32)
+}
+
+// Check -
+func (stub *ShardCheckerStub) Check(statistics map[string]*core.ValidatorStatistics, extraBLSKeys []string) ([]core.ShardChange, map[string]uint32) {
+	if stub.CheckHandler != nil {
+		return stub.CheckHandler(statistics, extraBLSKeys)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ShardCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -8,11 +8,13 @@ import (
 
 // StatusHandlerStub -
 type StatusHandlerStub struct {
-	NotifyAppStartHandler      func()
-	ErrorEncounteredHandler    func(err error)
-	CollectKeysProblemsHandler func(messages []core.OutputMessage)
-	ExecuteHandler             func(ctx context.Context) error
-	SendCloseMessageHandler    func()
+	NotifyAppStartHandler       func()
+	ErrorEncounteredHandler     func(err error)
+	CollectKeysProblemsHandler  func(messages []core.OutputMessage)
+	CollectKeysShardsHandler    func(monitorName string, shards map[string]uint32)
+	AddEndpointsProviderHandler func(monitorName string, provider core.EndpointsProvider)
+	ExecuteHandler              func(ctx context.Context) error
+	SendCloseMessageHandler     func()
 }
 
 // NotifyAppStart -
@@ -36,6 +38,20 @@ func (stub *StatusHandlerStub) CollectKeysProblems(messages []core.OutputMessage
 	}
 }
 
+// CollectKeysShards -
+func (stub *StatusHandlerStub) CollectKeysShards(monitorName string, shards map[string]uint32) {
+	if stub.CollectKeysShardsHandler != nil {
+		stub.CollectKeysShardsHandler(monitorName, shards)
+	}
+}
+
+// AddEndpointsProvider -
+func (stub *StatusHandlerStub) AddEndpointsProvider(monitorName string, provider core.EndpointsProvider) {
+	if stub.AddEndpointsProviderHandler != nil {
+		stub.AddEndpointsProviderHandler(monitorName, provider)
+	}
+}
+
 // SendCloseMessage -
 func (stub *StatusHandlerStub) SendCloseMessage() {
 	if stub.SendCloseMessageHandler != nil {
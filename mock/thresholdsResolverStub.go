@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+// ThresholdsResolverStub -
+type ThresholdsResolverStub struct {
+	SetOwnersBLSKeysHandler func(ownersBLSKeys []core.OwnerBLSKeys)
+}
+
+// SetOwnersBLSKeys -
+func (stub *ThresholdsResolverStub) SetOwnersBLSKeys(ownersBLSKeys []core.OwnerBLSKeys) {
+	if stub.SetOwnersBLSKeysHandler != nil {
+		stub.SetOwnersBLSKeysHandler(ownersBLSKeys)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ThresholdsResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
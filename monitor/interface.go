@@ -6,3 +6,7 @@ type pollingHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+type closer interface {
+	Close() error
+}
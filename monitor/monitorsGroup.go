@@ -0,0 +1,31 @@
+package monitor
+
+// monitorsGroup closes together all monitors created for the same configuration
+type monitorsGroup struct {
+	monitors []closer
+}
+
+// NewMonitorsGroup creates a new instance of type monitorsGroup
+func NewMonitorsGroup(monitors ...closer) *monitorsGroup {
+	return &monitorsGroup{
+		monitors: monitors,
+	}
+}
+
+// Close closes all monitors, returning the last encountered error
+func (group *monitorsGroup) Close() error {
+	var lastErr error
+	for _, monitor := range group.monitors {
+		err := monitor.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (group *monitorsGroup) IsInterfaceNil() bool {
+	return group == nil
+}
@@ -0,0 +1,42 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type closerStub struct {
+	numCalls int
+	err      error
+}
+
+func (stub *closerStub) Close() error {
+	stub.numCalls++
+	return stub.err
+}
+
+func TestMonitorsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *monitorsGroup
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = NewMonitorsGroup()
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestMonitorsGroup_Close(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	monitor1 := &closerStub{err: expectedErr}
+	monitor2 := &closerStub{}
+
+	group := NewMonitorsGroup(monitor1, monitor2)
+	err := group.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, monitor1.numCalls)
+	assert.Equal(t, 1, monitor2.numCalls)
+}
@@ -1,10 +1,13 @@
 package notifiers
 
-import "context"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
 
 // HTTPClientWrapper defines what an HTTP client wrapper should implement
 type HTTPClientWrapper interface {
-	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
-	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	PostHTTPWithResponse(ctx context.Context, endpoint string, data []byte) (*core.HTTPResponse, error)
 	IsInterfaceNil() bool
 }
@@ -2,6 +2,7 @@ package notifiers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -33,6 +34,11 @@ func (notifier *logNotifier) OutputMessages(messages ...core.OutputMessage) erro
 	return nil
 }
 
+// FitsInOnePayload returns true as each message is logged separately
+func (notifier *logNotifier) FitsInOnePayload(_ ...core.OutputMessage) bool {
+	return true
+}
+
 func (notifier *logNotifier) output(message core.OutputMessage) {
 	msg := composeMessage(message)
 
@@ -57,16 +63,40 @@ func composeMessage(message core.OutputMessage) string {
 		stringBuilder.WriteString(message.Identifier)
 		stringBuilder.WriteString(" ")
 	}
+	if len(message.Label) > 0 {
+		stringBuilder.WriteString(fmt.Sprintf("[%s] ", message.Label))
+	}
+	if len(message.Tags) > 0 {
+		stringBuilder.WriteString(fmt.Sprintf("{%s} ", composeTags(message.Tags)))
+	}
 	if len(message.ProblemEncountered) > 0 {
 		stringBuilder.WriteString("-> ")
 		stringBuilder.WriteString(message.ProblemEncountered)
 		stringBuilder.WriteString(" ")
 	}
+	if message.Epoch != nil {
+		stringBuilder.WriteString(fmt.Sprintf("in epoch %d ", *message.Epoch))
+	}
 	stringBuilder.WriteString(fmt.Sprintf("called by %s", message.ExecutorName))
 
 	return stringBuilder.String()
 }
 
+func composeTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+tags[key])
+	}
+
+	return strings.Join(pairs, " ")
+}
+
 // Name returns the name of the notifier
 func (notifier *logNotifier) Name() string {
 	return fmt.Sprintf("%T", notifier)
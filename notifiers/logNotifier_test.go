@@ -45,6 +45,13 @@ func TestLogNotifier_Name(t *testing.T) {
 	assert.Equal(t, "*notifiers.logNotifier", notifier.Name())
 }
 
+func TestLogNotifier_FitsInOnePayload(t *testing.T) {
+	t.Parallel()
+
+	notifier, _ := NewLogNotifier(&mock.LoggerStub{})
+	assert.True(t, notifier.FitsInOnePayload(make([]core.OutputMessage, 1000)...))
+}
+
 func TestLogNotifier_OutputErrorMessages(t *testing.T) {
 	t.Parallel()
 
@@ -91,3 +98,68 @@ func TestLogNotifier_OutputErrorMessages(t *testing.T) {
 
 	assert.Equal(t, expectedMap, messages)
 }
+
+func TestLogNotifier_OutputMessageWithLabelAndTags(t *testing.T) {
+	t.Parallel()
+
+	messages := make(map[string]logger.LogLevel)
+	logInstance := &mock.LoggerStub{
+		LogHandler: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+			messages[message] = logLevel
+		},
+	}
+
+	notifier, _ := NewLogNotifier(logInstance)
+	message := core.OutputMessage{
+		Type:               core.ErrorMessageOutputType,
+		IdentifierType:     "BLS key",
+		Identifier:         "0295e29aef11c30391a70c35781",
+		ExecutorName:       "test executor",
+		ProblemEncountered: "Rating drop detected: temp rating: 90.70, rating: 100.00",
+		Label:              "node-fra-03",
+		Tags: map[string]string{
+			"owner": "alice",
+			"dc":    "fra",
+		},
+	}
+
+	err := notifier.OutputMessages(message)
+	assert.Nil(t, err)
+
+	expectedMap := map[string]logger.LogLevel{
+		"BLS key 0295e29aef11c30391a70c35781 [node-fra-03] {dc=fra owner=alice} -> Rating drop detected: temp rating: 90.70, rating: 100.00 called by test executor": logger.LogError,
+	}
+
+	assert.Equal(t, expectedMap, messages)
+}
+
+func TestLogNotifier_OutputMessageWithEpoch(t *testing.T) {
+	t.Parallel()
+
+	messages := make(map[string]logger.LogLevel)
+	logInstance := &mock.LoggerStub{
+		LogHandler: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+			messages[message] = logLevel
+		},
+	}
+
+	notifier, _ := NewLogNotifier(logInstance)
+	epoch := uint32(37)
+	message := core.OutputMessage{
+		Type:               core.InfoMessageOutputType,
+		IdentifierType:     "BLS key",
+		Identifier:         "0295e29aef11c30391a70c35781",
+		ExecutorName:       "test executor",
+		ProblemEncountered: "Epoch report",
+		Epoch:              &epoch,
+	}
+
+	err := notifier.OutputMessages(message)
+	assert.Nil(t, err)
+
+	expectedMap := map[string]logger.LogLevel{
+		"BLS key 0295e29aef11c30391a70c35781 -> Epoch report in epoch 37 called by test executor": logger.LogInfo,
+	}
+
+	assert.Equal(t, expectedMap, messages)
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
-	httpSDK "github.com/multiversx/mx-sdk-go/core/http"
 )
 
 const (
 	maxSendTimeout       = time.Second * 30
+	maxPushoverLength    = 1024
 	httpBoldFormat       = "<b>%s</b>"
 	httpBoldedLinkFormat = `<b><a href="%s">%s</a></b>`
+	truncatedMarker      = "\n…truncated"
 )
 
 var log = logger.GetOrCreate("notifiers")
@@ -38,10 +43,11 @@ type pushoverNotifier struct {
 	httpClientWrapper HTTPClientWrapper
 }
 
-// NewPushoverNotifier will create a new Pushover notifier
-func NewPushoverNotifier(url string, token string, userKey string) *pushoverNotifier {
+// NewPushoverNotifier will create a new Pushover notifier. If the provided client is nil, the default HTTP client
+// will be used
+func NewPushoverNotifier(client *http.Client, url string, token string, userKey string) *pushoverNotifier {
 	return &pushoverNotifier{
-		httpClientWrapper: httpSDK.NewHttpClientWrapper(nil, url),
+		httpClientWrapper: clients.NewHTTPClientWrapper(client, url),
 		token:             token,
 		userKey:           userKey,
 	}
@@ -54,24 +60,79 @@ func (notifier *pushoverNotifier) OutputMessages(messages ...core.OutputMessage)
 		return nil
 	}
 
+	msgString, title := composeNotification(messages, httpBoldFormat, httpBoldedLinkFormat, "")
+	msgString = truncateText(msgString, maxPushoverLength)
+
+	err := notifier.pushNotification(msgString, title)
+	if err != nil {
+		return fmt.Errorf("%w in pushoverNotifier.OutputMessages", err)
+	}
+
+	return nil
+}
+
+// FitsInOnePayload returns true if the provided messages do not exceed the maximum message length accepted by Pushover
+func (notifier *pushoverNotifier) FitsInOnePayload(messages ...core.OutputMessage) bool {
+	msgString, _ := composeNotification(messages, httpBoldFormat, httpBoldedLinkFormat, "")
+
+	return len(msgString) <= maxPushoverLength
+}
+
+// composeNotification returns the text of the provided messages, each followed by the separator, and the title given
+// by the most severe message
+func composeNotification(
+	messages []core.OutputMessage,
+	boldFormat string,
+	linkFormat string,
+	separator string,
+) (string, string) {
+	if len(messages) == 0 {
+		return "", ""
+	}
+
 	msgString := ""
-	highestMessageOutputType := core.MessageOutputType(0)
+	maxMessageOutputType := core.MessageOutputType(0)
 	for _, msg := range messages {
-		if msg.Type > highestMessageOutputType {
-			highestMessageOutputType = msg.Type
+		if msg.Type > maxMessageOutputType {
+			maxMessageOutputType = msg.Type
 		}
 
-		msgString += createMessageString(msg, httpBoldFormat, httpBoldedLinkFormat)
+		msgString += createMessageString(msg, boldFormat, linkFormat) + separator
 	}
 
-	title := createTitle(highestMessageOutputType, messages[0].ExecutorName)
+	return msgString, createTitle(maxMessageOutputType, messages[0].ExecutorName)
+}
 
-	err := notifier.pushNotification(msgString, title)
-	if err != nil {
-		return fmt.Errorf("%w in pushoverNotifier.OutputMessages", err)
+// truncateText shortens a text exceeding the maximum length, a single message being too long to be split in several
+// notifications, and ends it with a marker. If the cut would leave an unclosed HTML tag, the text is cut at the
+// beginning of the line so the notification service can still parse it
+func truncateText(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
 	}
 
-	return nil
+	cut := maxLength - len(truncatedMarker)
+	if cut < 0 {
+		cut = 0
+	}
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	truncated := text[:cut]
+	if hasUnclosedTags(truncated) {
+		truncated = truncated[:strings.LastIndex(truncated, "\n")+1]
+	}
+
+	log.Warn("notification text exceeds the maximum length, truncating", "length", len(text), "max length", maxLength)
+
+	return truncated + truncatedMarker
+}
+
+func hasUnclosedTags(text string) bool {
+	return strings.LastIndex(text, "<") > strings.LastIndex(text, ">") ||
+		strings.Count(text, "<b>") > strings.Count(text, "</b>") ||
+		strings.Count(text, "<a ") > strings.Count(text, "</a>")
 }
 
 func createMessageString(
@@ -81,14 +142,34 @@ func createMessageString(
 ) string {
 	identifier := processIdentifier(msg, boldFormat, linkFormat)
 	iconString := getIconString(msg)
+	tagsString := getTagsString(msg)
+	epochString := getEpochString(msg)
 
 	if len(msg.ProblemEncountered) == 0 {
-		return fmt.Sprintf("%s %s %s\n\n",
-			iconString, msg.IdentifierType, identifier)
+		return fmt.Sprintf("%s %s %s%s%s\n\n",
+			iconString, msg.IdentifierType, identifier, tagsString, epochString)
+	}
+
+	return fmt.Sprintf("%s %s %s%s: %s%s\n\n",
+		iconString, msg.IdentifierType, identifier, tagsString, msg.ProblemEncountered, epochString)
+}
+
+// getTagsString returns the tags defined in the list file for the message's identifier, the label being already
+// displayed in the short identifier
+func getTagsString(message core.OutputMessage) string {
+	if len(message.Tags) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" {%s}", composeTags(message.Tags))
+}
+
+func getEpochString(message core.OutputMessage) string {
+	if message.Epoch == nil {
+		return ""
 	}
 
-	return fmt.Sprintf("%s %s %s: %s\n\n",
-		iconString, msg.IdentifierType, identifier, msg.ProblemEncountered)
+	return fmt.Sprintf(" (epoch %d)", *message.Epoch)
 }
 
 func getIconString(message core.OutputMessage) string {
@@ -150,16 +231,16 @@ func (notifier *pushoverNotifier) pushNotification(msgString string, title strin
 	ctx, cancel := context.WithTimeout(context.Background(), maxSendTimeout)
 	defer cancel()
 
-	responseBytes, statusCode, err := notifier.httpClientWrapper.PostHTTP(ctx, "", data)
+	response, err := notifier.httpClientWrapper.PostHTTPWithResponse(ctx, "", data)
 	if err != nil {
 		return err
 	}
-	if !core.IsHttpStatusCodeSuccess(statusCode) {
-		return fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
+	if !core.IsHttpStatusCodeSuccess(response.StatusCode) {
+		return createHTTPResponseError(response)
 	}
 
 	resp := &pushoverResponse{}
-	err = json.Unmarshal(responseBytes, &resp)
+	err = json.Unmarshal(response.Body, &resp)
 	if err != nil {
 		return err
 	}
@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -68,7 +70,7 @@ func createHttpTestServerThatRespondsOK(
 func TestNewPushoverNotifier(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewPushoverNotifier("url", "", "")
+	notifier := NewPushoverNotifier(nil, "url", "", "")
 	assert.NotNil(t, notifier)
 }
 
@@ -85,10 +87,53 @@ func TestPushoverNotifier_IsInterfaceNil(t *testing.T) {
 func TestPushoverNotifier_Name(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewPushoverNotifier("url", "", "")
+	notifier := NewPushoverNotifier(nil, "url", "", "")
 	assert.Equal(t, "*notifiers.pushoverNotifier", notifier.Name())
 }
 
+func TestPushoverNotifier_FitsInOnePayload(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewPushoverNotifier(nil, "", "token", "user")
+	message := core.OutputMessage{
+		Type:               core.ErrorMessageOutputType,
+		IdentifierType:     "BLS key",
+		ShortIdentifier:    "key",
+		ExecutorName:       "test",
+		ProblemEncountered: strings.Repeat("a", 250),
+	}
+	assert.True(t, notifier.FitsInOnePayload(message))
+	assert.True(t, notifier.FitsInOnePayload(message, message, message))
+	assert.False(t, notifier.FitsInOnePayload(message, message, message, message, message))
+}
+
+func TestTruncateText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short text should not be changed", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "text", truncateText("text", 4))
+	})
+	t.Run("long text should be truncated with a marker", func(t *testing.T) {
+		t.Parallel()
+
+		text := "🚨 BLS key <b>key</b>: " + strings.Repeat("é", 100)
+		truncated := truncateText(text, 50)
+		assert.LessOrEqual(t, len(truncated), 50)
+		assert.True(t, strings.HasPrefix(truncated, "🚨 BLS key <b>key</b>: é"))
+		assert.True(t, strings.HasSuffix(truncated, truncatedMarker))
+		assert.True(t, utf8.ValidString(truncated))
+	})
+	t.Run("the cut inside a tag should be moved at the line start", func(t *testing.T) {
+		t.Parallel()
+
+		text := "first line\n\n🚨 BLS key <b><a href=\"https://examples.com/key\">key</a></b>: problem"
+		truncated := truncateText(text, 50)
+		assert.Equal(t, "first line\n\n"+truncatedMarker, truncated)
+	})
+}
+
 func TestPushoverNotifier_OutputMessages(t *testing.T) {
 	t.Parallel()
 
@@ -101,7 +146,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewPushoverNotifier(testServer.URL, testToken, testUserKey)
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
 		err := notifier.OutputMessages()
 		assert.Nil(t, err)
 
@@ -111,7 +156,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 	t.Run("post method fails should error", func(t *testing.T) {
 		t.Parallel()
 
-		notifier := NewPushoverNotifier("not-a-server-URL", "", "")
+		notifier := NewPushoverNotifier(nil, "not-a-server-URL", "", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "not-a-server-URL")
@@ -123,7 +168,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 			rw.WriteHeader(http.StatusInternalServerError)
 		}))
 
-		notifier := NewPushoverNotifier(testHttpServer.URL, "", "")
+		notifier := NewPushoverNotifier(nil, testHttpServer.URL, "", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
 	})
@@ -135,7 +180,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 			_, _ = rw.Write([]byte("not-a-valid-json"))
 		}))
 
-		notifier := NewPushoverNotifier(testHttpServer.URL, "", "")
+		notifier := NewPushoverNotifier(nil, testHttpServer.URL, "", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid character")
@@ -143,6 +188,53 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 		// make sure any accidental calls on API endpoint routes are caught by the test server
 		time.Sleep(time.Second)
 	})
+	t.Run("a message exceeding the maximum length should be truncated", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedMessage string
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			request := &pushoverRequest{}
+			err := json.NewDecoder(req.Body).Decode(request)
+			assert.Nil(t, err)
+			receivedMessage = request.Message
+
+			rw.WriteHeader(http.StatusOK)
+			_, _ = rw.Write([]byte(`{"status":1}`))
+		}))
+		defer testHttpServer.Close()
+
+		message := testInfoMessage
+		message.ProblemEncountered = strings.Repeat("a", maxPushoverLength)
+		notifier := NewPushoverNotifier(nil, testHttpServer.URL, testToken, testUserKey)
+		err := notifier.OutputMessages(message)
+		assert.Nil(t, err)
+		assert.Equal(t, maxPushoverLength, len(receivedMessage))
+		assert.True(t, strings.HasSuffix(receivedMessage, truncatedMarker))
+	})
+	t.Run("the tags should be displayed after the identifier", func(t *testing.T) {
+		t.Parallel()
+
+		msg := core.OutputMessage{
+			Type:               core.ErrorMessageOutputType,
+			IdentifierType:     "BLS key",
+			ExecutorName:       "executor",
+			ShortIdentifier:    "node-fra-03 (key)",
+			Label:              "node-fra-03",
+			Tags:               map[string]string{"owner": "alice", "dc": "fra"},
+			ProblemEncountered: "problem1",
+		}
+
+		numCalls := uint32(0)
+		expectedTitle := "🚨 Problems occurred on executor"
+		expectedMessage := "🚨 BLS key <b>node-fra-03 (key)</b> {dc=fra owner=alice}: problem1\n\n"
+		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
+		defer testServer.Close()
+
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
+		err := notifier.OutputMessages(msg)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
 	t.Run("sending info messages should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -160,10 +252,12 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 			IdentifierType: "info10",
 			ExecutorName:   "executor",
 		}
+		epoch := uint32(37)
 		msg3 := core.OutputMessage{
 			Type:            core.InfoMessageOutputType,
 			ShortIdentifier: "info20",
 			ExecutorName:    "executor",
+			Epoch:           &epoch,
 		}
 
 		numCalls := uint32(0)
@@ -172,14 +266,14 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 
 ✅ info10 
 
-✅  <b>info20</b>
+✅  <b>info20</b> (epoch 37)
 
 `
 
 		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewPushoverNotifier(testServer.URL, testToken, testUserKey)
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -222,7 +316,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewPushoverNotifier(testServer.URL, testToken, testUserKey)
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -265,7 +359,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewPushoverNotifier(testServer.URL, testToken, testUserKey)
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -308,7 +402,7 @@ func TestPushoverNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOK(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewPushoverNotifier(testServer.URL, testToken, testUserKey)
+		notifier := NewPushoverNotifier(nil, testServer.URL, testToken, testUserKey)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -327,6 +421,7 @@ func TestPushoverNotifier_FunctionalTest(t *testing.T) {
 	_ = logger.SetLogLevel("*:DEBUG")
 
 	notifier := NewPushoverNotifier(
+		nil,
 		"https://api.pushover.net/1/messages.json",
 		pushoverToken,
 		pushoverUserKey,
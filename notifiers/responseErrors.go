@@ -0,0 +1,120 @@
+package notifiers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const retryAfterHeader = "Retry-After"
+
+// invalidCredentialsMarkers are the parts of the HTTP 400 response bodies sent by the notification services when the
+// configured token, chat ID or user key is invalid
+var invalidCredentialsMarkers = []string{
+	"invalid_token",                // Slack
+	"application token is invalid", // Pushover
+	"user key is invalid",          // Pushover
+	"not a valid user",             // Pushover
+	"chat not found",               // Telegram
+}
+
+type telegramErrorResponse struct {
+	Parameters struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters"`
+}
+
+// createHTTPResponseError will create the error for an HTTP response that does not have a 2xx status code
+func createHTTPResponseError(response *core.HTTPResponse) error {
+	return &core.NotificationError{
+		Err:        fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, response.StatusCode),
+		Code:       response.StatusCode,
+		Permanent:  isPermanentHTTPResponse(response.StatusCode, response.Body),
+		RetryAfter: parseRetryAfterHeader(response.Header),
+	}
+}
+
+// createTelegramResponseError will also take into account the retry_after parameter Telegram sends on HTTP 429
+func createTelegramResponseError(response *core.HTTPResponse) error {
+	err := createHTTPResponseError(response)
+
+	telegramResponse := &telegramErrorResponse{}
+	errUnmarshal := json.Unmarshal(response.Body, telegramResponse)
+	if errUnmarshal == nil && telegramResponse.Parameters.RetryAfter > 0 {
+		notificationError := err.(*core.NotificationError)
+		notificationError.RetryAfter = time.Duration(telegramResponse.Parameters.RetryAfter) * time.Second
+	}
+
+	return err
+}
+
+// createSmtpError will mark the 5xx SMTP replies (authentication failed, mailbox not found and so on) as permanent
+func createSmtpError(err error) error {
+	protocolError := &textproto.Error{}
+	if !errors.As(err, &protocolError) {
+		return err
+	}
+
+	return &core.NotificationError{
+		Err:       err,
+		Code:      protocolError.Code,
+		Permanent: protocolError.Code >= 500,
+	}
+}
+
+// isPermanentHTTPResponse returns true only for the responses that clearly signal a misconfigured notifier and will not
+// be solved by retrying: the unauthorized status code and the bad request status code with a known invalid token, chat
+// ID or user key body. The other rejections (a temporary 403 or 404 from a proxy, a payload too large and so on) are not
+// considered permanent, so the messages are moved in the outbox instead of being lost
+func isPermanentHTTPResponse(statusCode int, body []byte) bool {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusBadRequest:
+		lowerCaseBody := strings.ToLower(string(body))
+		for _, marker := range invalidCredentialsMarkers {
+			if strings.Contains(lowerCaseBody, marker) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// parseRetryAfterHeader supports both the delay-seconds and the HTTP-date formats
+func parseRetryAfterHeader(header http.Header) time.Duration {
+	value := header.Get(retryAfterHeader)
+	if len(value) == 0 {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0
+	}
+
+	delay := time.Until(date)
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
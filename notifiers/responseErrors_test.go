@@ -0,0 +1,159 @@
+package notifiers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPermanentHTTPResponse(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isPermanentHTTPResponse(http.StatusOK, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusFound, nil))
+	assert.True(t, isPermanentHTTPResponse(http.StatusUnauthorized, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusBadRequest, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusBadRequest, []byte("message too long")))
+	assert.True(t, isPermanentHTTPResponse(http.StatusBadRequest, []byte("invalid_token")))
+	assert.True(t, isPermanentHTTPResponse(http.StatusBadRequest,
+		[]byte(`{"token":"invalid","errors":["application token is invalid"],"status":0}`)))
+	assert.True(t, isPermanentHTTPResponse(http.StatusBadRequest,
+		[]byte(`{"user":"invalid","errors":["user identifier is not a valid user, group, or subscribed user key"],"status":0}`)))
+	assert.True(t, isPermanentHTTPResponse(http.StatusBadRequest,
+		[]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`)))
+	assert.False(t, isPermanentHTTPResponse(http.StatusForbidden, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusNotFound, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusRequestEntityTooLarge, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusRequestURITooLong, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusRequestTimeout, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusTooManyRequests, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusInternalServerError, nil))
+	assert.False(t, isPermanentHTTPResponse(http.StatusBadGateway, nil))
+}
+
+func TestParseRetryAfterHeader(t *testing.T) {
+	t.Parallel()
+
+	createHeader := func(value string) http.Header {
+		header := http.Header{}
+		header.Set(retryAfterHeader, value)
+		return header
+	}
+
+	assert.Zero(t, parseRetryAfterHeader(http.Header{}))
+	assert.Zero(t, parseRetryAfterHeader(createHeader("not a number")))
+	assert.Zero(t, parseRetryAfterHeader(createHeader("-5")))
+	assert.Equal(t, time.Second*37, parseRetryAfterHeader(createHeader("37")))
+	assert.Zero(t, parseRetryAfterHeader(createHeader(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))))
+
+	delay := parseRetryAfterHeader(createHeader(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)))
+	assert.Greater(t, delay, time.Minute*59)
+	assert.LessOrEqual(t, delay, time.Hour)
+}
+
+func TestCreateHTTPResponseError(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set(retryAfterHeader, "10")
+	err := createHTTPResponseError(&core.HTTPResponse{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     header,
+	})
+
+	assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+	assert.Equal(t, "HTTP return code is not OK, but 429", err.Error())
+	notificationError := &core.NotificationError{}
+	require.True(t, errors.As(err, &notificationError))
+	assert.Equal(t, http.StatusTooManyRequests, notificationError.Code)
+	assert.False(t, notificationError.Permanent)
+	assert.Equal(t, time.Second*10, notificationError.RetryAfter)
+}
+
+func TestCreateTelegramResponseError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retry_after parameter should be used", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTelegramResponseError(&core.HTTPResponse{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       []byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`),
+		})
+
+		notificationError := &core.NotificationError{}
+		require.True(t, errors.As(err, &notificationError))
+		assert.Equal(t, time.Second*5, notificationError.RetryAfter)
+		assert.False(t, notificationError.Permanent)
+	})
+	t.Run("unauthorized should be permanent", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTelegramResponseError(&core.HTTPResponse{
+			StatusCode: http.StatusUnauthorized,
+			Body:       []byte(`{"ok":false,"error_code":401,"description":"Unauthorized"}`),
+		})
+
+		notificationError := &core.NotificationError{}
+		require.True(t, errors.As(err, &notificationError))
+		assert.Zero(t, notificationError.RetryAfter)
+		assert.True(t, notificationError.Permanent)
+	})
+	t.Run("invalid body should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTelegramResponseError(&core.HTTPResponse{
+			StatusCode: http.StatusBadGateway,
+			Body:       []byte("not a JSON"),
+		})
+
+		notificationError := &core.NotificationError{}
+		require.True(t, errors.As(err, &notificationError))
+		assert.Zero(t, notificationError.RetryAfter)
+		assert.False(t, notificationError.Permanent)
+	})
+}
+
+func TestCreateSmtpError(t *testing.T) {
+	t.Parallel()
+
+	genericErr := errors.New("connection refused")
+	assert.Equal(t, genericErr, createSmtpError(genericErr))
+
+	err := createSmtpError(fmt.Errorf("wrapped: %w", &textproto.Error{Code: 535, Msg: "authentication failed"}))
+	notificationError := &core.NotificationError{}
+	require.True(t, errors.As(err, &notificationError))
+	assert.Equal(t, 535, notificationError.Code)
+	assert.True(t, notificationError.Permanent)
+
+	err = createSmtpError(&textproto.Error{Code: 421, Msg: "service not available"})
+	require.True(t, errors.As(err, &notificationError))
+	assert.Equal(t, 421, notificationError.Code)
+	assert.False(t, notificationError.Permanent)
+}
+
+func TestTelegramNotifier_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusTooManyRequests)
+		_, _ = rw.Write([]byte(`{"ok":false,"error_code":429,"parameters":{"retry_after":3}}`))
+	}))
+	defer testServer.Close()
+
+	notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
+	err := notifier.OutputMessages(core.OutputMessage{Type: core.ErrorMessageOutputType})
+	assert.ErrorIs(t, err, errReturnCodeIsNotOk)
+
+	notificationError := &core.NotificationError{}
+	require.True(t, errors.As(err, &notificationError))
+	assert.Equal(t, time.Second*3, notificationError.RetryAfter)
+}
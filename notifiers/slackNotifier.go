@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
-	httpSDK "github.com/multiversx/mx-sdk-go/core/http"
 )
 
 const (
+	maxSlackLength        = 40000
 	slackBoldFormat       = "*%s*"
 	slackBoldedLinkFormat = "*<%s|%s>*"
 )
@@ -23,10 +25,11 @@ type slackNotifier struct {
 	secret            string
 }
 
-// NewSlackNotifier will create a new Slack notifier
-func NewSlackNotifier(url string, secret string) *slackNotifier {
+// NewSlackNotifier will create a new Slack notifier. If the provided client is nil, the default HTTP client
+// will be used
+func NewSlackNotifier(client *http.Client, url string, secret string) *slackNotifier {
 	return &slackNotifier{
-		httpClientWrapper: httpSDK.NewHttpClientWrapper(nil, url),
+		httpClientWrapper: clients.NewHTTPClientWrapper(client, url),
 		secret:            secret,
 	}
 }
@@ -38,17 +41,8 @@ func (notifier *slackNotifier) OutputMessages(messages ...core.OutputMessage) er
 		return nil
 	}
 
-	msgString := ""
-	maxMessageOutputType := core.MessageOutputType(0)
-	for _, msg := range messages {
-		if msg.Type > maxMessageOutputType {
-			maxMessageOutputType = msg.Type
-		}
-
-		msgString += createMessageString(msg, slackBoldFormat, slackBoldedLinkFormat)
-	}
-
-	title := createTitle(maxMessageOutputType, messages[0].ExecutorName)
+	msgString, title := composeNotification(messages, slackBoldFormat, slackBoldedLinkFormat, "")
+	msgString = truncateText(msgString, maxSlackLength-len(createSlackText("", title)))
 
 	err := notifier.pushNotification(msgString, title)
 	if err != nil {
@@ -63,27 +57,38 @@ func (notifier *slackNotifier) pushNotification(msgString string, title string)
 	defer cancel()
 
 	request := &slackRequest{
-		Text: fmt.Sprintf("%s\n\n%s", title, msgString),
+		Text: createSlackText(msgString, title),
 	}
 	requestBuff, err := json.Marshal(request)
 	if err != nil {
 		return err
 	}
 
-	_, statusCode, err := notifier.httpClientWrapper.PostHTTP(ctx, notifier.secret, requestBuff)
+	response, err := notifier.httpClientWrapper.PostHTTPWithResponse(ctx, notifier.secret, requestBuff)
 	if err != nil {
 		return err
 	}
-	if !core.IsHttpStatusCodeSuccess(statusCode) {
-		return fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
+	if !core.IsHttpStatusCodeSuccess(response.StatusCode) {
+		return createHTTPResponseError(response)
 	}
 
 	log.Debug("slackNotifier.pushNotification: sent notification",
-		"status", statusCode)
+		"status", response.StatusCode)
 
 	return nil
 }
 
+// FitsInOnePayload returns true if the provided messages do not exceed the maximum text length accepted by Slack
+func (notifier *slackNotifier) FitsInOnePayload(messages ...core.OutputMessage) bool {
+	msgString, title := composeNotification(messages, slackBoldFormat, slackBoldedLinkFormat, "")
+
+	return len(createSlackText(msgString, title)) <= maxSlackLength
+}
+
+func createSlackText(msgString string, title string) string {
+	return fmt.Sprintf("%s\n\n%s", title, msgString)
+}
+
 // Name returns the name of the notifier
 func (notifier *slackNotifier) Name() string {
 	return fmt.Sprintf("%T", notifier)
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -51,7 +52,7 @@ func createHttpTestServerThatRespondsOKForSlack(
 func TestNewSlackNotifier(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewSlackNotifier("", "")
+	notifier := NewSlackNotifier(nil, "", "")
 	require.NotNil(t, notifier)
 }
 
@@ -68,10 +69,26 @@ func TestSlackNotifier_IsInterfaceNil(t *testing.T) {
 func TestSlackNotifier_Name(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewSlackNotifier("url", "")
+	notifier := NewSlackNotifier(nil, "url", "")
 	assert.Equal(t, "*notifiers.slackNotifier", notifier.Name())
 }
 
+func TestSlackNotifier_FitsInOnePayload(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewSlackNotifier(nil, "", "secret")
+	message := core.OutputMessage{
+		Type:               core.ErrorMessageOutputType,
+		IdentifierType:     "BLS key",
+		ShortIdentifier:    "key",
+		ExecutorName:       "test",
+		ProblemEncountered: strings.Repeat("a", 10000),
+	}
+	assert.True(t, notifier.FitsInOnePayload(message))
+	assert.True(t, notifier.FitsInOnePayload(message, message, message))
+	assert.False(t, notifier.FitsInOnePayload(message, message, message, message, message))
+}
+
 func TestSlackNotifier_OutputMessages(t *testing.T) {
 	t.Parallel()
 
@@ -84,7 +101,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForSlack(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewSlackNotifier(testServer.URL, testSlackSecret)
+		notifier := NewSlackNotifier(nil, testServer.URL, testSlackSecret)
 		err := notifier.OutputMessages()
 		assert.Nil(t, err)
 
@@ -94,7 +111,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 	t.Run("post method fails should error", func(t *testing.T) {
 		t.Parallel()
 
-		notifier := NewSlackNotifier("not-a-server-URL", "")
+		notifier := NewSlackNotifier(nil, "not-a-server-URL", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "not-a-server-URL")
@@ -106,7 +123,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 			rw.WriteHeader(http.StatusInternalServerError)
 		}))
 
-		notifier := NewSlackNotifier(testHttpServer.URL, "")
+		notifier := NewSlackNotifier(nil, testHttpServer.URL, "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
 	})
@@ -146,7 +163,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForSlack(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewSlackNotifier(testServer.URL, testSlackSecret)
+		notifier := NewSlackNotifier(nil, testServer.URL, testSlackSecret)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -189,7 +206,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForSlack(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewSlackNotifier(testServer.URL, testSlackSecret)
+		notifier := NewSlackNotifier(nil, testServer.URL, testSlackSecret)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -232,7 +249,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForSlack(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewSlackNotifier(testServer.URL, testSlackSecret)
+		notifier := NewSlackNotifier(nil, testServer.URL, testSlackSecret)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -275,7 +292,7 @@ func TestSlackNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForSlack(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewSlackNotifier(testServer.URL, testSlackSecret)
+		notifier := NewSlackNotifier(nil, testServer.URL, testSlackSecret)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -293,6 +310,7 @@ func TestSlackNotifier_FunctionalTest(t *testing.T) {
 	_ = logger.SetLogLevel("*:DEBUG")
 
 	notifier := NewSlackNotifier(
+		nil,
 		"https://hooks.slack.com/services",
 		slackAppSecret,
 	)
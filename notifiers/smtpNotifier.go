@@ -59,17 +59,7 @@ func (notifier *smtpNotifier) OutputMessages(messages ...core.OutputMessage) err
 		return nil
 	}
 
-	msgString := ""
-	maxMessageOutputType := core.MessageOutputType(0)
-	for _, msg := range messages {
-		if msg.Type > maxMessageOutputType {
-			maxMessageOutputType = msg.Type
-		}
-
-		msgString += createMessageString(msg, httpBoldFormat, httpBoldedLinkFormat) + htmlLineBreak
-	}
-
-	title := createTitle(maxMessageOutputType, messages[0].ExecutorName)
+	msgString, title := composeNotification(messages, httpBoldFormat, httpBoldedLinkFormat, htmlLineBreak)
 
 	err := notifier.pushNotification(msgString, title)
 	if err != nil {
@@ -95,7 +85,7 @@ func (notifier *smtpNotifier) pushNotification(msgString string, title string) e
 		msgBytes,
 	)
 	if err != nil {
-		return err
+		return createSmtpError(err)
 	}
 
 	log.Debug("smtpNotifier.pushNotification: sent notification as SMTP email")
@@ -103,6 +93,11 @@ func (notifier *smtpNotifier) pushNotification(msgString string, title string) e
 	return nil
 }
 
+// FitsInOnePayload returns true as the emails do not have a size limit relevant for the notifications
+func (notifier *smtpNotifier) FitsInOnePayload(_ ...core.OutputMessage) bool {
+	return true
+}
+
 func sendMail(host string, auth smtp.Auth, from string, to []string, msgBytes []byte) error {
 	return smtp.SendMail(host, auth, from, to, msgBytes)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/smtp"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
@@ -36,6 +37,22 @@ func TestSmtpNotifier_Name(t *testing.T) {
 	assert.Equal(t, "*notifiers.smtpNotifier", notifier.Name())
 }
 
+func TestSmtpNotifier_FitsInOnePayload(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewSmtpNotifier(ArgsSmtpNotifier{})
+	message := core.OutputMessage{
+		Type:               core.ErrorMessageOutputType,
+		IdentifierType:     "BLS key",
+		ShortIdentifier:    "key",
+		ExecutorName:       "test",
+		ProblemEncountered: strings.Repeat("a", 10000),
+	}
+	assert.True(t, notifier.FitsInOnePayload(message))
+	assert.True(t, notifier.FitsInOnePayload(message, message, message))
+	assert.True(t, notifier.FitsInOnePayload(message, message, message, message, message))
+}
+
 func TestSmtpNotifier_OutputMessages(t *testing.T) {
 	testArgs := ArgsSmtpNotifier{
 		To:       "to@email.com",
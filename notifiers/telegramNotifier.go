@@ -3,22 +3,27 @@ package notifiers
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 
+	"github.com/multiversx/mx-chain-keys-monitor-go/clients"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
-	httpSDK "github.com/multiversx/mx-sdk-go/core/http"
 )
 
+// maxTelegramLength is the maximum length of a Telegram message text
+const maxTelegramLength = 4096
+
 type telegramNotifier struct {
 	token             string
 	chatID            string
 	httpClientWrapper HTTPClientWrapper
 }
 
-// NewTelegramNotifier will create a new Telegram notifier
-func NewTelegramNotifier(url string, token string, chatID string) *telegramNotifier {
+// NewTelegramNotifier will create a new Telegram notifier. If the provided client is nil, the default HTTP client
+// will be used
+func NewTelegramNotifier(client *http.Client, url string, token string, chatID string) *telegramNotifier {
 	return &telegramNotifier{
-		httpClientWrapper: httpSDK.NewHttpClientWrapper(nil, url),
+		httpClientWrapper: clients.NewHTTPClientWrapper(client, url),
 		token:             token,
 		chatID:            chatID,
 	}
@@ -31,17 +36,8 @@ func (notifier *telegramNotifier) OutputMessages(messages ...core.OutputMessage)
 		return nil
 	}
 
-	msgString := ""
-	maxMessageOutputType := core.MessageOutputType(0)
-	for _, msg := range messages {
-		if msg.Type > maxMessageOutputType {
-			maxMessageOutputType = msg.Type
-		}
-
-		msgString += createMessageString(msg, httpBoldFormat, httpBoldedLinkFormat)
-	}
-
-	title := createTitle(maxMessageOutputType, messages[0].ExecutorName)
+	msgString, title := composeNotification(messages, httpBoldFormat, httpBoldedLinkFormat, "")
+	msgString = truncateText(msgString, maxTelegramLength-len(createTelegramText("", title)))
 
 	err := notifier.pushNotification(msgString, title)
 	if err != nil {
@@ -58,24 +54,35 @@ func (notifier *telegramNotifier) pushNotification(msgString string, title strin
 	urlVal := url.Values{
 		"chat_id":    {notifier.chatID},
 		"parse_mode": {"html"},
-		"text":       {fmt.Sprintf("%s\n\n%s", title, msgString)},
+		"text":       {createTelegramText(msgString, title)},
 	}
 
 	encodedURL := fmt.Sprintf("bot%s/sendMessage?%s", notifier.token, urlVal.Encode())
-	_, statusCode, err := notifier.httpClientWrapper.PostHTTP(ctx, encodedURL, make([]byte, 0))
+	response, err := notifier.httpClientWrapper.PostHTTPWithResponse(ctx, encodedURL, make([]byte, 0))
 	if err != nil {
 		return err
 	}
-	if !core.IsHttpStatusCodeSuccess(statusCode) {
-		return fmt.Errorf("%w, but %d", errReturnCodeIsNotOk, statusCode)
+	if !core.IsHttpStatusCodeSuccess(response.StatusCode) {
+		return createTelegramResponseError(response)
 	}
 
 	log.Debug("telegramNotifier.pushNotification: sent notification",
-		"status", statusCode)
+		"status", response.StatusCode)
 
 	return nil
 }
 
+// FitsInOnePayload returns true if the provided messages do not exceed the maximum text length accepted by Telegram
+func (notifier *telegramNotifier) FitsInOnePayload(messages ...core.OutputMessage) bool {
+	msgString, title := composeNotification(messages, httpBoldFormat, httpBoldedLinkFormat, "")
+
+	return len(createTelegramText(msgString, title)) <= maxTelegramLength
+}
+
+func createTelegramText(msgString string, title string) string {
+	return fmt.Sprintf("%s\n\n%s", title, msgString)
+}
+
 // Name returns the name of the notifier
 func (notifier *telegramNotifier) Name() string {
 	return fmt.Sprintf("%T", notifier)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -40,7 +41,7 @@ func createHttpTestServerThatRespondsOKForTelegram(
 func TestNewTelegramNotifier(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewTelegramNotifier("url", "", "")
+	notifier := NewTelegramNotifier(nil, "url", "", "")
 	assert.NotNil(t, notifier)
 }
 
@@ -57,10 +58,26 @@ func TestTelegramNotifier_IsInterfaceNil(t *testing.T) {
 func TestTelegramNotifier_Name(t *testing.T) {
 	t.Parallel()
 
-	notifier := NewTelegramNotifier("url", "", "")
+	notifier := NewTelegramNotifier(nil, "url", "", "")
 	assert.Equal(t, "*notifiers.telegramNotifier", notifier.Name())
 }
 
+func TestTelegramNotifier_FitsInOnePayload(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewTelegramNotifier(nil, "", testTelegramToken, testTelegramChatID)
+	message := core.OutputMessage{
+		Type:               core.ErrorMessageOutputType,
+		IdentifierType:     "BLS key",
+		ShortIdentifier:    "key",
+		ExecutorName:       "test",
+		ProblemEncountered: strings.Repeat("a", 1000),
+	}
+	assert.True(t, notifier.FitsInOnePayload(message))
+	assert.True(t, notifier.FitsInOnePayload(message, message, message))
+	assert.False(t, notifier.FitsInOnePayload(message, message, message, message, message))
+}
+
 func TestTelegramNotifier_OutputMessages(t *testing.T) {
 	t.Parallel()
 
@@ -73,7 +90,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForTelegram(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewTelegramNotifier(testServer.URL, testTelegramToken, testTelegramChatID)
+		notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
 		err := notifier.OutputMessages()
 		assert.Nil(t, err)
 
@@ -83,7 +100,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 	t.Run("post method fails should error", func(t *testing.T) {
 		t.Parallel()
 
-		notifier := NewTelegramNotifier("not-a-server-URL", "", "")
+		notifier := NewTelegramNotifier(nil, "not-a-server-URL", "", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "not-a-server-URL")
@@ -95,10 +112,34 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 			rw.WriteHeader(http.StatusInternalServerError)
 		}))
 
-		notifier := NewTelegramNotifier(testHttpServer.URL, "", "")
+		notifier := NewTelegramNotifier(nil, testHttpServer.URL, "", "")
 		err := notifier.OutputMessages(testInfoMessage)
 		assert.ErrorIs(t, err, errReturnCodeIsNotOk)
 	})
+	t.Run("a message exceeding the maximum length should be truncated", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedText string
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			receivedText = req.URL.Query().Get("text")
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testHttpServer.Close()
+
+		message := core.OutputMessage{
+			Type:               core.InfoMessageOutputType,
+			IdentifierType:     "info1",
+			ExecutorName:       "executor",
+			ShortIdentifier:    "info3",
+			ProblemEncountered: strings.Repeat("a", maxTelegramLength),
+		}
+		notifier := NewTelegramNotifier(nil, testHttpServer.URL, testTelegramToken, testTelegramChatID)
+		err := notifier.OutputMessages(message)
+		assert.Nil(t, err)
+		assert.Equal(t, maxTelegramLength, len(receivedText))
+		assert.True(t, strings.HasPrefix(receivedText, "ⓘ Info for executor\n\n✅ info1 <b>info3</b>: aaa"))
+		assert.True(t, strings.HasSuffix(receivedText, truncatedMarker))
+	})
 	t.Run("sending info messages should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -135,7 +176,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForTelegram(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewTelegramNotifier(testServer.URL, testTelegramToken, testTelegramChatID)
+		notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -178,7 +219,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForTelegram(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewTelegramNotifier(testServer.URL, testTelegramToken, testTelegramChatID)
+		notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -221,7 +262,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForTelegram(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewTelegramNotifier(testServer.URL, testTelegramToken, testTelegramChatID)
+		notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -264,7 +305,7 @@ func TestTelegramNotifier_OutputMessages(t *testing.T) {
 		testServer := createHttpTestServerThatRespondsOKForTelegram(t, expectedMessage, expectedTitle, &numCalls)
 		defer testServer.Close()
 
-		notifier := NewTelegramNotifier(testServer.URL, testTelegramToken, testTelegramChatID)
+		notifier := NewTelegramNotifier(nil, testServer.URL, testTelegramToken, testTelegramChatID)
 		err := notifier.OutputMessages(msg1, msg2, msg3)
 		assert.Nil(t, err)
 
@@ -283,6 +324,7 @@ func TestTelegramNotifier_FunctionalTest(t *testing.T) {
 	_ = logger.SetLogLevel("*:DEBUG")
 
 	notifier := NewTelegramNotifier(
+		nil,
 		"https://api.telegram.org",
 		telegramBotToken,
 		telegramChatID,
@@ -0,0 +1,148 @@
+package parsers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const csvIdentityColumn = "identity"
+const csvLabelColumn = "label"
+const csvAlarmDeltaRatingDropColumn = "alarmdeltaratingdrop"
+const csvJailThresholdColumn = "jailthreshold"
+const csvEnabledChecksColumn = "enabledchecks"
+
+type csvParser struct {
+}
+
+// NewCSVParser creates a new CSV identities file parser. The first record is the header, it should contain the
+// identity column and, optionally, the label, the alarmDeltaRatingDrop, the jailThreshold and the enabledChecks columns.
+// All other columns are tags
+func NewCSVParser() *csvParser {
+	return &csvParser{}
+}
+
+// ParseFile will try to parse the CSV file and split the identities in 2. Errors if something is wrong with the file
+func (parser *csvParser) ParseFile(filename string) (*core.IdentitiesHolder, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := csv.NewReader(file)
+	reader.Comment = []rune(commentMarker)[0]
+	reader.TrimLeadingSpace = true
+
+	result := newIdentitiesHolder(filename)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := parseCSVHeader(header)
+	if err != nil {
+		line, _ := reader.FieldPos(0)
+		return nil, fmt.Errorf("%w on line %d", err, line)
+	}
+
+	for index := 0; ; index++ {
+		fields, errRead := reader.Read()
+		if errors.Is(errRead, io.EOF) {
+			return result, nil
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		line, _ := reader.FieldPos(0)
+		err = addCSVRecord(result, columns, fields, core.IdentityLocation{File: filename, Line: line})
+		if err != nil {
+			return nil, fmt.Errorf("%w on record %d (line %d)", err, index, line)
+		}
+	}
+}
+
+func parseCSVHeader(header []string) ([]string, error) {
+	columns := make([]string, 0, len(header))
+	existing := make(map[string]struct{})
+	for _, column := range header {
+		column = strings.TrimSpace(column)
+		if isReservedCSVColumn(column) {
+			column = strings.ToLower(column)
+		}
+
+		_, found := existing[column]
+		if found {
+			return nil, fmt.Errorf("%w: %s", errDuplicatedColumn, column)
+		}
+
+		existing[column] = struct{}{}
+		columns = append(columns, column)
+	}
+
+	_, found := existing[csvIdentityColumn]
+	if !found {
+		return nil, errMissingIdentityColumn
+	}
+
+	return columns, nil
+}
+
+func isReservedCSVColumn(column string) bool {
+	switch strings.ToLower(column) {
+	case csvIdentityColumn, csvLabelColumn, csvAlarmDeltaRatingDropColumn, csvJailThresholdColumn, csvEnabledChecksColumn:
+		return true
+	default:
+		return false
+	}
+}
+
+func addCSVRecord(
+	identitiesHolder *core.IdentitiesHolder,
+	columns []string,
+	fields []string,
+	location core.IdentityLocation,
+) error {
+	record := identityRecord{
+		Tags: make(map[string]string),
+	}
+	for index, column := range columns {
+		value := strings.TrimSpace(fields[index])
+		if len(value) == 0 {
+			continue
+		}
+
+		switch column {
+		case csvIdentityColumn:
+			record.Identity = value
+		case csvLabelColumn:
+			record.Label = value
+		case csvAlarmDeltaRatingDropColumn:
+			record.Tags[alarmDeltaRatingDropTagName] = value
+		case csvJailThresholdColumn:
+			record.Tags[jailThresholdTagName] = value
+		case csvEnabledChecksColumn:
+			record.Tags[enabledChecksTagName] = value
+		default:
+			record.Tags[column] = value
+		}
+	}
+
+	return addRecord(identitiesHolder, record, location)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (parser *csvParser) IsInterfaceNil() bool {
+	return parser == nil
+}
@@ -0,0 +1,91 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCSVParser(t *testing.T) {
+	t.Parallel()
+
+	parser := NewCSVParser()
+	assert.NotNil(t, parser)
+}
+
+func TestCsvParser_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *csvParser
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &csvParser{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestCsvParser_ParseFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("file-not-found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "file-not-found")
+		assert.Nil(t, result)
+	})
+	t.Run("missing identity column should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("./testdata/missingIdentityColumn.csv")
+		assert.ErrorIs(t, err, errMissingIdentityColumn)
+		assert.Contains(t, err.Error(), "on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("invalid threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("./testdata/invalidThreshold.csv")
+		assert.ErrorIs(t, err, errInvalidTag)
+		assert.Contains(t, err.Error(), "alarmDeltaRatingDrop=high on record 1 (line 3)")
+		assert.Nil(t, result)
+	})
+	t.Run("invalid identity should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("./testdata/invalidIdentity.csv")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "on record 1 (line 3)")
+		assert.Nil(t, result)
+	})
+	t.Run("correct file should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("./testdata/okIdentities.csv")
+		assert.Nil(t, err)
+		checkStructuredIdentities(t, result)
+	})
+	t.Run("thresholds overrides columns should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewCSVParser()
+		result, err := parser.ParseFile("./testdata/okOverrides.csv")
+		assert.Nil(t, err)
+
+		jailThreshold := 5.0
+		expectedOverrides := map[string]core.ThresholdsOverride{
+			testBLSKey1: {
+				JailThreshold: &jailThreshold,
+				EnabledChecks: []string{core.RatingDropCheck, core.ImminentJailCheck},
+			},
+		}
+		assert.Equal(t, expectedOverrides, result.Overrides)
+		assert.Equal(t, map[string]string{"dc": "fra"}, result.Metadata[testBLSKey1].Tags)
+	})
+}
@@ -0,0 +1,57 @@
+package parsers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// MonitorIdentities holds the identities loaded by a monitor
+type MonitorIdentities struct {
+	MonitorName string
+	Identities  *core.IdentitiesHolder
+}
+
+// DuplicatedIdentity holds an identity defined more than once and all the places where it was defined, in the
+// "monitor <name>: <file>:<line>" format
+type DuplicatedIdentity struct {
+	Identity  string
+	Locations []string
+}
+
+// FindDuplicatedIdentities returns the identities defined more than once in the same monitor or in different
+// monitors, sorted by identity
+func FindDuplicatedIdentities(monitors []MonitorIdentities) []DuplicatedIdentity {
+	allLocations := make(map[string][]string)
+	for _, monitor := range monitors {
+		if monitor.Identities == nil {
+			continue
+		}
+
+		for identity, locations := range monitor.Identities.Locations {
+			for _, location := range locations {
+				allLocations[identity] = append(allLocations[identity],
+					fmt.Sprintf("monitor %s: %s", monitor.MonitorName, location.String()))
+			}
+		}
+	}
+
+	duplicates := make([]DuplicatedIdentity, 0)
+	for identity, locations := range allLocations {
+		if len(locations) < 2 {
+			continue
+		}
+
+		duplicates = append(duplicates, DuplicatedIdentity{
+			Identity:  identity,
+			Locations: locations,
+		})
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].Identity < duplicates[j].Identity
+	})
+
+	return duplicates
+}
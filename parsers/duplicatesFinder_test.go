@@ -0,0 +1,76 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDuplicatedIdentities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no duplicates should return an empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		monitors := []MonitorIdentities{
+			{
+				MonitorName: "mainnet",
+				Identities: &core.IdentitiesHolder{
+					Locations: map[string][]core.IdentityLocation{
+						"bls1": {{File: "a.list", Line: 1}},
+					},
+				},
+			},
+			{
+				MonitorName: "testnet",
+				Identities: &core.IdentitiesHolder{
+					Locations: map[string][]core.IdentityLocation{
+						"bls2": {{File: "b.list", Line: 1}},
+					},
+				},
+			},
+			{
+				MonitorName: "nil identities",
+			},
+		}
+
+		assert.Empty(t, FindDuplicatedIdentities(monitors))
+	})
+	t.Run("duplicates in the same monitor and across monitors", func(t *testing.T) {
+		t.Parallel()
+
+		monitors := []MonitorIdentities{
+			{
+				MonitorName: "mainnet",
+				Identities: &core.IdentitiesHolder{
+					Locations: map[string][]core.IdentityLocation{
+						"bls2": {{File: "a.list", Line: 3}, {File: "teams/b.list", Line: 7}},
+						"bls1": {{File: "a.list", Line: 1}},
+						"bls3": {{File: "a.list", Line: 2}},
+					},
+				},
+			},
+			{
+				MonitorName: "mainnet backup",
+				Identities: &core.IdentitiesHolder{
+					Locations: map[string][]core.IdentityLocation{
+						"bls1": {{File: "./keys"}},
+					},
+				},
+			},
+		}
+
+		expectedDuplicates := []DuplicatedIdentity{
+			{
+				Identity:  "bls1",
+				Locations: []string{"monitor mainnet: a.list:1", "monitor mainnet backup: ./keys"},
+			},
+			{
+				Identity:  "bls2",
+				Locations: []string{"monitor mainnet: a.list:3", "monitor mainnet: teams/b.list:7"},
+			},
+		}
+		assert.Equal(t, expectedDuplicates, FindDuplicatedIdentities(monitors))
+	})
+}
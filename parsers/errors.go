@@ -0,0 +1,50 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errInvalidTag                     = errors.New("invalid tag")
+	errDuplicatedTag                  = errors.New("duplicated tag")
+	errMultipleLabels                 = errors.New("multiple labels defined")
+	errMissingIdentity                = errors.New("missing identity")
+	errOverrideNotSupportedForAddress = errors.New("the thresholds overrides are supported only for BLS keys")
+	errMissingIdentityColumn          = errors.New("missing identity column in the CSV header")
+	errDuplicatedColumn               = errors.New("duplicated column in the CSV header")
+	errNoPEMFilesFound                = errors.New("no PEM files found")
+	errNoPEMKeysFound                 = errors.New("no BLS keys found")
+	errInvalidPEMPublicKey            = errors.New("invalid public BLS key")
+	errInvalidFileStructure           = errors.New("invalid file structure")
+	errNilParser                      = errors.New("nil parser")
+	errNoIdentitiesSource             = errors.New("no identities source defined")
+	errInvalidInclude                 = errors.New("invalid include directive")
+	errNoIncludedFiles                = errors.New("no files match the include path")
+	errIncludeCycle                   = errors.New("include cycle detected")
+)
+
+// lineError is an error already located on a line of a list or PEM file. It is not located again by the list files
+// including that line, so the reported line is always the one of the entry that caused the error
+type lineError struct {
+	err error
+}
+
+// Error returns the error message
+func (e *lineError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *lineError) Unwrap() error {
+	return e.err
+}
+
+func newLineError(err error, line int) error {
+	located := &lineError{}
+	if errors.As(err, &located) {
+		return err
+	}
+
+	return &lineError{err: fmt.Errorf("%w on line %d", err, line)}
+}
@@ -0,0 +1,175 @@
+package parsers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+const alarmDeltaRatingDropTagName = "alarmDeltaRatingDrop"
+const jailThresholdTagName = "jailThreshold"
+const enabledChecksTagName = "enabledChecks"
+const enabledChecksSeparator = ","
+
+var bech32PubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(core.AddressLen, core.AddressHRP)
+
+// identityRecord is the representation of one identity in the structured (JSON, YAML) files
+type identityRecord struct {
+	Identity             string            `json:"identity" yaml:"identity"`
+	Label                string            `json:"label" yaml:"label"`
+	Tags                 map[string]string `json:"tags" yaml:"tags"`
+	AlarmDeltaRatingDrop *float64          `json:"alarmDeltaRatingDrop" yaml:"alarmDeltaRatingDrop"`
+	JailThreshold        *float64          `json:"jailThreshold" yaml:"jailThreshold"`
+	EnabledChecks        []string          `json:"enabledChecks" yaml:"enabledChecks"`
+}
+
+func newIdentitiesHolder(sourceFiles ...string) *core.IdentitiesHolder {
+	return &core.IdentitiesHolder{
+		Metadata:    make(map[string]core.IdentityMetadata),
+		Overrides:   make(map[string]core.ThresholdsOverride),
+		Locations:   make(map[string][]core.IdentityLocation),
+		SourceFiles: sourceFiles,
+	}
+}
+
+func addRecord(identitiesHolder *core.IdentitiesHolder, record identityRecord, location core.IdentityLocation) error {
+	for key, value := range record.Tags {
+		if len(key) == 0 || len(value) == 0 {
+			return fmt.Errorf("%w: %s=%s", errInvalidTag, key, value)
+		}
+	}
+
+	metadata := core.IdentityMetadata{
+		Label: record.Label,
+		Tags:  record.Tags,
+	}
+
+	override := core.ThresholdsOverride{
+		AlarmDeltaRatingDrop: record.AlarmDeltaRatingDrop,
+		JailThreshold:        record.JailThreshold,
+		EnabledChecks:        record.EnabledChecks,
+	}
+
+	return addIdentity(identitiesHolder, record.Identity, metadata, override, location)
+}
+
+// addIdentity will add the BLS key or the address in the holder, together with its metadata, its location and the
+// optional thresholds override. The override can also be defined by the alarmDeltaRatingDrop, jailThreshold and
+// enabledChecks tags
+func addIdentity(
+	identitiesHolder *core.IdentitiesHolder,
+	identity string,
+	metadata core.IdentityMetadata,
+	override core.ThresholdsOverride,
+	location core.IdentityLocation,
+) error {
+	if len(identity) == 0 {
+		return errMissingIdentity
+	}
+
+	metadata, override, err := extractOverride(metadata, override)
+	if err != nil {
+		return err
+	}
+
+	if len(identity) == core.BLSHexKeyLen {
+		// try to unhex it
+		_, err := hex.DecodeString(identity)
+		if err != nil {
+			return err
+		}
+
+		identitiesHolder.BlsHexKeys = append(identitiesHolder.BlsHexKeys, identity)
+		addMetadata(identitiesHolder, identity, metadata)
+		addLocation(identitiesHolder, identity, location)
+		if !override.IsEmpty() {
+			identitiesHolder.Overrides[identity] = override
+		}
+
+		return nil
+	}
+	decoded, err := bech32PubKeyConverter.Decode(identity)
+	if err != nil {
+		return err
+	}
+	if !override.IsEmpty() {
+		return fmt.Errorf("%w for address %s", errOverrideNotSupportedForAddress, identity)
+	}
+
+	address := core.Address{
+		Hex:    hex.EncodeToString(decoded),
+		Bech32: identity,
+	}
+	identitiesHolder.Addresses = append(identitiesHolder.Addresses, address)
+	addMetadata(identitiesHolder, identity, metadata)
+	addLocation(identitiesHolder, identity, location)
+
+	return nil
+}
+
+// extractOverride moves the thresholds override tags from the metadata to the override. A value can not be defined
+// both as a tag and as a field of the record
+func extractOverride(
+	metadata core.IdentityMetadata,
+	override core.ThresholdsOverride,
+) (core.IdentityMetadata, core.ThresholdsOverride, error) {
+	if len(metadata.Tags) == 0 {
+		return metadata, override, nil
+	}
+
+	var err error
+	tags := make(map[string]string, len(metadata.Tags))
+	for key, value := range metadata.Tags {
+		switch key {
+		case alarmDeltaRatingDropTagName:
+			if override.AlarmDeltaRatingDrop != nil {
+				return core.IdentityMetadata{}, core.ThresholdsOverride{}, fmt.Errorf("%w: %s", errDuplicatedTag, key)
+			}
+			override.AlarmDeltaRatingDrop, err = parseFloatTag(key, value)
+		case jailThresholdTagName:
+			if override.JailThreshold != nil {
+				return core.IdentityMetadata{}, core.ThresholdsOverride{}, fmt.Errorf("%w: %s", errDuplicatedTag, key)
+			}
+			override.JailThreshold, err = parseFloatTag(key, value)
+		case enabledChecksTagName:
+			if len(override.EnabledChecks) > 0 {
+				return core.IdentityMetadata{}, core.ThresholdsOverride{}, fmt.Errorf("%w: %s", errDuplicatedTag, key)
+			}
+			override.EnabledChecks = strings.Split(value, enabledChecksSeparator)
+		default:
+			tags[key] = value
+		}
+		if err != nil {
+			return core.IdentityMetadata{}, core.ThresholdsOverride{}, err
+		}
+	}
+
+	metadata.Tags = tags
+
+	return metadata, override, nil
+}
+
+func parseFloatTag(key string, value string) (*float64, error) {
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s=%s", errInvalidTag, key, value)
+	}
+
+	return &result, nil
+}
+
+func addMetadata(identitiesHolder *core.IdentitiesHolder, identity string, metadata core.IdentityMetadata) {
+	if len(metadata.Label) == 0 && len(metadata.Tags) == 0 {
+		return
+	}
+
+	identitiesHolder.Metadata[identity] = metadata
+}
+
+func addLocation(identitiesHolder *core.IdentitiesHolder, identity string, location core.IdentityLocation) {
+	identitiesHolder.Locations[identity] = append(identitiesHolder.Locations[identity], location)
+}
@@ -0,0 +1,151 @@
+package parsers
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+// IdentitiesSource defines a file containing identities and the parser able to read it
+type IdentitiesSource struct {
+	Filename string
+	Parser   identitiesParser
+}
+
+// ArgsIdentitiesLoader defines the DTO struct for the NewIdentitiesLoader constructor function
+type ArgsIdentitiesLoader struct {
+	Sources  []IdentitiesSource
+	PemFiles []string
+	PemDirs  []string
+}
+
+type identitiesLoader struct {
+	sources  []IdentitiesSource
+	pemFiles []string
+	pemDirs  []string
+}
+
+// NewIdentitiesLoader creates a component that loads and merges the identities from all the provided sources: the
+// identities files and the PEM files and directories
+func NewIdentitiesLoader(args ArgsIdentitiesLoader) (*identitiesLoader, error) {
+	if len(args.Sources) == 0 && len(args.PemFiles) == 0 && len(args.PemDirs) == 0 {
+		return nil, errNoIdentitiesSource
+	}
+	for index, source := range args.Sources {
+		if check.IfNil(source.Parser) {
+			return nil, fmt.Errorf("%w for source at index %d", errNilParser, index)
+		}
+	}
+
+	return &identitiesLoader{
+		sources:  args.Sources,
+		pemFiles: args.PemFiles,
+		pemDirs:  args.PemDirs,
+	}, nil
+}
+
+// LoadIdentities parses all the sources and returns the merged identities. An identity defined more than once is
+// monitored only once, all its locations being kept
+func (loader *identitiesLoader) LoadIdentities() (*core.IdentitiesHolder, error) {
+	result := newIdentitiesHolder()
+	for _, source := range loader.sources {
+		identities, err := source.Parser.ParseFile(source.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("%w in file %s", err, source.Filename)
+		}
+
+		mergeIdentities(result, identities)
+	}
+
+	for _, pemFile := range loader.pemFiles {
+		blsKeys, err := readPEMFile(pemFile)
+		if err != nil {
+			return nil, err
+		}
+
+		addPEMKeys(result, blsKeys, pemFile)
+	}
+
+	for _, pemDir := range loader.pemDirs {
+		blsKeys, err := readPEMDirectory(pemDir)
+		if err != nil {
+			return nil, err
+		}
+
+		addPEMKeys(result, blsKeys, pemDir)
+	}
+
+	removeDuplicatedIdentities(result)
+
+	return result, nil
+}
+
+func mergeIdentities(destination *core.IdentitiesHolder, source *core.IdentitiesHolder) {
+	destination.BlsHexKeys = append(destination.BlsHexKeys, source.BlsHexKeys...)
+	destination.Addresses = append(destination.Addresses, source.Addresses...)
+	destination.SourceFiles = append(destination.SourceFiles, source.SourceFiles...)
+	for identity, metadata := range source.Metadata {
+		destination.Metadata[identity] = metadata
+	}
+	for blsKey, override := range source.Overrides {
+		destination.Overrides[blsKey] = override
+	}
+	for identity, locations := range source.Locations {
+		destination.Locations[identity] = append(destination.Locations[identity], locations...)
+	}
+}
+
+func addPEMKeys(identitiesHolder *core.IdentitiesHolder, blsKeys []string, path string) {
+	identitiesHolder.BlsHexKeys = append(identitiesHolder.BlsHexKeys, blsKeys...)
+	identitiesHolder.SourceFiles = append(identitiesHolder.SourceFiles, path)
+	for _, blsKey := range blsKeys {
+		addLocation(identitiesHolder, blsKey, core.IdentityLocation{File: path})
+	}
+}
+
+// removeDuplicatedIdentities keeps only the first occurrence of each BLS key and address
+func removeDuplicatedIdentities(identitiesHolder *core.IdentitiesHolder) {
+	existing := make(map[string]struct{})
+	blsKeys := make([]string, 0, len(identitiesHolder.BlsHexKeys))
+	for _, blsKey := range identitiesHolder.BlsHexKeys {
+		_, found := existing[blsKey]
+		if found {
+			continue
+		}
+
+		existing[blsKey] = struct{}{}
+		blsKeys = append(blsKeys, blsKey)
+	}
+
+	addresses := make([]core.Address, 0, len(identitiesHolder.Addresses))
+	for _, address := range identitiesHolder.Addresses {
+		_, found := existing[address.Bech32]
+		if found {
+			continue
+		}
+
+		existing[address.Bech32] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	identitiesHolder.BlsHexKeys = blsKeys
+	identitiesHolder.Addresses = addresses
+}
+
+// Filenames returns the identities files, the PEM files and the PEM directories that should be watched for changes
+func (loader *identitiesLoader) Filenames() []string {
+	filenames := make([]string, 0, len(loader.sources)+len(loader.pemFiles)+len(loader.pemDirs))
+	for _, source := range loader.sources {
+		filenames = append(filenames, source.Filename)
+	}
+	filenames = append(filenames, loader.pemFiles...)
+	filenames = append(filenames, loader.pemDirs...)
+
+	return filenames
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (loader *identitiesLoader) IsInterfaceNil() bool {
+	return loader == nil
+}
@@ -0,0 +1,151 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdentitiesLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(ArgsIdentitiesLoader{})
+		assert.Equal(t, errNoIdentitiesSource, err)
+		assert.Nil(t, loader)
+	})
+	t.Run("nil parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			Sources: []IdentitiesSource{
+				{Filename: "keys.list", Parser: NewListParser()},
+				{Filename: "keys.json"},
+			},
+		})
+		assert.ErrorIs(t, err, errNilParser)
+		assert.Contains(t, err.Error(), "at index 1")
+		assert.Nil(t, loader)
+	})
+	t.Run("only PEM sources should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			PemDirs: []string{"./testdata/pems"},
+		})
+		assert.Nil(t, err)
+		assert.NotNil(t, loader)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, err := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			Sources: []IdentitiesSource{
+				{Filename: "keys.list", Parser: NewListParser()},
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotNil(t, loader)
+	})
+}
+
+func TestIdentitiesLoader_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *identitiesLoader
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &identitiesLoader{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestIdentitiesLoader_Filenames(t *testing.T) {
+	t.Parallel()
+
+	loader, _ := NewIdentitiesLoader(ArgsIdentitiesLoader{
+		Sources: []IdentitiesSource{
+			{Filename: "keys.list", Parser: NewListParser()},
+			{Filename: "keys.json", Parser: NewJSONParser()},
+		},
+		PemDirs: []string{"./testdata/pems"},
+	})
+	assert.Equal(t, []string{"keys.list", "keys.json", "./testdata/pems"}, loader.Filenames())
+}
+
+func TestIdentitiesLoader_LoadIdentities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("source errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			Sources: []IdentitiesSource{
+				{Filename: "./testdata/invalidTag.list", Parser: NewListParser()},
+			},
+		})
+		result, err := loader.LoadIdentities()
+		assert.ErrorIs(t, err, errInvalidTag)
+		assert.Contains(t, err.Error(), "in file ./testdata/invalidTag.list")
+		assert.Nil(t, result)
+	})
+	t.Run("invalid PEM file should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			PemFiles: []string{"./testdata/invalidKey.pem"},
+		})
+		result, err := loader.LoadIdentities()
+		assert.ErrorIs(t, err, errInvalidPEMPublicKey)
+		assert.Nil(t, result)
+	})
+	t.Run("invalid PEM directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			PemDirs: []string{"./testdata/emptyDir"},
+		})
+		result, err := loader.LoadIdentities()
+		assert.ErrorIs(t, err, errNoPEMFilesFound)
+		assert.Nil(t, result)
+	})
+	t.Run("should merge all sources", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := NewIdentitiesLoader(ArgsIdentitiesLoader{
+			Sources: []IdentitiesSource{
+				{Filename: "./testdata/emptyFileWithComments.list", Parser: NewListParser()},
+				{Filename: "./testdata/okIdentities.json", Parser: NewJSONParser()},
+			},
+			PemFiles: []string{"./testdata/pems/node2.pem"},
+			PemDirs:  []string{"./testdata/pems"},
+		})
+		result, err := loader.LoadIdentities()
+		assert.Nil(t, err)
+
+		// the duplicated keys are monitored only once, all their locations are kept
+		assert.Equal(t, []string{testBLSKey1, testBLSKey2}, result.BlsHexKeys)
+		expectedLocations := []core.IdentityLocation{
+			{File: "./testdata/okIdentities.json", Line: 8},
+			{File: "./testdata/pems/node2.pem"},
+			{File: "./testdata/pems"},
+		}
+		assert.Equal(t, expectedLocations, result.Locations[testBLSKey2])
+		expectedSourceFiles := []string{
+			"./testdata/emptyFileWithComments.list",
+			"./testdata/okIdentities.json",
+			"./testdata/pems/node2.pem",
+			"./testdata/pems",
+		}
+		assert.Equal(t, expectedSourceFiles, result.SourceFiles)
+		assert.Equal(t, 1, len(result.Addresses))
+		assert.Equal(t, testAddress, result.Addresses[0].Bech32)
+		assert.Equal(t, "node-fra-03", result.Metadata[testBLSKey1].Label)
+		assert.Equal(t, "provider", result.Metadata[testAddress].Label)
+		expectedAlarmDeltaRatingDrop := 2.5
+		assert.Equal(t, map[string]core.ThresholdsOverride{testBLSKey1: {AlarmDeltaRatingDrop: &expectedAlarmDeltaRatingDrop}}, result.Overrides)
+	})
+}
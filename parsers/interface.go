@@ -0,0 +1,8 @@
+package parsers
+
+import "github.com/multiversx/mx-chain-keys-monitor-go/core"
+
+type identitiesParser interface {
+	ParseFile(filename string) (*core.IdentitiesHolder, error)
+	IsInterfaceNil() bool
+}
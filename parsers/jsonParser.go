@@ -0,0 +1,75 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+)
+
+type jsonParser struct {
+}
+
+// NewJSONParser creates a new JSON identities file parser. The file should contain an array of identity records
+func NewJSONParser() *jsonParser {
+	return &jsonParser{}
+}
+
+// ParseFile will try to parse the JSON file and split the identities in 2. Errors if something is wrong with the file
+func (parser *jsonParser) ParseFile(filename string) (*core.IdentitiesHolder, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("%w on line %d", err, lineAtOffset(data, decoder.InputOffset()))
+	}
+	if token != json.Delim('[') {
+		return nil, fmt.Errorf("%w, expected an array of identity records on line %d",
+			errInvalidFileStructure, lineAtOffset(data, 0))
+	}
+
+	result := newIdentitiesHolder(filename)
+	for index := 0; decoder.More(); index++ {
+		line := lineAtOffset(data, decoder.InputOffset())
+
+		record := identityRecord{}
+		err = decoder.Decode(&record)
+		if err != nil {
+			return nil, fmt.Errorf("%w on record %d (line %d)", err, index, line)
+		}
+
+		err = addRecord(result, record, core.IdentityLocation{File: filename, Line: line})
+		if err != nil {
+			return nil, fmt.Errorf("%w on record %d (line %d)", err, index, line)
+		}
+	}
+
+	return result, nil
+}
+
+// lineAtOffset returns the 1-based line number of the first meaningful character found starting with the provided
+// offset. Whitespaces and the JSON elements separator are skipped
+func lineAtOffset(data []byte, offset int64) int {
+	for offset < int64(len(data)) {
+		character := data[offset]
+		if character != ' ' && character != '\t' && character != '\r' && character != '\n' && character != ',' {
+			break
+		}
+		offset++
+	}
+
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (parser *jsonParser) IsInterfaceNil() bool {
+	return parser == nil
+}
@@ -0,0 +1,74 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJSONParser(t *testing.T) {
+	t.Parallel()
+
+	parser := NewJSONParser()
+	assert.NotNil(t, parser)
+}
+
+func TestJsonParser_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *jsonParser
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &jsonParser{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestJsonParser_ParseFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewJSONParser()
+		result, err := parser.ParseFile("file-not-found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "file-not-found")
+		assert.Nil(t, result)
+	})
+	t.Run("not an array should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewJSONParser()
+		result, err := parser.ParseFile("./testdata/notAnArray.json")
+		assert.ErrorIs(t, err, errInvalidFileStructure)
+		assert.Contains(t, err.Error(), "on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("invalid record should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewJSONParser()
+		result, err := parser.ParseFile("./testdata/invalidRecord.json")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "alarmDeltaRatingDrop")
+		assert.Contains(t, err.Error(), "on record 1 (line 3)")
+		assert.Nil(t, result)
+	})
+	t.Run("unknown field should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewJSONParser()
+		result, err := parser.ParseFile("./testdata/unknownField.json")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown field \"threshold\" on record 1 (line 4)")
+		assert.Nil(t, result)
+	})
+	t.Run("correct file should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewJSONParser()
+		result, err := parser.ParseFile("./testdata/okIdentities.json")
+		assert.Nil(t, err)
+		checkStructuredIdentities(t, result)
+	})
+}
@@ -1,18 +1,20 @@
 package parsers
 
 import (
-	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
-	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
 )
 
 const commentMarker = "#"
-
-var bech32PubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(core.AddressLen, core.AddressHRP)
+const tagSeparator = "="
+const labelTagName = "name"
+const trimCutset = ",.;"
+const includeDirective = "include"
 
 type listParser struct {
 }
@@ -24,62 +26,201 @@ func NewListParser() *listParser {
 
 // ParseFile will try to parse to file and split the identities in 2. Errors if something is wrong with the file
 func (parser *listParser) ParseFile(filename string) (*core.IdentitiesHolder, error) {
-	dataFile, err := os.ReadFile(filename)
+	result := newIdentitiesHolder()
+	err := parser.parseFile(filename, make(map[string]struct{}), result)
 	if err != nil {
 		return nil, err
 	}
 
-	result := &core.IdentitiesHolder{}
+	return result, nil
+}
+
+// parseFile parses the list file and, recursively, all the files included by it. The include chain holds the files
+// currently being parsed and is used to detect the include cycles
+func (parser *listParser) parseFile(filename string, includeChain map[string]struct{}, result *core.IdentitiesHolder) error {
+	dataFile, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
 
+	chainKey := includeChainKey(filename)
+	includeChain[chainKey] = struct{}{}
+	defer delete(includeChain, chainKey)
+
+	result.SourceFiles = append(result.SourceFiles, filename)
 	spitLines := strings.Split(string(dataFile), "\n")
 	for index, line := range spitLines {
 		line = strings.TrimSpace(line)
-		line = strings.Trim(line, ",.;")
+		line = strings.Trim(line, trimCutset)
 		if len(line) == 0 {
 			continue
 		}
 		if strings.Index(line, commentMarker) == 0 {
 			continue
 		}
-		err = parser.processLine(line, result)
+
+		location := core.IdentityLocation{
+			File: filename,
+			Line: index + 1,
+		}
+		err = parser.processLine(line, location, includeChain, result)
 		if err != nil {
-			return nil, fmt.Errorf("%w on line %d", err, index)
+			return newLineError(err, index+1)
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-func (parser *listParser) processLine(line string, identitiesHolder *core.IdentitiesHolder) error {
-	if strings.HasPrefix(line, "\"") && strings.HasSuffix(line, "\"") {
-		line = line[1 : len(line)-1]
+// processLine will parse a line in the format <identity> [label] [key=value ...]. The identity can also reference a
+// PEM file or a directory containing PEM files, as pem:<path>, relative to the list file directory. The line can also
+// be an include <path or glob> directive, the path being relative to the list file directory
+func (parser *listParser) processLine(
+	line string,
+	location core.IdentityLocation,
+	includeChain map[string]struct{},
+	identitiesHolder *core.IdentitiesHolder,
+) error {
+	fields := strings.Fields(line)
+	if fields[0] == includeDirective {
+		return parser.processInclude(fields[1:], location, includeChain, identitiesHolder)
+	}
+
+	identity := strings.Trim(fields[0], trimCutset)
+	if strings.HasPrefix(identity, "\"") && strings.HasSuffix(identity, "\"") {
+		identity = identity[1 : len(identity)-1]
 	}
 
-	if len(line) == core.BLSHexKeyLen {
-		// try to unhex it
-		_, err := hex.DecodeString(line)
+	metadata, err := parseMetadata(fields[1:])
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(identity, pemEntryPrefix) {
+		path := resolvePath(strings.TrimPrefix(identity, pemEntryPrefix), location.File)
+
+		return addPEMIdentities(identitiesHolder, path, metadata, location)
+	}
+
+	return addIdentity(identitiesHolder, identity, metadata, core.ThresholdsOverride{}, location)
+}
+
+// processInclude parses all the files matching the provided path or glob pattern, in lexical order
+func (parser *listParser) processInclude(
+	arguments []string,
+	location core.IdentityLocation,
+	includeChain map[string]struct{},
+	identitiesHolder *core.IdentitiesHolder,
+) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("%w, expected %s <path or glob>", errInvalidInclude, includeDirective)
+	}
+
+	pattern := resolvePath(arguments[0], location.File)
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, pattern)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("%w: %s", errNoIncludedFiles, pattern)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		_, isIncluding := includeChain[includeChainKey(file)]
+		if isIncluding {
+			return fmt.Errorf("%w: %s", errIncludeCycle, file)
+		}
+
+		err = parser.parseFile(file, includeChain, identitiesHolder)
 		if err != nil {
-			return err
+			return fmt.Errorf("%w in included file %s", err, file)
 		}
+	}
+
+	return nil
+}
+
+// resolvePath returns the path relative to the directory of the list file, if not absolute
+func resolvePath(path string, listFile string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
 
-		identitiesHolder.BlsHexKeys = append(identitiesHolder.BlsHexKeys, line)
+	return filepath.Join(filepath.Dir(listFile), path)
+}
 
-		return nil
+func includeChainKey(filename string) string {
+	absolutePath, err := filepath.Abs(filename)
+	if err != nil {
+		return filepath.Clean(filename)
 	}
-	decoded, err := bech32PubKeyConverter.Decode(line)
+
+	return absolutePath
+}
+
+func addPEMIdentities(
+	identitiesHolder *core.IdentitiesHolder,
+	path string,
+	metadata core.IdentityMetadata,
+	location core.IdentityLocation,
+) error {
+	blsKeys, err := readPEMPublicKeys(path)
 	if err != nil {
 		return err
 	}
+	identitiesHolder.SourceFiles = append(identitiesHolder.SourceFiles, path)
 
-	address := core.Address{
-		Hex:    hex.EncodeToString(decoded),
-		Bech32: line,
+	for _, blsKey := range blsKeys {
+		err = addIdentity(identitiesHolder, blsKey, metadata, core.ThresholdsOverride{}, location)
+		if err != nil {
+			return err
+		}
 	}
-	identitiesHolder.Addresses = append(identitiesHolder.Addresses, address)
 
 	return nil
 }
 
+// parseMetadata parses the optional label and tags. The label can be provided as a single word or as the name tag
+func parseMetadata(fields []string) (core.IdentityMetadata, error) {
+	metadata := core.IdentityMetadata{
+		Tags: make(map[string]string),
+	}
+
+	for _, field := range fields {
+		if !strings.Contains(field, tagSeparator) {
+			if len(metadata.Label) > 0 {
+				return core.IdentityMetadata{}, fmt.Errorf("%w: %s and %s", errMultipleLabels, metadata.Label, field)
+			}
+
+			metadata.Label = field
+			continue
+		}
+
+		key, value, _ := strings.Cut(field, tagSeparator)
+		if len(key) == 0 || len(value) == 0 {
+			return core.IdentityMetadata{}, fmt.Errorf("%w: %s", errInvalidTag, field)
+		}
+		if key == labelTagName {
+			if len(metadata.Label) > 0 {
+				return core.IdentityMetadata{}, fmt.Errorf("%w: %s and %s", errMultipleLabels, metadata.Label, value)
+			}
+
+			metadata.Label = value
+			continue
+		}
+
+		_, exists := metadata.Tags[key]
+		if exists {
+			return core.IdentityMetadata{}, fmt.Errorf("%w: %s", errDuplicatedTag, key)
+		}
+
+		metadata.Tags[key] = value
+	}
+
+	return metadata, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (parser *listParser) IsInterfaceNil() bool {
 	return parser == nil
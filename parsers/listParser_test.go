@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-keys-monitor-go/core"
@@ -106,4 +107,200 @@ func TestListParser_ParseFile(t *testing.T) {
 		}
 		assert.Equal(t, expectedAddresses, result.Addresses)
 	})
+	t.Run("invalid tag should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/invalidTag.list")
+		assert.ErrorIs(t, err, errInvalidTag)
+		assert.Contains(t, err.Error(), "dc= on line 2")
+		assert.Nil(t, result)
+	})
+	t.Run("duplicated tag should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/duplicatedTag.list")
+		assert.ErrorIs(t, err, errDuplicatedTag)
+		assert.Contains(t, err.Error(), "dc on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("multiple labels should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/multipleLabels.list")
+		assert.ErrorIs(t, err, errMultipleLabels)
+		assert.Contains(t, err.Error(), "node-fra-03 and node-fra-04 on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("labels and tags should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/okLabelsAndTags.list")
+		assert.Nil(t, err)
+
+		expectedHexBLSKeys := []string{
+			"015c24a0585c3007e02bb9168c7988cccd183285161b26a0fd908b68f4daf64518517b947f58a3c6cb3caebc4a1c84015470b2b43b05d6d9dbd463c817162b7f6c30f2bcb95fd7bc5dce98e5858200087c1d2b095f097dea57c142e4c0c0e088",
+			"02dbca1ecef7a29da845c6ddd7b06254c4e6ef4506268e0117fd0350ab8a2f44b2997a02cf5eed3fd54673696d964301c90e5ff3bebc56d1b03138e77afc9d09bcb3d96b2efd93814c805a24761b2ba994be9d4696702966f6d53d149495378c",
+		}
+		assert.Equal(t, expectedHexBLSKeys, result.BlsHexKeys)
+		assert.Equal(t, 2, len(result.Addresses))
+
+		expectedMetadata := map[string]core.IdentityMetadata{
+			expectedHexBLSKeys[0]: {
+				Label: "node-fra-03",
+				Tags: map[string]string{
+					"dc":    "fra",
+					"owner": "alice",
+				},
+			},
+			expectedHexBLSKeys[1]: {
+				Label: "node-ams-01",
+				Tags:  map[string]string{},
+			},
+			"erd1cemzcl4kah9ng8f7xle7vc3k8jvwvgmmgfzl2echjesspr23vzcqdexyy9": {
+				Tags: map[string]string{
+					"owner": "bob",
+				},
+			},
+		}
+		assert.Equal(t, expectedMetadata, result.Metadata)
+	})
+	t.Run("thresholds overrides tags should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/okOverrides.list")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey1, testBLSKey2}, result.BlsHexKeys)
+
+		alarmDeltaRatingDrop, jailThreshold := 2.5, 5.0
+		expectedOverrides := map[string]core.ThresholdsOverride{
+			testBLSKey1: {
+				AlarmDeltaRatingDrop: &alarmDeltaRatingDrop,
+				JailThreshold:        &jailThreshold,
+				EnabledChecks:        []string{core.RatingDropCheck, core.ImminentJailCheck},
+			},
+			testBLSKey2: {
+				EnabledChecks: []string{core.NoChecks},
+			},
+		}
+		assert.Equal(t, expectedOverrides, result.Overrides)
+
+		// the overrides tags are not kept as tags
+		assert.Equal(t, map[string]string{"dc": "fra"}, result.Metadata[testBLSKey1].Tags)
+		assert.Equal(t, "backup", result.Metadata[testBLSKey2].Label)
+		assert.Empty(t, result.Metadata[testBLSKey2].Tags)
+	})
+	t.Run("invalid thresholds override tag should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/invalidOverride.list")
+		assert.ErrorIs(t, err, errInvalidTag)
+		assert.Contains(t, err.Error(), "jailThreshold=low on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("thresholds override on address should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/overrideOnAddress.list")
+		assert.ErrorIs(t, err, errOverrideNotSupportedForAddress)
+		assert.Nil(t, result)
+	})
+	t.Run("invalid PEM entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/invalidPEMEntry.list")
+		assert.ErrorIs(t, err, errInvalidPEMPublicKey)
+		// the error is located only on the line of the PEM file, not again on the line of the list file
+		assert.Contains(t, err.Error(), "invalidKey.pem on line 1")
+		assert.NotContains(t, err.Error(), "on line 3")
+		assert.Nil(t, result)
+	})
+	t.Run("PEM entries should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/okPEMEntries.list")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey1, testBLSKey2}, result.BlsHexKeys)
+		assert.Equal(t, 1, len(result.Addresses))
+
+		expectedMetadata := core.IdentityMetadata{
+			Label: "ams",
+			Tags:  map[string]string{"dc": "ams"},
+		}
+		assert.Equal(t, expectedMetadata, result.Metadata[testBLSKey1])
+		assert.Equal(t, expectedMetadata, result.Metadata[testBLSKey2])
+
+		result, err = parser.ParseFile("./testdata/okPEMFileEntry.list")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey2}, result.BlsHexKeys)
+		assert.Equal(t, "node-fra-03", result.Metadata[testBLSKey2].Label)
+	})
+	t.Run("include directives should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/includes/main.list")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey1, testBLSKey2, testBLSKey2}, result.BlsHexKeys)
+		assert.Equal(t, 1, len(result.Addresses))
+		assert.Equal(t, "node-fra-03", result.Metadata[testBLSKey1].Label)
+
+		expectedSourceFiles := []string{
+			"./testdata/includes/main.list",
+			"testdata/includes/teams/team1.list",
+			"testdata/includes/teams/team2.list",
+			"testdata/okPEMFileEntry.list",
+			"testdata/pems/node2.pem",
+		}
+		assert.Equal(t, expectedSourceFiles, result.SourceFiles)
+
+		expectedLocations := []core.IdentityLocation{
+			{File: "testdata/includes/teams/team2.list", Line: 3},
+			{File: "testdata/okPEMFileEntry.list", Line: 1},
+		}
+		assert.Equal(t, expectedLocations, result.Locations[testBLSKey2])
+		assert.Equal(t, []core.IdentityLocation{{File: "./testdata/includes/main.list", Line: 5}}, result.Locations[testAddress])
+	})
+	t.Run("include cycle should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/includes/cycle1.list")
+		assert.ErrorIs(t, err, errIncludeCycle)
+		assert.Nil(t, result)
+	})
+	t.Run("include without matching files should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/includes/noMatch.list")
+		assert.ErrorIs(t, err, errNoIncludedFiles)
+		assert.Contains(t, err.Error(), "on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("include with multiple paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/includes/invalidInclude.list")
+		assert.ErrorIs(t, err, errInvalidInclude)
+		assert.Nil(t, result)
+	})
+	t.Run("invalid included file should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewListParser()
+		result, err := parser.ParseFile("./testdata/includes/invalidIncluded.list")
+		assert.ErrorIs(t, err, errInvalidTag)
+		assert.True(t, strings.HasSuffix(err.Error(), "on line 2 in included file testdata/invalidTag.list"))
+		assert.Nil(t, result)
+	})
 }
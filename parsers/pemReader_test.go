@@ -0,0 +1,57 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPEMPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing path should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/missing.pem")
+		assert.NotNil(t, err)
+		assert.Nil(t, keys)
+	})
+	t.Run("directory without PEM files should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/emptyDir")
+		assert.ErrorIs(t, err, errNoPEMFilesFound)
+		assert.Contains(t, err.Error(), "emptyDir")
+		assert.Nil(t, keys)
+	})
+	t.Run("file without keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/noKeys.pem")
+		assert.ErrorIs(t, err, errNoPEMKeysFound)
+		assert.Contains(t, err.Error(), "noKeys.pem")
+		assert.Nil(t, keys)
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/invalidKey.pem")
+		assert.ErrorIs(t, err, errInvalidPEMPublicKey)
+		assert.Contains(t, err.Error(), "length 16, expected 192 in PEM file ./testdata/invalidKey.pem on line 1")
+		assert.Nil(t, keys)
+	})
+	t.Run("file should work", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/pems/node1.pem")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey1}, keys)
+	})
+	t.Run("directory should work", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := readPEMPublicKeys("./testdata/pems")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{testBLSKey1, testBLSKey2}, keys)
+	})
+}
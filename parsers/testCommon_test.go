@@ -0,0 +1,33 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBLSKey1 = "015c24a0585c3007e02bb9168c7988cccd183285161b26a0fd908b68f4daf64518517b947f58a3c6cb3caebc4a1c84015470b2b43b05d6d9dbd463c817162b7f6c30f2bcb95fd7bc5dce98e5858200087c1d2b095f097dea57c142e4c0c0e088"
+const testBLSKey2 = "02dbca1ecef7a29da845c6ddd7b06254c4e6ef4506268e0117fd0350ab8a2f44b2997a02cf5eed3fd54673696d964301c90e5ff3bebc56d1b03138e77afc9d09bcb3d96b2efd93814c805a24761b2ba994be9d4696702966f6d53d149495378c"
+const testAddress = "erd1cemzcl4kah9ng8f7xle7vc3k8jvwvgmmgfzl2echjesspr23vzcqdexyy9"
+
+// checkStructuredIdentities verifies the identities defined in the okIdentities.* files
+func checkStructuredIdentities(t *testing.T, result *core.IdentitiesHolder) {
+	assert.Equal(t, []string{testBLSKey1, testBLSKey2}, result.BlsHexKeys)
+	expectedAddresses := []core.Address{
+		{
+			Hex:    "c6762c7eb6edcb341d3e37f3e662363c98e6237b4245f567179661008d5160b0",
+			Bech32: testAddress,
+		},
+	}
+	assert.Equal(t, expectedAddresses, result.Addresses)
+
+	assert.Equal(t, 2, len(result.Metadata))
+	assert.Equal(t, "node-fra-03", result.Metadata[testBLSKey1].Label)
+	assert.Equal(t, map[string]string{"dc": "fra", "owner": "alice"}, result.Metadata[testBLSKey1].Tags)
+	assert.Equal(t, "provider", result.Metadata[testAddress].Label)
+	assert.Empty(t, result.Metadata[testAddress].Tags)
+
+	expectedAlarmDeltaRatingDrop := 2.5
+	assert.Equal(t, map[string]core.ThresholdsOverride{testBLSKey1: {AlarmDeltaRatingDrop: &expectedAlarmDeltaRatingDrop}}, result.Overrides)
+}
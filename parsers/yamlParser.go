@@ -0,0 +1,63 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlParser struct {
+}
+
+// NewYAMLParser creates a new YAML identities file parser. The file should contain a sequence of identity records
+func NewYAMLParser() *yamlParser {
+	return &yamlParser{}
+}
+
+// ParseFile will try to parse the YAML file and split the identities in 2. Errors if something is wrong with the file
+func (parser *yamlParser) ParseFile(filename string) (*core.IdentitiesHolder, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	document := yaml.Node{}
+	err = yaml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newIdentitiesHolder(filename)
+	if len(document.Content) == 0 {
+		// empty file or only comments
+		return result, nil
+	}
+
+	sequence := document.Content[0]
+	if sequence.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("%w, expected a sequence of identity records on line %d",
+			errInvalidFileStructure, sequence.Line)
+	}
+
+	for index, node := range sequence.Content {
+		record := identityRecord{}
+		err = node.Decode(&record)
+		if err != nil {
+			return nil, fmt.Errorf("%w on record %d (line %d)", err, index, node.Line)
+		}
+
+		err = addRecord(result, record, core.IdentityLocation{File: filename, Line: node.Line})
+		if err != nil {
+			return nil, fmt.Errorf("%w on record %d (line %d)", err, index, node.Line)
+		}
+	}
+
+	return result, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (parser *yamlParser) IsInterfaceNil() bool {
+	return parser == nil
+}
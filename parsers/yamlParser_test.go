@@ -0,0 +1,74 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewYAMLParser(t *testing.T) {
+	t.Parallel()
+
+	parser := NewYAMLParser()
+	assert.NotNil(t, parser)
+}
+
+func TestYamlParser_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *yamlParser
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &yamlParser{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestYamlParser_ParseFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewYAMLParser()
+		result, err := parser.ParseFile("file-not-found")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "file-not-found")
+		assert.Nil(t, result)
+	})
+	t.Run("not a sequence should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewYAMLParser()
+		result, err := parser.ParseFile("./testdata/notASequence.yaml")
+		assert.ErrorIs(t, err, errInvalidFileStructure)
+		assert.Contains(t, err.Error(), "on line 1")
+		assert.Nil(t, result)
+	})
+	t.Run("threshold override on address should error", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewYAMLParser()
+		result, err := parser.ParseFile("./testdata/overrideOnAddress.yaml")
+		assert.ErrorIs(t, err, errOverrideNotSupportedForAddress)
+		assert.Contains(t, err.Error(), "on record 1 (line 2)")
+		assert.Nil(t, result)
+	})
+	t.Run("empty file with comments should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewYAMLParser()
+		result, err := parser.ParseFile("./testdata/emptyFileWithComments.yaml")
+		assert.Nil(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result.Addresses)
+		assert.Empty(t, result.BlsHexKeys)
+	})
+	t.Run("correct file should work", func(t *testing.T) {
+		t.Parallel()
+
+		parser := NewYAMLParser()
+		result, err := parser.ParseFile("./testdata/okIdentities.yaml")
+		assert.Nil(t, err)
+		checkStructuredIdentities(t, result)
+	})
+}
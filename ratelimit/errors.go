@@ -0,0 +1,9 @@
+package ratelimit
+
+import "errors"
+
+var (
+	errInvalidRate        = errors.New("invalid rate")
+	errInvalidBurst       = errors.New("invalid burst")
+	errNilCurrentTimeFunc = errors.New("nil current time function")
+)
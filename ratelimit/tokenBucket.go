@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type tokenBucket struct {
+	mut            sync.Mutex
+	refillInterval time.Duration
+	capacity       float64
+	tokens         float64
+	lastRefill     time.Time
+	getCurrentTime func() time.Time
+}
+
+// ArgsTokenBucket defines the DTO struct for the NewTokenBucket constructor function
+type ArgsTokenBucket struct {
+	MessagesPerMinute float64
+	Burst             uint32
+	GetCurrentTime    func() time.Time
+}
+
+// NewTokenBucket creates a new instance of type tokenBucket. The bucket starts full, holding Burst tokens, and
+// is refilled with MessagesPerMinute tokens each minute
+func NewTokenBucket(args ArgsTokenBucket) (*tokenBucket, error) {
+	if args.MessagesPerMinute <= 0 {
+		return nil, fmt.Errorf("%w, provided: %v messages per minute", errInvalidRate, args.MessagesPerMinute)
+	}
+	if args.Burst == 0 {
+		return nil, fmt.Errorf("%w, provided 0", errInvalidBurst)
+	}
+	if args.GetCurrentTime == nil {
+		return nil, errNilCurrentTimeFunc
+	}
+
+	return &tokenBucket{
+		refillInterval: time.Duration(float64(time.Minute) / args.MessagesPerMinute),
+		capacity:       float64(args.Burst),
+		tokens:         float64(args.Burst),
+		lastRefill:     args.GetCurrentTime(),
+		getCurrentTime: args.GetCurrentTime,
+	}, nil
+}
+
+// Reserve will consume one token if available and return 0. Otherwise, no token is consumed and the function
+// returns the time after which a new token will be available
+func (bucket *tokenBucket) Reserve() time.Duration {
+	bucket.mut.Lock()
+	defer bucket.mut.Unlock()
+
+	bucket.refill()
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - bucket.tokens) * float64(bucket.refillInterval))
+}
+
+// Refund will give back a token previously consumed by the Reserve call
+func (bucket *tokenBucket) Refund() {
+	bucket.mut.Lock()
+	defer bucket.mut.Unlock()
+
+	bucket.tokens++
+	if bucket.tokens > bucket.capacity {
+		bucket.tokens = bucket.capacity
+	}
+}
+
+func (bucket *tokenBucket) refill() {
+	now := bucket.getCurrentTime()
+	elapsed := now.Sub(bucket.lastRefill)
+	bucket.lastRefill = now
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens += float64(elapsed) / float64(bucket.refillInterval)
+	if bucket.tokens > bucket.capacity {
+		bucket.tokens = bucket.capacity
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bucket *tokenBucket) IsInterfaceNil() bool {
+	return bucket == nil
+}
@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testClock struct {
+	mut         sync.Mutex
+	currentTime time.Time
+}
+
+func (clock *testClock) now() time.Time {
+	clock.mut.Lock()
+	defer clock.mut.Unlock()
+
+	return clock.currentTime
+}
+
+func (clock *testClock) advance(duration time.Duration) {
+	clock.mut.Lock()
+	clock.currentTime = clock.currentTime.Add(duration)
+	clock.mut.Unlock()
+}
+
+func createTestArgsTokenBucket(clock *testClock) ArgsTokenBucket {
+	return ArgsTokenBucket{
+		MessagesPerMinute: 6,
+		Burst:             2,
+		GetCurrentTime:    clock.now,
+	}
+}
+
+func TestNewTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{currentTime: time.Unix(1000, 0)}
+	t.Run("invalid rate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsTokenBucket(clock)
+		args.MessagesPerMinute = 0
+		instance, err := NewTokenBucket(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidRate)
+		assert.Contains(t, err.Error(), "provided: 0 messages per minute")
+	})
+	t.Run("invalid burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsTokenBucket(clock)
+		args.Burst = 0
+		instance, err := NewTokenBucket(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidBurst)
+	})
+	t.Run("nil current time function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsTokenBucket(clock)
+		args.GetCurrentTime = nil
+		instance, err := NewTokenBucket(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilCurrentTimeFunc, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewTokenBucket(createTestArgsTokenBucket(clock))
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenBucket_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *tokenBucket
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &tokenBucket{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{currentTime: time.Unix(1000, 0)}
+	bucket, _ := NewTokenBucket(createTestArgsTokenBucket(clock))
+
+	// burst
+	assert.Zero(t, bucket.Reserve())
+	assert.Zero(t, bucket.Reserve())
+	// one token each 10 seconds
+	assert.Equal(t, time.Second*10, bucket.Reserve())
+
+	clock.advance(time.Second * 4)
+	assert.Equal(t, time.Second*6, bucket.Reserve())
+
+	clock.advance(time.Second * 6)
+	assert.Zero(t, bucket.Reserve())
+	assert.Equal(t, time.Second*10, bucket.Reserve())
+
+	// the bucket should not hold more than the burst
+	clock.advance(time.Hour)
+	assert.Zero(t, bucket.Reserve())
+	assert.Zero(t, bucket.Reserve())
+	assert.NotZero(t, bucket.Reserve())
+}
+
+func TestTokenBucket_Refund(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{currentTime: time.Unix(1000, 0)}
+	bucket, _ := NewTokenBucket(createTestArgsTokenBucket(clock))
+
+	assert.Zero(t, bucket.Reserve())
+	assert.Zero(t, bucket.Reserve())
+	bucket.Refund()
+	assert.Zero(t, bucket.Reserve())
+	assert.NotZero(t, bucket.Reserve())
+
+	// refunding a full bucket should not increase its capacity
+	clock.advance(time.Hour)
+	bucket.Refund()
+	assert.Zero(t, bucket.Reserve())
+	assert.Zero(t, bucket.Reserve())
+	assert.NotZero(t, bucket.Reserve())
+}
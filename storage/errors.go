@@ -0,0 +1,11 @@
+package storage
+
+import "errors"
+
+var (
+	errEmptyDirectory          = errors.New("empty directory")
+	errNilCurrentTimestampFunc = errors.New("nil current timestamp function")
+	errInvalidMaxAge           = errors.New("invalid max age")
+	errInvalidBackoff          = errors.New("invalid backoff")
+	errEntryNotFound           = errors.New("outbox entry not found")
+)
@@ -0,0 +1,273 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const outboxFileExtension = ".json"
+const outboxFilePermissions = 0644
+const outboxDirectoryPermissions = 0755
+
+var log = logger.GetOrCreate("storage")
+
+var invalidFilenameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+type notifierOutbox struct {
+	entries []core.OutboxEntry
+	nextID  uint64
+}
+
+type fileOutbox struct {
+	directory           string
+	maxAge              int64
+	initialBackoff      int64
+	maxBackoff          int64
+	getCurrentTimestamp func() int64
+	mut                 sync.Mutex
+	outboxes            map[string]*notifierOutbox
+}
+
+// ArgsFileOutbox defines the DTO struct for the NewFileOutbox constructor function
+type ArgsFileOutbox struct {
+	Directory           string
+	MaxAgeInSec         uint64
+	InitialBackoffInSec uint64
+	MaxBackoffInSec     uint64
+	GetCurrentTimestamp func() int64
+}
+
+// NewFileOutbox creates a new instance of type fileOutbox. Each notifier will have its own file in the provided
+// directory so the undelivered notifications survive an application restart
+func NewFileOutbox(args ArgsFileOutbox) (*fileOutbox, error) {
+	if len(args.Directory) == 0 {
+		return nil, errEmptyDirectory
+	}
+	if args.GetCurrentTimestamp == nil {
+		return nil, errNilCurrentTimestampFunc
+	}
+	if args.MaxAgeInSec == 0 {
+		return nil, fmt.Errorf("%w, provided 0", errInvalidMaxAge)
+	}
+	if args.InitialBackoffInSec == 0 || args.MaxBackoffInSec < args.InitialBackoffInSec {
+		return nil, fmt.Errorf("%w, initial: %d, max: %d", errInvalidBackoff, args.InitialBackoffInSec, args.MaxBackoffInSec)
+	}
+
+	err := os.MkdirAll(args.Directory, outboxDirectoryPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileOutbox{
+		directory:           args.Directory,
+		maxAge:              int64(args.MaxAgeInSec),
+		initialBackoff:      int64(args.InitialBackoffInSec),
+		maxBackoff:          int64(args.MaxBackoffInSec),
+		getCurrentTimestamp: args.GetCurrentTimestamp,
+		outboxes:            make(map[string]*notifierOutbox),
+	}, nil
+}
+
+// Add will store the messages that could not be delivered for the provided notifier
+func (outbox *fileOutbox) Add(notifierID string, caller string, messages []core.OutputMessage) error {
+	outbox.mut.Lock()
+	defer outbox.mut.Unlock()
+
+	box, err := outbox.getNotifierOutbox(notifierID)
+	if err != nil {
+		return err
+	}
+
+	currentTimestamp := outbox.getCurrentTimestamp()
+	box.entries = append(box.entries, core.OutboxEntry{
+		ID:                   box.nextID,
+		Caller:               caller,
+		Messages:             messages,
+		CreatedTimestamp:     currentTimestamp,
+		NextAttemptTimestamp: currentTimestamp + outbox.initialBackoff,
+	})
+	box.nextID++
+
+	log.Debug("fileOutbox.Add: stored undelivered messages", "notifier", notifierID, "num messages", len(messages),
+		"outbox size", len(box.entries))
+
+	return outbox.save(notifierID, box)
+}
+
+// GetDueEntries returns the entries that should be resent now. Expired entries are removed
+func (outbox *fileOutbox) GetDueEntries(notifierID string) []core.OutboxEntry {
+	outbox.mut.Lock()
+	defer outbox.mut.Unlock()
+
+	box, err := outbox.getNotifierOutbox(notifierID)
+	if err != nil {
+		log.Error("fileOutbox.GetDueEntries: can not load outbox", "notifier", notifierID, "error", err)
+		return nil
+	}
+
+	currentTimestamp := outbox.getCurrentTimestamp()
+	notExpired := make([]core.OutboxEntry, 0, len(box.entries))
+	dueEntries := make([]core.OutboxEntry, 0)
+	for _, entry := range box.entries {
+		if entry.CreatedTimestamp+outbox.maxAge <= currentTimestamp {
+			log.Warn("fileOutbox: undelivered messages expired", "notifier", notifierID, "executor", entry.Caller,
+				"num messages", len(entry.Messages), "num attempts", entry.NumAttempts)
+			continue
+		}
+
+		notExpired = append(notExpired, entry)
+		if entry.NextAttemptTimestamp <= currentTimestamp {
+			dueEntries = append(dueEntries, entry)
+		}
+	}
+
+	if len(notExpired) != len(box.entries) {
+		box.entries = notExpired
+		log.LogIfError(outbox.save(notifierID, box))
+	}
+
+	return dueEntries
+}
+
+// MarkDelivered removes the entry from the outbox
+func (outbox *fileOutbox) MarkDelivered(notifierID string, entryID uint64) error {
+	outbox.mut.Lock()
+	defer outbox.mut.Unlock()
+
+	box, err := outbox.getNotifierOutbox(notifierID)
+	if err != nil {
+		return err
+	}
+
+	for index, entry := range box.entries {
+		if entry.ID != entryID {
+			continue
+		}
+
+		box.entries = append(box.entries[:index], box.entries[index+1:]...)
+		return outbox.save(notifierID, box)
+	}
+
+	return fmt.Errorf("%w, notifier %s, ID %d", errEntryNotFound, notifierID, entryID)
+}
+
+// MarkFailed records a failed attempt and schedules the next one using an exponential backoff
+func (outbox *fileOutbox) MarkFailed(notifierID string, entryID uint64) error {
+	outbox.mut.Lock()
+	defer outbox.mut.Unlock()
+
+	box, err := outbox.getNotifierOutbox(notifierID)
+	if err != nil {
+		return err
+	}
+
+	for index := range box.entries {
+		entry := &box.entries[index]
+		if entry.ID != entryID {
+			continue
+		}
+
+		entry.NumAttempts++
+		entry.NextAttemptTimestamp = outbox.getCurrentTimestamp() + outbox.computeBackoff(entry.NumAttempts)
+		return outbox.save(notifierID, box)
+	}
+
+	return fmt.Errorf("%w, notifier %s, ID %d", errEntryNotFound, notifierID, entryID)
+}
+
+func (outbox *fileOutbox) computeBackoff(numAttempts uint32) int64 {
+	backoff := outbox.initialBackoff
+	for i := uint32(0); i < numAttempts; i++ {
+		backoff *= 2
+		if backoff >= outbox.maxBackoff {
+			return outbox.maxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// NumEntries returns the number of entries stored for the provided notifier
+func (outbox *fileOutbox) NumEntries(notifierID string) int {
+	outbox.mut.Lock()
+	defer outbox.mut.Unlock()
+
+	box, err := outbox.getNotifierOutbox(notifierID)
+	if err != nil {
+		return 0
+	}
+
+	return len(box.entries)
+}
+
+// getNotifierOutbox returns the cached outbox or loads it from the disk on the first access
+func (outbox *fileOutbox) getNotifierOutbox(notifierID string) (*notifierOutbox, error) {
+	box, found := outbox.outboxes[notifierID]
+	if found {
+		return box, nil
+	}
+
+	box = &notifierOutbox{
+		entries: make([]core.OutboxEntry, 0),
+	}
+	data, err := os.ReadFile(outbox.filename(notifierID))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		err = json.Unmarshal(data, &box.entries)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the outbox for notifier %s", err, notifierID)
+		}
+	}
+
+	for _, entry := range box.entries {
+		if entry.ID >= box.nextID {
+			box.nextID = entry.ID + 1
+		}
+	}
+	if len(box.entries) > 0 {
+		log.Info("loaded undelivered notifications from outbox", "notifier", notifierID, "num entries", len(box.entries))
+	}
+
+	outbox.outboxes[notifierID] = box
+
+	return box, nil
+}
+
+// save writes the outbox in a temporary file and then renames it, so a crash can not leave a corrupted file behind
+func (outbox *fileOutbox) save(notifierID string, box *notifierOutbox) error {
+	data, err := json.Marshal(box.entries)
+	if err != nil {
+		return err
+	}
+
+	filename := outbox.filename(notifierID)
+	tempFilename := filename + ".tmp"
+	err = os.WriteFile(tempFilename, data, outboxFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilename, filename)
+}
+
+func (outbox *fileOutbox) filename(notifierID string) string {
+	name := invalidFilenameCharacters.ReplaceAllString(notifierID, "_")
+
+	return filepath.Join(outbox.directory, name+outboxFileExtension)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (outbox *fileOutbox) IsInterfaceNil() bool {
+	return outbox == nil
+}
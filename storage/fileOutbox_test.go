@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-keys-monitor-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNotifierID = "0-*notifiers.telegramNotifier"
+
+func createTestArgsFileOutbox(t *testing.T, currentTimestamp *int64) ArgsFileOutbox {
+	return ArgsFileOutbox{
+		Directory:           t.TempDir(),
+		MaxAgeInSec:         1000,
+		InitialBackoffInSec: 10,
+		MaxBackoffInSec:     50,
+		GetCurrentTimestamp: func() int64 {
+			return atomic.LoadInt64(currentTimestamp)
+		},
+	}
+}
+
+func TestNewFileOutbox(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(0)
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsFileOutbox(t, &currentTimestamp)
+		args.Directory = ""
+		instance, err := NewFileOutbox(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errEmptyDirectory, err)
+	})
+	t.Run("nil current timestamp function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsFileOutbox(t, &currentTimestamp)
+		args.GetCurrentTimestamp = nil
+		instance, err := NewFileOutbox(args)
+		assert.Nil(t, instance)
+		assert.Equal(t, errNilCurrentTimestampFunc, err)
+	})
+	t.Run("invalid max age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsFileOutbox(t, &currentTimestamp)
+		args.MaxAgeInSec = 0
+		instance, err := NewFileOutbox(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidMaxAge)
+	})
+	t.Run("invalid backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsFileOutbox(t, &currentTimestamp)
+		args.InitialBackoffInSec = 0
+		instance, err := NewFileOutbox(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidBackoff)
+
+		args = createTestArgsFileOutbox(t, &currentTimestamp)
+		args.MaxBackoffInSec = args.InitialBackoffInSec - 1
+		instance, err = NewFileOutbox(args)
+		assert.Nil(t, instance)
+		assert.ErrorIs(t, err, errInvalidBackoff)
+		assert.Contains(t, err.Error(), "initial: 10, max: 9")
+	})
+	t.Run("should work and create the directory", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestArgsFileOutbox(t, &currentTimestamp)
+		args.Directory = filepath.Join(args.Directory, "db", "outbox")
+		instance, err := NewFileOutbox(args)
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+
+		info, err := os.Stat(args.Directory)
+		assert.Nil(t, err)
+		assert.True(t, info.IsDir())
+	})
+}
+
+func TestFileOutbox_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *fileOutbox
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &fileOutbox{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestFileOutbox_AddAndResend(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(100)
+	args := createTestArgsFileOutbox(t, &currentTimestamp)
+	outbox, _ := NewFileOutbox(args)
+
+	messages1 := []core.OutputMessage{{Identifier: "bls1", Type: core.ErrorMessageOutputType}}
+	messages2 := []core.OutputMessage{{Identifier: "bls2", Type: core.WarningMessageOutputType}}
+	require.Nil(t, outbox.Add(testNotifierID, "executor", messages1))
+	require.Nil(t, outbox.Add(testNotifierID, "executor", messages2))
+	assert.Equal(t, 2, outbox.NumEntries(testNotifierID))
+	assert.Equal(t, 0, outbox.NumEntries("another notifier"))
+
+	// the entries are not due yet
+	assert.Empty(t, outbox.GetDueEntries(testNotifierID))
+
+	atomic.StoreInt64(&currentTimestamp, 110)
+	entries := outbox.GetDueEntries(testNotifierID)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, uint64(0), entries[0].ID)
+	assert.Equal(t, messages1, entries[0].Messages)
+	assert.Equal(t, "executor", entries[0].Caller)
+	assert.Equal(t, uint64(1), entries[1].ID)
+	assert.Equal(t, messages2, entries[1].Messages)
+
+	assert.Nil(t, outbox.MarkDelivered(testNotifierID, 0))
+	assert.Nil(t, outbox.MarkFailed(testNotifierID, 1))
+	assert.ErrorIs(t, outbox.MarkDelivered(testNotifierID, 0), errEntryNotFound)
+	assert.ErrorIs(t, outbox.MarkFailed(testNotifierID, 0), errEntryNotFound)
+	assert.Equal(t, 1, outbox.NumEntries(testNotifierID))
+
+	// first failure doubles the initial backoff: 110 + 20
+	atomic.StoreInt64(&currentTimestamp, 129)
+	assert.Empty(t, outbox.GetDueEntries(testNotifierID))
+	atomic.StoreInt64(&currentTimestamp, 130)
+	entries = outbox.GetDueEntries(testNotifierID)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, uint32(1), entries[0].NumAttempts)
+}
+
+func TestFileOutbox_ComputeBackoff(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(0)
+	args := createTestArgsFileOutbox(t, &currentTimestamp)
+	outbox, _ := NewFileOutbox(args)
+
+	assert.Equal(t, int64(10), outbox.computeBackoff(0))
+	assert.Equal(t, int64(20), outbox.computeBackoff(1))
+	assert.Equal(t, int64(40), outbox.computeBackoff(2))
+	assert.Equal(t, int64(50), outbox.computeBackoff(3))
+	assert.Equal(t, int64(50), outbox.computeBackoff(300))
+}
+
+func TestFileOutbox_ExpiredEntriesShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(100)
+	args := createTestArgsFileOutbox(t, &currentTimestamp)
+	outbox, _ := NewFileOutbox(args)
+
+	require.Nil(t, outbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls1"}}))
+	atomic.StoreInt64(&currentTimestamp, 500)
+	require.Nil(t, outbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls2"}}))
+
+	atomic.StoreInt64(&currentTimestamp, 1100)
+	entries := outbox.GetDueEntries(testNotifierID)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "bls2", entries[0].Messages[0].Identifier)
+	assert.Equal(t, 1, outbox.NumEntries(testNotifierID))
+}
+
+func TestFileOutbox_ShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(100)
+	args := createTestArgsFileOutbox(t, &currentTimestamp)
+	outbox, _ := NewFileOutbox(args)
+
+	require.Nil(t, outbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls1"}}))
+	require.Nil(t, outbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls2"}}))
+	require.Nil(t, outbox.MarkDelivered(testNotifierID, 0))
+
+	files, err := os.ReadDir(args.Directory)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+	assert.Equal(t, "0-_notifiers_telegramNotifier.json", files[0].Name())
+
+	reloadedOutbox, _ := NewFileOutbox(args)
+	assert.Equal(t, 1, reloadedOutbox.NumEntries(testNotifierID))
+
+	require.Nil(t, reloadedOutbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls3"}}))
+	atomic.StoreInt64(&currentTimestamp, 200)
+	entries := reloadedOutbox.GetDueEntries(testNotifierID)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, uint64(1), entries[0].ID)
+	assert.Equal(t, "bls2", entries[0].Messages[0].Identifier)
+	assert.Equal(t, uint64(2), entries[1].ID)
+	assert.Equal(t, "bls3", entries[1].Messages[0].Identifier)
+}
+
+func TestFileOutbox_CorruptedFileShouldError(t *testing.T) {
+	t.Parallel()
+
+	currentTimestamp := int64(100)
+	args := createTestArgsFileOutbox(t, &currentTimestamp)
+	outbox, _ := NewFileOutbox(args)
+
+	err := os.WriteFile(outbox.filename(testNotifierID), []byte("not a JSON"), 0644)
+	require.Nil(t, err)
+
+	err = outbox.Add(testNotifierID, "executor", []core.OutputMessage{{Identifier: "bls1"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "while loading the outbox for notifier "+testNotifierID)
+	assert.Empty(t, outbox.GetDueEntries(testNotifierID))
+	assert.Zero(t, outbox.NumEntries(testNotifierID))
+}